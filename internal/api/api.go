@@ -148,6 +148,13 @@ func (a *API) Handler(ctx context.Context) (http.Handler, error) {
 	s := router.PathPrefix(a.prefix).Subrouter()
 
 	s.Handle("/stream", websocketService(a.wsClientManager, a.dashConfig))
+	s.Handle("/logs/download", newLogDownloadHandler(a.dashConfig))
+	s.Handle("/terminal-recordings", newTerminalRecordingHandler(a.dashConfig))
+
+	containerFiles := newContainerFilesHandler(a.dashConfig)
+	s.HandleFunc("/containers/files", containerFiles.list)
+	s.HandleFunc("/containers/files/download", containerFiles.download)
+	s.HandleFunc("/containers/files/upload", containerFiles.upload)
 
 	s.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		a.logger.Errorf("api handler not found: %s", r.URL.String())
@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/containerfs"
+	"github.com/vmware-tanzu/octant/internal/mime"
+)
+
+// containerFilesMaxMemory is the amount of an upload which is kept in memory.
+// The rest is stored in temporary files.
+const containerFilesMaxMemory = 32 << 20
+
+// containerFilesHandler lists, downloads, and uploads files in a container
+// with ls and tar over exec, like kubectl cp. The container is set with
+// the namespace, pod, and container query parameters, and the file or
+// directory with the path query parameter.
+type containerFilesHandler struct {
+	dashConfig    config.Dash
+	newFileSystem func() *containerfs.FileSystem
+}
+
+func newContainerFilesHandler(dashConfig config.Dash) *containerFilesHandler {
+	h := &containerFilesHandler{
+		dashConfig: dashConfig,
+	}
+	h.newFileSystem = func() *containerfs.FileSystem {
+		return containerfs.New(containerfs.ClusterExec(h.dashConfig.ClusterClient()))
+	}
+
+	return h
+}
+
+// list lists the files in the directory as JSON.
+func (h *containerFilesHandler) list(w http.ResponseWriter, r *http.Request) {
+	logger := h.dashConfig.Logger()
+
+	if r.Method != http.MethodGet {
+		RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		return
+	}
+
+	target, dir, err := containerFilesTarget(r.URL.Query())
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	files, err := h.newFileSystem().List(r.Context(), target, dir)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+		return
+	}
+
+	if files == nil {
+		files = []containerfs.File{}
+	}
+
+	w.Header().Set("Content-Type", mime.JSONContentType)
+	if err := json.NewEncoder(w).Encode(files); err != nil {
+		logger.WithErr(err).Errorf("encoding container files")
+	}
+}
+
+// download downloads a file, or a directory as a gzipped tar archive. Files
+// are downloaded as an archive as well if the archive query parameter is true.
+func (h *containerFilesHandler) download(w http.ResponseWriter, r *http.Request) {
+	logger := h.dashConfig.Logger()
+
+	if r.Method != http.MethodGet {
+		RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		return
+	}
+
+	query := r.URL.Query()
+
+	target, filePath, err := containerFilesTarget(query)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	archive := false
+	if s := query.Get("archive"); s != "" {
+		archive, err = strconv.ParseBool(s)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("parsing archive: %s", err), logger)
+			return
+		}
+	}
+
+	ctx := r.Context()
+	fs := h.newFileSystem()
+
+	file, err := fs.Stat(ctx, target, filePath)
+	if err != nil {
+		RespondWithError(w, http.StatusNotFound, err.Error(), logger)
+		return
+	}
+
+	name := file.Name
+	if name == "/" {
+		name = target.Container
+	}
+
+	// Headers are written with the first part of the download, so a download
+	// which fails before then responds with an error.
+	dw := &downloadWriter{w: w}
+
+	if file.IsDir || archive {
+		dw.contentType = "application/gzip"
+		dw.filename = name + ".tar.gz"
+		err = fs.DownloadArchive(ctx, target, file.Path, dw)
+	} else {
+		dw.contentType = "application/octet-stream"
+		dw.filename = name
+		err = fs.DownloadFile(ctx, target, file.Path, dw)
+	}
+
+	if err != nil {
+		if !dw.wroteHeader {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+		logger.WithErr(err).Errorf("downloading container file")
+		return
+	}
+
+	dw.writeHeader()
+}
+
+// upload uploads the files in a multipart form to the directory. The files
+// are in the form's files field.
+func (h *containerFilesHandler) upload(w http.ResponseWriter, r *http.Request) {
+	logger := h.dashConfig.Logger()
+
+	if r.Method != http.MethodPost {
+		RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		return
+	}
+
+	target, dir, err := containerFilesTarget(r.URL.Query())
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	if err := r.ParseMultipartForm(containerFilesMaxMemory); err != nil {
+		RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("parsing upload: %s", err), logger)
+		return
+	}
+	defer func() {
+		if err := r.MultipartForm.RemoveAll(); err != nil {
+			logger.WithErr(err).Errorf("removing uploaded files")
+		}
+	}()
+
+	headers := r.MultipartForm.File["files"]
+	if len(headers) == 0 {
+		RespondWithError(w, http.StatusBadRequest, "no files were uploaded", logger)
+		return
+	}
+
+	fs := h.newFileSystem()
+
+	for _, header := range headers {
+		f, err := header.Open()
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+
+		err = fs.Upload(r.Context(), target, dir, header.Filename, f, header.Size)
+		f.Close()
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// downloadWriter writes a download's headers before the first write.
+type downloadWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	wroteHeader bool
+}
+
+var _ io.Writer = (*downloadWriter)(nil)
+
+func (dw *downloadWriter) Write(p []byte) (int, error) {
+	dw.writeHeader()
+	return dw.w.Write(p)
+}
+
+func (dw *downloadWriter) writeHeader() {
+	if dw.wroteHeader {
+		return
+	}
+	dw.wroteHeader = true
+
+	dw.w.Header().Set("Content-Type", dw.contentType)
+	dw.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", dw.filename))
+	dw.w.WriteHeader(http.StatusOK)
+}
+
+// containerFilesTarget returns the container and path in query parameters.
+func containerFilesTarget(values url.Values) (containerfs.Target, string, error) {
+	target := containerfs.Target{
+		Namespace: values.Get("namespace"),
+		Pod:       values.Get("pod"),
+		Container: values.Get("container"),
+	}
+
+	for _, key := range []string{"namespace", "pod", "container"} {
+		if values.Get(key) == "" {
+			return containerfs.Target{}, "", fmt.Errorf("%s is required", key)
+		}
+	}
+
+	filePath := values.Get("path")
+	if filePath == "" {
+		filePath = "/"
+	}
+	if !path.IsAbs(filePath) {
+		return containerfs.Target{}, "", fmt.Errorf("path %q is not absolute", filePath)
+	}
+
+	return target, filePath, nil
+}
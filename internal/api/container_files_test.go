@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/containerfs"
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+// containerFilesExec fakes ls, readlink and tar in a container with a file,
+// /etc/app.conf, a link to it, /etc/link.conf, and a file which can't be
+// read, /etc/secret.conf.
+func containerFilesExec(t *testing.T, uploads map[string]string) containerfs.ExecFunc {
+	return func(ctx context.Context, target containerfs.Target, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+		assert.Equal(t, containerfs.Target{Namespace: "default", Pod: "web", Container: "app"}, target)
+
+		var err error
+		switch strings.Join(command, " ") {
+		case "ls -lA -- /etc":
+			_, err = io.WriteString(stdout, "-rw-r--r--    1 root     root            11 Jan  2 15:04 app.conf\n")
+		case "ls -ld -- /etc/app.conf":
+			_, err = io.WriteString(stdout, "-rw-r--r--    1 root     root            11 Jan  2 15:04 /etc/app.conf\n")
+		case "ls -ld -- /etc/link.conf":
+			_, err = io.WriteString(stdout, "lrwxrwxrwx    1 root     root             8 Jan  2 15:04 /etc/link.conf -> app.conf\n")
+		case "readlink -f -- /etc/link.conf":
+			_, err = io.WriteString(stdout, "/etc/app.conf\n")
+		case "ls -ld -- /etc/secret.conf":
+			_, err = io.WriteString(stdout, "-r--------    1 root     root            11 Jan  2 15:04 /etc/secret.conf\n")
+		case "tar -cf - -C /etc app.conf", "tar -chf - -C /etc app.conf":
+			tw := tar.NewWriter(stdout)
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: "app.conf", Mode: 0644, Size: 11}))
+			_, err = io.WriteString(tw, "debug: true")
+			require.NoError(t, err)
+			err = tw.Close()
+		case "tar -xmf - -C /tmp":
+			tr := tar.NewReader(stdin)
+			header, err := tr.Next()
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(tr)
+			require.NoError(t, err)
+			uploads[header.Name] = string(data)
+		default:
+			_, _ = io.WriteString(stderr, "No such file or directory")
+			return assert.AnError
+		}
+
+		return err
+	}
+}
+
+func TestContainerFilesHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		body         func(t *testing.T) (io.Reader, string)
+		expectedCode int
+		check        func(t *testing.T, res *http.Response, body []byte, uploads map[string]string)
+	}{
+		{
+			name:         "list",
+			method:       http.MethodGet,
+			url:          "/containers/files?namespace=default&pod=web&container=app&path=/etc",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte, _ map[string]string) {
+				var files []containerfs.File
+				require.NoError(t, json.Unmarshal(body, &files))
+				require.Len(t, files, 1)
+				assert.Equal(t, "/etc/app.conf", files[0].Path)
+				assert.Equal(t, int64(11), files[0].Size)
+			},
+		},
+		{
+			name:         "list missing directory",
+			method:       http.MethodGet,
+			url:          "/containers/files?namespace=default&pod=web&container=app&path=/missing",
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "list without container",
+			method:       http.MethodGet,
+			url:          "/containers/files?namespace=default&pod=web",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "download file",
+			method:       http.MethodGet,
+			url:          "/containers/files/download?namespace=default&pod=web&container=app&path=/etc/app.conf",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte, _ map[string]string) {
+				assert.Equal(t, `attachment; filename="app.conf"`, res.Header.Get("Content-Disposition"))
+				assert.Equal(t, "debug: true", string(body))
+			},
+		},
+		{
+			name:         "download linked file",
+			method:       http.MethodGet,
+			url:          "/containers/files/download?namespace=default&pod=web&container=app&path=/etc/link.conf",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte, _ map[string]string) {
+				assert.Equal(t, `attachment; filename="link.conf"`, res.Header.Get("Content-Disposition"))
+				assert.Equal(t, "debug: true", string(body))
+			},
+		},
+		{
+			name:         "download unreadable file",
+			method:       http.MethodGet,
+			url:          "/containers/files/download?namespace=default&pod=web&container=app&path=/etc/secret.conf",
+			expectedCode: http.StatusInternalServerError,
+			check: func(t *testing.T, res *http.Response, body []byte, _ map[string]string) {
+				assert.Empty(t, res.Header.Get("Content-Disposition"))
+			},
+		},
+		{
+			name:         "download file as archive",
+			method:       http.MethodGet,
+			url:          "/containers/files/download?namespace=default&pod=web&container=app&path=/etc/app.conf&archive=true",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte, _ map[string]string) {
+				assert.Equal(t, `attachment; filename="app.conf.tar.gz"`, res.Header.Get("Content-Disposition"))
+				assert.Equal(t, "application/gzip", res.Header.Get("Content-Type"))
+			},
+		},
+		{
+			name:         "download missing file",
+			method:       http.MethodGet,
+			url:          "/containers/files/download?namespace=default&pod=web&container=app&path=/etc/missing",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "upload",
+			method: http.MethodPost,
+			url:    "/containers/files/upload?namespace=default&pod=web&container=app&path=/tmp",
+			body: func(t *testing.T) (io.Reader, string) {
+				var buf bytes.Buffer
+				mw := multipart.NewWriter(&buf)
+				fw, err := mw.CreateFormFile("files", "heap.hprof")
+				require.NoError(t, err)
+				_, err = io.WriteString(fw, "heap")
+				require.NoError(t, err)
+				require.NoError(t, mw.Close())
+				return &buf, mw.FormDataContentType()
+			},
+			expectedCode: http.StatusNoContent,
+			check: func(t *testing.T, res *http.Response, body []byte, uploads map[string]string) {
+				assert.Equal(t, map[string]string{"heap.hprof": "heap"}, uploads)
+			},
+		},
+		{
+			name:   "upload without files",
+			method: http.MethodPost,
+			url:    "/containers/files/upload?namespace=default&pod=web&container=app&path=/tmp",
+			body: func(t *testing.T) (io.Reader, string) {
+				var buf bytes.Buffer
+				mw := multipart.NewWriter(&buf)
+				require.NoError(t, mw.Close())
+				return &buf, mw.FormDataContentType()
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "upload with wrong method",
+			method:       http.MethodGet,
+			url:          "/containers/files/upload?namespace=default&pod=web&container=app&path=/tmp",
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+
+			uploads := map[string]string{}
+
+			h := newContainerFilesHandler(dashConfig)
+			h.newFileSystem = func() *containerfs.FileSystem {
+				return containerfs.New(containerFilesExec(t, uploads))
+			}
+
+			var body io.Reader
+			contentType := ""
+			if test.body != nil {
+				body, contentType = test.body(t)
+			}
+
+			req := httptest.NewRequest(test.method, test.url, body)
+			if contentType != "" {
+				req.Header.Set("Content-Type", contentType)
+			}
+			w := httptest.NewRecorder()
+
+			switch {
+			case strings.HasPrefix(test.url, "/containers/files/download"):
+				h.download(w, req)
+			case strings.HasPrefix(test.url, "/containers/files/upload"):
+				h.upload(w, req)
+			default:
+				h.list(w, req)
+			}
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			data, err := ioutil.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedCode, res.StatusCode, string(data))
+			if test.check != nil {
+				test.check(t, res, data, uploads)
+			}
+		})
+	}
+}
@@ -8,11 +8,15 @@ package api
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
+
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/queryer"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/vmware-tanzu/octant/internal/config"
@@ -23,8 +27,14 @@ import (
 
 type logEntry struct {
 	Timestamp *time.Time `json:"timestamp,omitempty"`
+	Pod       string     `json:"pod,omitempty"`
 	Container string     `json:"container,omitempty"`
 	Message   string     `json:"message,omitempty"`
+	// Format, Level, Caller, and Fields are set for structured log messages.
+	Format string            `json:"format,omitempty"`
+	Level  string            `json:"level,omitempty"`
+	Caller string            `json:"caller,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
 }
 
 const (
@@ -65,21 +75,11 @@ func (s *podLogsStateManager) Handlers() []octant.ClientRequestHandler {
 }
 
 func (s *podLogsStateManager) StreamPodLogsSubscribe(_ octant.State, payload action.Payload) error {
-	namespace, err := payload.String("namespace")
-	if err != nil {
-		return fmt.Errorf("getting namespace from payload: %w", err)
-	}
-	podName, err := payload.String("podName")
-	if err != nil {
-		return fmt.Errorf("getting podName from payload: %w", err)
-	}
-
-	containerName, err := payload.String("containerName")
+	eventType, err := loggingEventType(payload)
 	if err != nil {
-		return fmt.Errorf("getting containerName from payload: %w", err)
+		return err
 	}
 
-	eventType := octant.NewLoggingEventType(namespace, podName)
 	val, ok := s.podLogSubscriptions.Load(eventType)
 	if ok {
 		cancelFn, ok := val.(context.CancelFunc)
@@ -89,33 +89,28 @@ func (s *podLogsStateManager) StreamPodLogsSubscribe(_ octant.State, payload act
 		cancelFn()
 	}
 
-	key := store.KeyFromGroupVersionKind(gvk.Pod)
-	key.Name = podName
-	key.Namespace = namespace
+	logOptions, err := logOptionsFromPayload(payload)
+	if err != nil {
+		return fmt.Errorf("getting log options from payload: %w", err)
+	}
 
-	logStreamer, err := container.NewLogStreamer(s.ctx, s.config, key, containerName)
+	logStreamer, err := s.logStreamer(payload, logOptions)
 	if err != nil {
 		return fmt.Errorf("creating log streamer: %w", err)
 	}
 
-	cancelFn := s.startStream(key, logStreamer)
+	cancelFn := s.startStream(eventType, logStreamer)
 	s.podLogSubscriptions.Store(eventType, cancelFn)
 
 	return nil
 }
 
 func (s *podLogsStateManager) StreamPodLogsUnsubscribe(_ octant.State, payload action.Payload) error {
-	namespace, err := payload.String("namespace")
+	eventType, err := loggingEventType(payload)
 	if err != nil {
-		return fmt.Errorf("getting namespace from payload: %w", err)
+		return err
 	}
 
-	podName, err := payload.String("podName")
-	if err != nil {
-		return fmt.Errorf("getting podName from payload: %w", err)
-	}
-
-	eventType := octant.NewLoggingEventType(namespace, podName)
 	val, ok := s.podLogSubscriptions.Load(eventType)
 	if ok {
 		cancelFn, ok := val.(context.CancelFunc)
@@ -128,6 +123,152 @@ func (s *podLogsStateManager) StreamPodLogsUnsubscribe(_ octant.State, payload a
 	return nil
 }
 
+// loggingEventType returns the event type for a subscription. A subscription is for
+// one of:
+//   - a pod: podName
+//   - all the pods of a workload: apiVersion, kind, and name
+//   - all the pods matching a label selector: selector
+func loggingEventType(payload action.Payload) (octant.EventType, error) {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return "", fmt.Errorf("getting namespace from payload: %w", err)
+	}
+
+	kind, err := payload.OptionalString("kind")
+	if err != nil {
+		return "", fmt.Errorf("getting kind from payload: %w", err)
+	}
+
+	selector, err := payload.OptionalString("selector")
+	if err != nil {
+		return "", fmt.Errorf("getting selector from payload: %w", err)
+	}
+
+	switch {
+	case selector != "":
+		return octant.NewSelectorLoggingEventType(namespace, selector), nil
+	case kind != "" && kind != "Pod":
+		name, err := payload.String("name")
+		if err != nil {
+			return "", fmt.Errorf("getting name from payload: %w", err)
+		}
+		return octant.NewWorkloadLoggingEventType(namespace, kind, name), nil
+	default:
+		podName, err := payload.String("podName")
+		if err != nil {
+			return "", fmt.Errorf("getting podName from payload: %w", err)
+		}
+		return octant.NewLoggingEventType(namespace, podName), nil
+	}
+}
+
+// logStreamer creates a log streamer for a subscription.
+func (s *podLogsStateManager) logStreamer(payload action.Payload, logOptions container.LogOptions) (container.LogStreamer, error) {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return nil, fmt.Errorf("getting namespace from payload: %w", err)
+	}
+
+	kind, err := payload.OptionalString("kind")
+	if err != nil {
+		return nil, fmt.Errorf("getting kind from payload: %w", err)
+	}
+
+	selector, err := payload.OptionalString("selector")
+	if err != nil {
+		return nil, fmt.Errorf("getting selector from payload: %w", err)
+	}
+
+	if selector != "" || (kind != "" && kind != "Pod") {
+		resolver, err := podResolverFromPayload(s.config, payload)
+		if err != nil {
+			return nil, err
+		}
+		return container.NewMultiPodLogStreamer(s.ctx, s.config, namespace, resolver, logOptions), nil
+	}
+
+	podName, err := payload.String("podName")
+	if err != nil {
+		return nil, fmt.Errorf("getting podName from payload: %w", err)
+	}
+
+	containerName, err := payload.String("containerName")
+	if err != nil {
+		return nil, fmt.Errorf("getting containerName from payload: %w", err)
+	}
+
+	key := store.KeyFromGroupVersionKind(gvk.Pod)
+	key.Name = podName
+	key.Namespace = namespace
+
+	return container.NewLogStreamer(s.ctx, s.config, key, logOptions, containerName)
+}
+
+// podResolverFromPayload creates a pod resolver for the pods selected by a payload.
+// Pods are selected by label selector, by workload, or by pod name. If none of
+// these are set, all the pods in the namespace are selected.
+func podResolverFromPayload(dashConfig config.Dash, payload action.Payload) (container.PodResolver, error) {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return nil, fmt.Errorf("getting namespace from payload: %w", err)
+	}
+
+	kind, err := payload.OptionalString("kind")
+	if err != nil {
+		return nil, fmt.Errorf("getting kind from payload: %w", err)
+	}
+
+	selectorString, err := payload.OptionalString("selector")
+	if err != nil {
+		return nil, fmt.Errorf("getting selector from payload: %w", err)
+	}
+
+	podName, err := payload.OptionalString("podName")
+	if err != nil {
+		return nil, fmt.Errorf("getting podName from payload: %w", err)
+	}
+
+	objectStore := dashConfig.ObjectStore()
+
+	switch {
+	case selectorString != "":
+		selector, err := labels.Parse(selectorString)
+		if err != nil {
+			return nil, fmt.Errorf("parsing selector: %w", err)
+		}
+
+		return container.SelectorPodResolver(objectStore, namespace, selector), nil
+	case kind != "" && kind != "Pod":
+		if !container.IsLogWorkloadKind(kind) {
+			return nil, fmt.Errorf("can't stream logs for %s", kind)
+		}
+
+		key, err := store.KeyFromPayload(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		discoveryClient, err := dashConfig.ClusterClient().DiscoveryClient()
+		if err != nil {
+			return nil, fmt.Errorf("getting discovery client: %w", err)
+		}
+
+		newQueryer := func() queryer.Queryer {
+			return queryer.New(objectStore, discoveryClient)
+		}
+
+		return container.WorkloadPodResolver(objectStore, newQueryer, key), nil
+	case podName != "":
+		key := store.KeyFromGroupVersionKind(gvk.Pod)
+		key.Name = podName
+		key.Namespace = namespace
+
+		return container.NamedPodResolver(objectStore, key), nil
+	default:
+		return container.SelectorPodResolver(objectStore, namespace, labels.Everything()), nil
+	}
+}
+
 func (s *podLogsStateManager) Start(ctx context.Context, _ octant.State, client OctantClient) {
 	s.client = client
 	s.ctx = ctx
@@ -141,7 +282,7 @@ func (s *podLogsStateManager) streamEventsToClient(ctx context.Context, logEvent
 			done = true
 		case entry, ok := <-logCh:
 			if ok {
-				le := newLogEntry(entry.Line(), entry.Container())
+				le := newLogEntry(entry)
 				logEvent := octant.Event{
 					Type: logEventType,
 					Data: le,
@@ -155,10 +296,9 @@ func (s *podLogsStateManager) streamEventsToClient(ctx context.Context, logEvent
 	}
 }
 
-func (s *podLogsStateManager) startStream(key store.Key, logStreamer container.LogStreamer) context.CancelFunc {
+func (s *podLogsStateManager) startStream(eventType octant.EventType, logStreamer container.LogStreamer) context.CancelFunc {
 	ctx, cancelFn := context.WithCancel(s.ctx)
 
-	eventType := octant.NewLoggingEventType(key.Namespace, key.Name)
 	logCh := make(chan container.LogEntry)
 	go s.streamEventsToClient(ctx, eventType, logCh)
 
@@ -167,16 +307,127 @@ func (s *podLogsStateManager) startStream(key store.Key, logStreamer container.L
 	return cancelFn
 }
 
-func newLogEntry(message, container string) logEntry {
+// logOptionsFromPayload creates log options from a subscribe payload. All
+// options are optional:
+//   - previous: stream logs for the previously terminated container
+//   - sinceTime: RFC3339 timestamp to stream logs from
+//   - sinceSeconds: relative time in seconds to stream logs from
+//   - tailLines: number of lines from the end of the logs to start with
+//   - limitBytes: maximum number of bytes to stream for each container
+//   - include: regular expression lines must match to be sent
+//   - exclude: regular expression lines must not match to be sent
+//   - fieldFilter: comma separated filters on structured log fields, e.g. level>=warn,request_id=abc
+func logOptionsFromPayload(payload action.Payload) (container.LogOptions, error) {
+	var options container.LogOptions
+
+	previous, err := payload.OptionalBool("previous")
+	if err != nil {
+		return container.LogOptions{}, fmt.Errorf("getting previous: %w", err)
+	}
+	options.Previous = previous
+
+	sinceTime, err := payload.OptionalString("sinceTime")
+	if err != nil {
+		return container.LogOptions{}, fmt.Errorf("getting sinceTime: %w", err)
+	}
+	if sinceTime != "" {
+		t, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			return container.LogOptions{}, fmt.Errorf("parsing sinceTime: %w", err)
+		}
+		options.SinceTime = &t
+	}
+
+	for name, dest := range map[string]**int64{
+		"sinceSeconds": &options.SinceSeconds,
+		"tailLines":    &options.TailLines,
+		"limitBytes":   &options.LimitBytes,
+	} {
+		value, err := optionalPositiveInt64(payload, name)
+		if err != nil {
+			return container.LogOptions{}, err
+		}
+		*dest = value
+	}
+
+	if options.SinceTime != nil && options.SinceSeconds != nil {
+		return container.LogOptions{}, fmt.Errorf("sinceTime and sinceSeconds can't both be set")
+	}
+
+	for name, dest := range map[string]**regexp.Regexp{
+		"include": &options.Include,
+		"exclude": &options.Exclude,
+	} {
+		expr, err := payload.OptionalString(name)
+		if err != nil {
+			return container.LogOptions{}, fmt.Errorf("getting %s: %w", name, err)
+		}
+		if expr == "" {
+			continue
+		}
+
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return container.LogOptions{}, fmt.Errorf("compiling %s expression: %w", name, err)
+		}
+		*dest = re
+	}
+
+	fieldFilter, err := payload.OptionalString("fieldFilter")
+	if err != nil {
+		return container.LogOptions{}, fmt.Errorf("getting fieldFilter: %w", err)
+	}
+	fieldFilters, err := container.ParseFieldFilters(fieldFilter)
+	if err != nil {
+		return container.LogOptions{}, fmt.Errorf("parsing fieldFilter: %w", err)
+	}
+	options.FieldFilters = fieldFilters
+
+	return options, nil
+}
+
+func optionalPositiveInt64(payload action.Payload, key string) (*int64, error) {
+	if v, ok := payload[key]; !ok || v == nil {
+		return nil, nil
+	}
+
+	f, err := payload.Float64(key)
+	if err != nil {
+		return nil, fmt.Errorf("getting %s: %w", key, err)
+	}
+
+	if f <= 0 {
+		return nil, fmt.Errorf("%s must be greater than zero", key)
+	}
+
+	i := int64(f)
+	return &i, nil
+}
+
+// newLogEntry converts a log entry for the client. The entry's parsed message
+// is reused rather than parsing the line again.
+func newLogEntry(entry container.LogEntry) logEntry {
 	le := logEntry{
-		Container: container,
-		Message:   message,
+		Pod:       entry.Pod(),
+		Container: entry.Container(),
+		Message:   entry.Line(),
 		Timestamp: nil,
 	}
 	if message, ts, err := formatTimestamp(le.Message); err == nil {
 		le.Message = message
 		le.Timestamp = &ts
 	}
+
+	if structured := entry.Structured(); structured.IsStructured() {
+		le.Format = structured.Format
+		le.Level = structured.Level
+		le.Caller = structured.Caller
+		le.Fields = structured.Fields
+		if structured.Message != "" {
+			le.Message = structured.Message
+		}
+	}
+
 	return le
 }
 
@@ -2,27 +2,30 @@ package api
 
 import (
 	"context"
+	"regexp"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/container"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
 func TestContainerLogs_NewLogEntry(t *testing.T) {
-	le := newLogEntry("line", "container-name")
+	le := newLogEntry(container.NewLogEntry("container-name", "line"))
 
 	assert.Equal(t, "container-name", le.Container)
 	assert.Equal(t, "line", le.Message)
 	assert.Nil(t, le.Timestamp)
 
-	le = newLogEntry("1985-04-12T23:20:50.52Z line", "container-name")
+	le = newLogEntry(container.NewLogEntry("container-name", "1985-04-12T23:20:50.52Z line"))
 	assert.Equal(t, "container-name", le.Container)
 	assert.Equal(t, "line", le.Message)
 
@@ -31,6 +34,146 @@ func TestContainerLogs_NewLogEntry(t *testing.T) {
 	assert.Equal(t, ts.String(), le.Timestamp.String())
 }
 
+func TestContainerLogs_NewLogEntry_structured(t *testing.T) {
+	le := newLogEntry(container.NewLogEntry("container-name", `1985-04-12T23:20:50.52Z {"level":"error","msg":"failed","caller":"main.go:10","request_id":"abc"}`))
+
+	assert.Equal(t, "failed", le.Message)
+	assert.Equal(t, "json", le.Format)
+	assert.Equal(t, "error", le.Level)
+	assert.Equal(t, "main.go:10", le.Caller)
+	assert.Equal(t, map[string]string{"request_id": "abc"}, le.Fields)
+	assert.NotNil(t, le.Timestamp)
+}
+
+func TestContainerLogs_logOptionsFromPayload(t *testing.T) {
+	int64Ptr := func(i int64) *int64 { return &i }
+	sinceTime := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected container.LogOptions
+		wantErr  bool
+	}{
+		{
+			name:     "defaults",
+			payload:  action.Payload{},
+			expected: container.LogOptions{},
+		},
+		{
+			name: "query options",
+			payload: action.Payload{
+				"previous":   true,
+				"sinceTime":  "2020-05-01T10:00:00Z",
+				"tailLines":  float64(100),
+				"limitBytes": float64(1024),
+			},
+			expected: container.LogOptions{
+				Previous:   true,
+				SinceTime:  &sinceTime,
+				TailLines:  int64Ptr(100),
+				LimitBytes: int64Ptr(1024),
+			},
+		},
+		{
+			name:     "since seconds",
+			payload:  action.Payload{"sinceSeconds": float64(60)},
+			expected: container.LogOptions{SinceSeconds: int64Ptr(60)},
+		},
+		{
+			name:     "filters",
+			payload:  action.Payload{"include": "error", "exclude": "^debug"},
+			expected: container.LogOptions{Include: regexp.MustCompile("error"), Exclude: regexp.MustCompile("^debug")},
+		},
+		{
+			name:    "field filter",
+			payload: action.Payload{"fieldFilter": "level>=warn"},
+			expected: container.LogOptions{FieldFilters: []container.FieldFilter{
+				{Field: "level", Operator: ">=", Value: "warn"},
+			}},
+		},
+		{
+			name:    "invalid field filter",
+			payload: action.Payload{"fieldFilter": "level"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid expression",
+			payload: action.Payload{"include": "("},
+			wantErr: true,
+		},
+		{
+			name:    "invalid since time",
+			payload: action.Payload{"sinceTime": "yesterday"},
+			wantErr: true,
+		},
+		{
+			name:    "since time and since seconds",
+			payload: action.Payload{"sinceTime": "2020-05-01T10:00:00Z", "sinceSeconds": float64(60)},
+			wantErr: true,
+		},
+		{
+			name:    "negative tail lines",
+			payload: action.Payload{"tailLines": float64(-1)},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := logOptionsFromPayload(test.payload)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestContainerLogs_loggingEventType(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		expected octant.EventType
+		wantErr  bool
+	}{
+		{
+			name:     "pod",
+			payload:  action.Payload{"namespace": "default", "podName": "pod"},
+			expected: "event.octant.dev/logging/namespace/default/pod/pod",
+		},
+		{
+			name:     "workload",
+			payload:  action.Payload{"namespace": "default", "apiVersion": "apps/v1", "kind": "Deployment", "name": "web"},
+			expected: "event.octant.dev/logging/namespace/default/deployment/web",
+		},
+		{
+			name:     "selector",
+			payload:  action.Payload{"namespace": "default", "selector": "app=web"},
+			expected: "event.octant.dev/logging/namespace/default/selector/app=web",
+		},
+		{
+			name:    "missing namespace",
+			payload: action.Payload{"podName": "pod"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := loggingEventType(test.payload)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
 func TestContainerLogs_SendLogEventsStops(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
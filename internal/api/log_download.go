@@ -0,0 +1,355 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/modules/overview/container"
+	"github.com/vmware-tanzu/octant/pkg/action"
+)
+
+const (
+	// LogDownloadFormatText downloads logs as plain text.
+	LogDownloadFormatText = "text"
+	// LogDownloadFormatNDJSON downloads logs as newline delimited JSON.
+	LogDownloadFormatNDJSON = "ndjson"
+	// LogDownloadFormatArchive downloads logs as a gzipped tar archive with
+	// one file per container.
+	LogDownloadFormatArchive = "archive"
+)
+
+// logDownloadStreamFunc opens a log stream for a container.
+type logDownloadStreamFunc func(ctx context.Context, namespace, pod string, options *corev1.PodLogOptions) (io.ReadCloser, error)
+
+// logTarget is a container logs are downloaded for.
+type logTarget struct {
+	pod       string
+	container string
+}
+
+func (t logTarget) String() string {
+	return t.pod + "/" + t.container
+}
+
+// logDownloadHandler downloads the logs for a pod, a workload, the pods
+// matching a label selector, or all the pods in a namespace. It accepts the
+// same options as the log stream subscription as query parameters, and
+// a format parameter of text (the default), ndjson, or archive.
+type logDownloadHandler struct {
+	dashConfig config.Dash
+	openStream logDownloadStreamFunc
+}
+
+var _ http.Handler = (*logDownloadHandler)(nil)
+
+func newLogDownloadHandler(dashConfig config.Dash) *logDownloadHandler {
+	h := &logDownloadHandler{
+		dashConfig: dashConfig,
+	}
+	h.openStream = h.podLogs
+
+	return h
+}
+
+func (h *logDownloadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := h.dashConfig.Logger()
+
+	if r.Method != http.MethodGet {
+		RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		return
+	}
+
+	payload, err := logDownloadPayload(r.URL.Query())
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	format, err := payload.OptionalString("format")
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+	if format == "" {
+		format = LogDownloadFormatText
+	}
+
+	var contentType, extension string
+	switch format {
+	case LogDownloadFormatText:
+		contentType, extension = "text/plain; charset=utf-8", "log"
+	case LogDownloadFormatNDJSON:
+		contentType, extension = "application/x-ndjson", "ndjson"
+	case LogDownloadFormatArchive:
+		contentType, extension = "application/gzip", "tar.gz"
+	default:
+		RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("unknown log format %q", format), logger)
+		return
+	}
+
+	options, err := logOptionsFromPayload(payload)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	resolver, err := podResolverFromPayload(h.dashConfig, payload)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	ctx := r.Context()
+
+	pods, err := resolver(ctx)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+		return
+	}
+
+	containerName, err := payload.OptionalString("containerName")
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, err.Error(), logger)
+		return
+	}
+
+	targets := logTargets(pods, containerName, options.Previous)
+	if len(targets) == 0 {
+		RespondWithError(w, http.StatusNotFound, "no containers with logs were found", logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", logDownloadFilename(payload, namespace, extension)))
+
+	switch format {
+	case LogDownloadFormatArchive:
+		err = h.writeArchive(ctx, w, namespace, targets, options)
+	default:
+		err = h.writeLines(ctx, w, namespace, targets, options, format)
+	}
+
+	if err != nil {
+		// The response has started, so the error can only be logged.
+		logger.WithErr(err).Errorf("downloading logs")
+	}
+}
+
+// writeLines writes the logs for each container in turn. Text lines are
+// prefixed with their pod and container when there is more than one container.
+func (h *logDownloadHandler) writeLines(ctx context.Context, w io.Writer, namespace string, targets []logTarget, options container.LogOptions, format string) error {
+	bw := bufio.NewWriter(w)
+	encoder := json.NewEncoder(bw)
+
+	for _, target := range targets {
+		err := h.readLines(ctx, namespace, target, options, func(entry container.LogEntry) error {
+			switch format {
+			case LogDownloadFormatNDJSON:
+				return encoder.Encode(newLogEntry(entry))
+			default:
+				if len(targets) > 1 {
+					if _, err := fmt.Fprintf(bw, "[%s] ", target); err != nil {
+						return err
+					}
+				}
+				_, err := fmt.Fprintln(bw, entry.Line())
+				return err
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// writeArchive writes a gzipped tar archive with a file for each container.
+// A tar entry needs its size up front, so each container's logs are spooled
+// to a temporary file first.
+func (h *logDownloadHandler) writeArchive(ctx context.Context, w io.Writer, namespace string, targets []logTarget, options container.LogOptions) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	now := time.Now()
+
+	for _, target := range targets {
+		name := fmt.Sprintf("%s/%s/%s.log", namespace, target.pod, target.container)
+		if options.Previous {
+			name = fmt.Sprintf("%s/%s/%s-previous.log", namespace, target.pod, target.container)
+		}
+
+		err := h.spoolLines(ctx, namespace, target, options, func(f *os.File, size int64) error {
+			header := &tar.Header{
+				Name:    name,
+				Mode:    0644,
+				Size:    size,
+				ModTime: now,
+			}
+			if err := tw.WriteHeader(header); err != nil {
+				return fmt.Errorf("write archive header for %s: %w", target, err)
+			}
+			if _, err := io.Copy(tw, f); err != nil {
+				return fmt.Errorf("write archive file for %s: %w", target, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("close archive: %w", err)
+	}
+
+	return gw.Close()
+}
+
+// spoolLines writes the logs for a container to a temporary file, and calls
+// fn with the file positioned at its start and the file's size. The file is
+// removed once fn returns.
+func (h *logDownloadHandler) spoolLines(ctx context.Context, namespace string, target logTarget, options container.LogOptions, fn func(f *os.File, size int64) error) error {
+	f, err := ioutil.TempFile("", "octant-logs-*.log")
+	if err != nil {
+		return fmt.Errorf("create spool file for %s: %w", target, err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	bw := bufio.NewWriter(f)
+	err = h.readLines(ctx, namespace, target, options, func(entry container.LogEntry) error {
+		_, err := fmt.Fprintln(bw, entry.Line())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("spool logs for %s: %w", target, err)
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("spool logs for %s: %w", target, err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("spool logs for %s: %w", target, err)
+	}
+
+	return fn(f, size)
+}
+
+// readLines reads the logs for a container, and calls fn for each line
+// matching the log options.
+func (h *logDownloadHandler) readLines(ctx context.Context, namespace string, target logTarget, options container.LogOptions, fn func(entry container.LogEntry) error) error {
+	podLogOptions := options.PodLogOptions(target.container)
+	podLogOptions.Follow = false
+
+	stream, err := h.openStream(ctx, namespace, target.pod, podLogOptions)
+	if err != nil {
+		return fmt.Errorf("open log stream for %s: %w", target, err)
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		entry := container.NewPodLogEntry(target.pod, target.container, scanner.Text())
+		if !options.MatchesEntry(entry) {
+			continue
+		}
+
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read logs for %s: %w", target, err)
+	}
+
+	return nil
+}
+
+func (h *logDownloadHandler) podLogs(ctx context.Context, namespace, pod string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	client, err := h.dashConfig.ClusterClient().KubernetesClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.CoreV1().Pods(namespace).GetLogs(pod, options).Stream(ctx)
+}
+
+// logTargets returns the containers to download logs for. If containerName is
+// set, only containers with that name are returned.
+func logTargets(pods []corev1.Pod, containerName string, previous bool) []logTarget {
+	var targets []logTarget
+	for _, pod := range pods {
+		for _, status := range container.StreamableContainers(pod, previous) {
+			if containerName != "" && status.Name != containerName {
+				continue
+			}
+			targets = append(targets, logTarget{pod: pod.Name, container: status.Name})
+		}
+	}
+
+	return targets
+}
+
+// logDownloadPayload converts query parameters to a payload, so they can be
+// read in the same way as a subscription payload.
+func logDownloadPayload(values url.Values) (action.Payload, error) {
+	payload := action.Payload{}
+	for key := range values {
+		payload[key] = values.Get(key)
+	}
+
+	if previous, ok := payload["previous"]; ok {
+		b, err := strconv.ParseBool(previous.(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing previous: %w", err)
+		}
+		payload["previous"] = b
+	}
+
+	return payload, nil
+}
+
+func logDownloadFilename(payload action.Payload, namespace, extension string) string {
+	name := namespace
+	for _, key := range []string{"podName", "name"} {
+		if s, _ := payload.OptionalString(key); s != "" {
+			name = s
+			break
+		}
+	}
+
+	return fmt.Sprintf("%s-logs.%s", name, extension)
+}
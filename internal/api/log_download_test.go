@@ -0,0 +1,181 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func logDownloadPod(name string, containers ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+	}
+	for _, name := range containers {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:  name,
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		})
+	}
+	return pod
+}
+
+func TestLogDownloadHandler(t *testing.T) {
+	logs := map[string]string{
+		"web/app":     "2020-05-01T10:00:00Z starting\n2020-05-01T10:00:01Z {\"level\":\"error\",\"msg\":\"failed\"}\n",
+		"web/sidecar": "2020-05-01T10:00:02Z proxy ready\n",
+	}
+
+	tests := []struct {
+		name         string
+		query        string
+		expectedCode int
+		check        func(t *testing.T, res *http.Response, body []byte)
+	}{
+		{
+			name:         "pod container text",
+			query:        "namespace=default&podName=web&containerName=app",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte) {
+				assert.Equal(t, `attachment; filename="web-logs.log"`, res.Header.Get("Content-Disposition"))
+				assert.Equal(t, logs["web/app"], string(body))
+			},
+		},
+		{
+			name:         "pod text with filter",
+			query:        "namespace=default&podName=web&include=ready",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte) {
+				assert.Equal(t, "[web/sidecar] 2020-05-01T10:00:02Z proxy ready\n", string(body))
+			},
+		},
+		{
+			name:         "ndjson",
+			query:        "namespace=default&podName=web&containerName=app&format=ndjson&fieldFilter=level>=warn",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte) {
+				assert.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+
+				var le logEntry
+				require.NoError(t, json.Unmarshal(body, &le))
+				assert.Equal(t, "web", le.Pod)
+				assert.Equal(t, "app", le.Container)
+				assert.Equal(t, "failed", le.Message)
+				assert.Equal(t, "error", le.Level)
+				assert.NotNil(t, le.Timestamp)
+			},
+		},
+		{
+			name:         "namespace archive",
+			query:        "namespace=default&format=archive",
+			expectedCode: http.StatusOK,
+			check: func(t *testing.T, res *http.Response, body []byte) {
+				assert.Equal(t, `attachment; filename="default-logs.tar.gz"`, res.Header.Get("Content-Disposition"))
+
+				gr, err := gzip.NewReader(strings.NewReader(string(body)))
+				require.NoError(t, err)
+				tr := tar.NewReader(gr)
+
+				files := map[string]string{}
+				for {
+					header, err := tr.Next()
+					if err == io.EOF {
+						break
+					}
+					require.NoError(t, err)
+
+					data, err := ioutil.ReadAll(tr)
+					require.NoError(t, err)
+					files[header.Name] = string(data)
+				}
+
+				expected := map[string]string{
+					"default/web/app.log":     logs["web/app"],
+					"default/web/sidecar.log": logs["web/sidecar"],
+				}
+				assert.Equal(t, expected, files)
+			},
+		},
+		{
+			name:         "unknown format",
+			query:        "namespace=default&podName=web&format=xml",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid options",
+			query:        "namespace=default&podName=web&include=(",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unknown container",
+			query:        "namespace=default&podName=web&containerName=missing",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			pod := logDownloadPod("web", "app", "sidecar")
+
+			objectStore := storeFake.NewMockStore(controller)
+			objectStore.EXPECT().
+				Get(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "web"}).
+				Return(testutil.ToUnstructured(t, pod), nil).AnyTimes()
+			objectStore.EXPECT().
+				List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}).
+				Return(testutil.ToUnstructuredList(t, pod), false, nil).AnyTimes()
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+			dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+
+			h := newLogDownloadHandler(dashConfig)
+			h.openStream = func(ctx context.Context, namespace, pod string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+				assert.False(t, options.Follow)
+				return ioutil.NopCloser(strings.NewReader(logs[pod+"/"+options.Container])), nil
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/logs/download?"+test.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, err := ioutil.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedCode, res.StatusCode, string(body))
+			if test.check != nil {
+				test.check(t, res, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// nodeDebuggerIdleTimeout is how long a node debugger pod is kept after its
+// last terminal session ends, so reloading the page or switching back to its
+// terminal doesn't lose the pod.
+const nodeDebuggerIdleTimeout = 5 * time.Minute
+
+// nodeDebuggers tracks the terminal sessions of node debugger pods for all
+// clients.
+var nodeDebuggers = newNodeDebuggerSessions(nodeDebuggerIdleTimeout)
+
+// nodeDebuggerSessions counts the terminal sessions of node debugger pods. A
+// pod is deleted once it has had no sessions for the idle timeout.
+type nodeDebuggerSessions struct {
+	idleTimeout time.Duration
+
+	mu       sync.Mutex
+	sessions map[store.Key]int
+	timers   map[store.Key]*time.Timer
+}
+
+func newNodeDebuggerSessions(idleTimeout time.Duration) *nodeDebuggerSessions {
+	return &nodeDebuggerSessions{
+		idleTimeout: idleTimeout,
+		sessions:    make(map[store.Key]int),
+		timers:      make(map[store.Key]*time.Timer),
+	}
+}
+
+// start records a session for a pod. A pending delete of the pod is stopped.
+func (n *nodeDebuggerSessions) start(key store.Key) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.sessions[key]++
+	if timer, ok := n.timers[key]; ok {
+		timer.Stop()
+		delete(n.timers, key)
+	}
+}
+
+// end records the end of a session for a pod. If it was the pod's last
+// session, deletePod is called after the idle timeout unless another session
+// starts first.
+func (n *nodeDebuggerSessions) end(key store.Key, deletePod func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.sessions[key]--
+	if n.sessions[key] > 0 {
+		return
+	}
+	delete(n.sessions, key)
+
+	var timer *time.Timer
+	timer = time.AfterFunc(n.idleTimeout, func() {
+		n.mu.Lock()
+		if n.timers[key] != timer {
+			n.mu.Unlock()
+			return
+		}
+		delete(n.timers, key)
+		n.mu.Unlock()
+
+		deletePod()
+	})
+	n.timers[key] = timer
+}
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+func Test_nodeDebuggerSessions(t *testing.T) {
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "node-debugger"}
+
+	idleTimeout := 50 * time.Millisecond
+	n := newNodeDebuggerSessions(idleTimeout)
+
+	deleted := make(chan struct{}, 2)
+	deletePod := func() {
+		deleted <- struct{}{}
+	}
+
+	// A second session keeps the pod after the first one ends.
+	n.start(key)
+	n.start(key)
+	n.end(key, deletePod)
+
+	select {
+	case <-deleted:
+		t.Fatal("pod was deleted while it had a session")
+	case <-time.After(2 * idleTimeout):
+	}
+
+	// A session which starts within the idle timeout keeps the pod.
+	n.end(key, deletePod)
+	n.start(key)
+
+	select {
+	case <-deleted:
+		t.Fatal("pod was deleted after a new session started")
+	case <-time.After(2 * idleTimeout):
+	}
+
+	n.end(key, deletePod)
+
+	select {
+	case <-deleted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("pod was not deleted after the idle timeout")
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	assert.Empty(t, n.sessions)
+	assert.Empty(t, n.timers)
+}
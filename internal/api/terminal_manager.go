@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/octant/internal/config"
 	"github.com/vmware-tanzu/octant/internal/gvk"
@@ -134,19 +136,93 @@ func (s *terminalStateManager) startStream(key store.Key, container string) cont
 
 	eventType := octant.NewTerminalEventType(key.Namespace, key.Name, container)
 
-	instance, err := terminal.NewTerminalInstance(ctx, s.config.ClusterClient(), logger, key, container, "/bin/sh", s.chanInstance)
+	var options []terminal.InstanceOption
+	var recorder *terminal.Recorder
+	if dir := s.config.TerminalRecordingDir(); dir != "" {
+		source := terminal.RecordingSource{
+			Namespace: key.Namespace,
+			Pod:       key.Name,
+			Container: container,
+		}
+
+		var err error
+		recorder, err = terminal.CreateRecorder(dir, source, "/bin/sh")
+		if err != nil {
+			logger.WithErr(err).Errorf("unable to record terminal session")
+		} else {
+			options = append(options, terminal.WithRecorder(recorder))
+		}
+	}
+
+	instance, err := terminal.NewTerminalInstance(ctx, s.config.ClusterClient(), logger, key, container, "/bin/sh", s.chanInstance, options...)
 	if err != nil {
 		cancelFn()
+		if err := recorder.Discard(); err != nil {
+			logger.WithErr(err).Errorf("discarding terminal recording")
+		}
 		return cancelFn
 	}
 
 	s.instance = instance
 
+	if s.isNodeDebugger(ctx, key) {
+		nodeDebuggers.start(key)
+		go s.cleanupNodeDebugger(key, instance)
+	}
+
 	go s.sendTerminalEvents(ctx, eventType, instance, s.chanInstance)
 
 	return cancelFn
 }
 
+// isNodeDebugger returns true if the pod was created to debug a node.
+func (s *terminalStateManager) isNodeDebugger(ctx context.Context, key store.Key) bool {
+	object, err := s.config.ObjectStore().Get(ctx, key)
+	if err != nil || object == nil {
+		return false
+	}
+
+	_, ok := object.GetLabels()[octant.NodeDebuggerLabel]
+	return ok
+}
+
+// cleanupNodeDebugger ends the node debugger session once its terminal
+// instance ends. The pod is deleted if no other session starts within the
+// idle timeout.
+func (s *terminalStateManager) cleanupNodeDebugger(key store.Key, instance terminal.Instance) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if !instance.Active() {
+			break
+		}
+	}
+
+	nodeDebuggers.end(key, func() {
+		s.deleteNodeDebugger(key)
+	})
+}
+
+// deleteNodeDebugger deletes a node debugger pod.
+func (s *terminalStateManager) deleteNodeDebugger(key store.Key) {
+	logger := log.From(s.ctx).With("nodeDebugger", key.Name)
+
+	client, err := s.config.ClusterClient().KubernetesClient()
+	if err != nil {
+		logger.WithErr(err).Errorf("unable to delete node debugger pod")
+		return
+	}
+
+	err = client.CoreV1().Pods(key.Namespace).Delete(context.Background(), key.Name, metav1.DeleteOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		logger.WithErr(err).Errorf("unable to delete node debugger pod")
+		return
+	}
+
+	logger.Infof("deleted node debugger pod")
+}
+
 func (s *terminalStateManager) SendTerminalResize(state octant.State, payload action.Payload) error {
 	if s.instance == nil {
 		return errors.New("terminal instance not found")
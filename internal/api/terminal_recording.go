@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/terminal"
+)
+
+// terminalRecordingHandler serves a terminal recording in the asciicast v2
+// format. The recording is named by the name query parameter.
+type terminalRecordingHandler struct {
+	dashConfig config.Dash
+}
+
+var _ http.Handler = (*terminalRecordingHandler)(nil)
+
+func newTerminalRecordingHandler(dashConfig config.Dash) *terminalRecordingHandler {
+	return &terminalRecordingHandler{
+		dashConfig: dashConfig,
+	}
+}
+
+func (h *terminalRecordingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := h.dashConfig.Logger()
+
+	if r.Method != http.MethodGet {
+		RespondWithError(w, http.StatusMethodNotAllowed, "method not allowed", logger)
+		return
+	}
+
+	dir := h.dashConfig.TerminalRecordingDir()
+	if dir == "" {
+		RespondWithError(w, http.StatusNotFound, "terminal sessions are not being recorded", logger)
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	f, err := terminal.OpenRecording(dir, name)
+	if err != nil {
+		code := http.StatusBadRequest
+		if os.IsNotExist(err) {
+			code = http.StatusNotFound
+		}
+		RespondWithError(w, code, fmt.Sprintf("open terminal recording: %s", err), logger)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error(), logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-asciicast")
+	http.ServeContent(w, r, info.Name(), info.ModTime(), f)
+}
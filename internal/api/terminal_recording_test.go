@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+func TestTerminalRecordingHandler(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cast := `{"version":2,"width":80,"height":24}` + "\n" + `[0.5,"o","$ "]` + "\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "session.cast"), []byte(cast), 0600))
+
+	tests := []struct {
+		name         string
+		dir          string
+		query        string
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "in general",
+			dir:          dir,
+			query:        "name=session",
+			expectedCode: http.StatusOK,
+			expectedBody: cast,
+		},
+		{
+			name:         "missing recording",
+			dir:          dir,
+			query:        "name=missing",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "invalid name",
+			dir:          dir,
+			query:        "name=../session",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "not recording",
+			query:        "name=session",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dashConfig := configFake.NewMockDash(controller)
+			dashConfig.EXPECT().TerminalRecordingDir().Return(test.dir).AnyTimes()
+			dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+
+			h := newTerminalRecordingHandler(dashConfig)
+
+			req := httptest.NewRequest(http.MethodGet, "/terminal-recordings?"+test.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, err := ioutil.ReadAll(res.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedCode, res.StatusCode, string(body))
+			if test.expectedBody != "" {
+				assert.Equal(t, "application/x-asciicast", res.Header.Get("Content-Type"))
+				assert.Equal(t, test.expectedBody, string(body))
+			}
+		})
+	}
+}
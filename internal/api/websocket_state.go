@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
@@ -161,6 +162,7 @@ func NewTemporaryWebsocketState(actionDispatcher ActionDispatcher, wsClient Octa
 
 // Start starts WebsocketState by starting all associated StateManagers.
 func (c *WebsocketState) Start(ctx context.Context) {
+	ctx = ocontext.WithClientID(ctx, c.wsClient.ID())
 	for i := range c.managers {
 		go c.managers[i].Start(ctx, c, c.wsClient)
 	}
@@ -179,6 +181,7 @@ func (c *WebsocketState) Handlers() []octant.ClientRequestHandler {
 
 // Dispatch dispatches a message.
 func (c *WebsocketState) Dispatch(ctx context.Context, actionName string, payload action.Payload) error {
+	ctx = ocontext.WithClientID(ctx, c.wsClient.ID())
 	return c.actionDispatcher.Dispatch(ctx, c, actionName, payload)
 }
 
@@ -382,6 +385,11 @@ func (c *WebsocketState) SendAlert(alert action.Alert) {
 	c.wsClient.Send(CreateAlertUpdate(alert))
 }
 
+// SendEvent sends an event to the websocket client.
+func (c *WebsocketState) SendEvent(event octant.Event) {
+	c.wsClient.Send(event)
+}
+
 func updateContentPathNamespace(in, namespace string) string {
 	parts := strings.Split(in, "/")
 	if in == "" {
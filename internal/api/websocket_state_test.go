@@ -18,6 +18,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/api"
 	"github.com/vmware-tanzu/octant/internal/api/fake"
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/log"
 	moduleFake "github.com/vmware-tanzu/octant/internal/module/fake"
 	"github.com/vmware-tanzu/octant/internal/octant"
@@ -27,9 +28,12 @@ func TestWebsocketState_Start(t *testing.T) {
 	mocks := newWebsocketStateMocks(t, "default")
 	defer mocks.finish()
 
+	mocks.wsClient.EXPECT().ID().Return("client-id")
+
 	started := make(chan bool, 1)
 	mocks.stateManager.EXPECT().Start(gomock.Any(), gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, state octant.State, wsClient api.OctantClient) {
+			assert.Equal(t, "client-id", ocontext.ClientIDFrom(ctx))
 			started <- true
 		})
 	s := mocks.factory()
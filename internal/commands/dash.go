@@ -91,6 +91,7 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 					Context:                viper.GetString("context"),
 					ClientQPS:              float32(viper.GetFloat64("client-qps")),
 					ClientBurst:            viper.GetInt("client-burst"),
+					TerminalRecordingDir:   viper.GetString("terminal-recording-dir"),
 					UserAgent:              fmt.Sprintf("octant/%s", version),
 					BuildInfo:              buildInfo,
 				}
@@ -166,6 +167,7 @@ func newOctantCmd(version string, gitCommit string, buildTime string) *cobra.Com
 	octantCmd.Flags().StringP("proxy-frontend", "", "", "url to send frontend request to [DEV]")
 	octantCmd.Flags().String("ui-url", "", "dashboard url [DEV]")
 	octantCmd.Flags().String("browser-path", "", "the browser path to open the browser on")
+	octantCmd.Flags().String("terminal-recording-dir", "", "directory to record terminal sessions to in asciicast format")
 
 	return octantCmd
 }
@@ -106,6 +106,8 @@ type Dash interface {
 	ModuleManager() module.ManagerInterface
 
 	BuildInfo() (string, string, string)
+
+	TerminalRecordingDir() string
 }
 
 // Live is a live version of dash config.
@@ -122,6 +124,8 @@ type Live struct {
 	currentContextName string
 	restConfigOptions  cluster.RESTConfigOptions
 	buildInfo          BuildInfo
+
+	terminalRecordingDir string
 }
 
 var _ Dash = (*Live)(nil)
@@ -140,6 +144,7 @@ func NewLiveConfig(
 	currentContextName string,
 	restConfigOptions cluster.RESTConfigOptions,
 	buildInfo BuildInfo,
+	terminalRecordingDir string,
 ) *Live {
 	l := &Live{
 		clusterClient:      clusterClient,
@@ -154,6 +159,8 @@ func NewLiveConfig(
 		currentContextName: currentContextName,
 		restConfigOptions:  restConfigOptions,
 		buildInfo:          buildInfo,
+
+		terminalRecordingDir: terminalRecordingDir,
 	}
 	objectStore.RegisterOnUpdate(func(store store.Store) {
 		l.objectStore = store
@@ -291,3 +298,9 @@ func (l *Live) ModuleManager() module.ManagerInterface {
 func (l *Live) BuildInfo() (string, string, string) {
 	return l.buildInfo.Version, l.buildInfo.Commit, l.buildInfo.Time
 }
+
+// TerminalRecordingDir returns the directory terminal sessions are recorded to.
+// Sessions aren't recorded if it is empty.
+func (l *Live) TerminalRecordingDir() string {
+	return l.terminalRecordingDir
+}
@@ -96,7 +96,7 @@ func TestLiveConfig(t *testing.T) {
 
 	config := NewLiveConfig(clusterClient, crdWatcher, kubeConfigPath, logger, moduleManager, objectStore,
 		errorStore, pluginManager, portForwarder,
-		contextName, restConfigOptions, buildInfo)
+		contextName, restConfigOptions, buildInfo, "/recordings")
 
 	assert.NoError(t, config.Validate())
 	assert.Equal(t, clusterClient, config.ClusterClient())
@@ -105,6 +105,7 @@ func TestLiveConfig(t *testing.T) {
 	assert.Equal(t, objectStore, config.ObjectStore())
 	assert.Equal(t, pluginManager, config.PluginManager())
 	assert.Equal(t, portForwarder, config.PortForwarder())
+	assert.Equal(t, "/recordings", config.TerminalRecordingDir())
 
 	objectPath, err := config.ObjectPath("", "", "", "")
 	require.NoError(t, err)
@@ -228,6 +228,20 @@ func (mr *MockDashMockRecorder) PortForwarder() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForwarder", reflect.TypeOf((*MockDash)(nil).PortForwarder))
 }
 
+// TerminalRecordingDir mocks base method
+func (m *MockDash) TerminalRecordingDir() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TerminalRecordingDir")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TerminalRecordingDir indicates an expected call of TerminalRecordingDir
+func (mr *MockDashMockRecorder) TerminalRecordingDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminalRecordingDir", reflect.TypeOf((*MockDash)(nil).TerminalRecordingDir))
+}
+
 // UseContext mocks base method
 func (m *MockDash) UseContext(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
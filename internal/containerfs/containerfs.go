@@ -0,0 +1,389 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package containerfs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/terminal"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// Target is a container files are listed in and copied to and from.
+type Target struct {
+	Namespace string
+	Pod       string
+	Container string
+}
+
+// ExecFunc runs a command in a container. Stdin is optional.
+type ExecFunc func(ctx context.Context, target Target, command []string, stdin io.Reader, stdout, stderr io.Writer) error
+
+// ClusterExec creates an ExecFunc which runs commands with the exec subresource.
+func ClusterExec(client cluster.ClientInterface) ExecFunc {
+	return func(ctx context.Context, target Target, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+		key := store.Key{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Namespace:  target.Namespace,
+			Name:       target.Pod,
+		}
+
+		return terminal.Exec(ctx, client, key, target.Container, command, stdin, stdout, stderr)
+	}
+}
+
+// File is a file in a container.
+type File struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	Mode       string `json:"mode"`
+	Owner      string `json:"owner"`
+	Group      string `json:"group"`
+	Size       int64  `json:"size"`
+	Modified   string `json:"modified"`
+	IsDir      bool   `json:"isDir"`
+	LinkTarget string `json:"linkTarget,omitempty"`
+}
+
+// FileSystem lists and copies files in containers. Like kubectl cp, it
+// requires ls and tar in the container, and readlink to resolve links.
+type FileSystem struct {
+	exec ExecFunc
+}
+
+// New creates an instance of FileSystem.
+func New(exec ExecFunc) *FileSystem {
+	return &FileSystem{
+		exec: exec,
+	}
+}
+
+// List lists the files in a directory.
+func (fs *FileSystem) List(ctx context.Context, target Target, dir string) ([]File, error) {
+	dir, err := cleanPath(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := fs.run(ctx, target, []string{"ls", "-lA", "--", dir}, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %s", dir)
+	}
+
+	return parseListing(dir, out)
+}
+
+// Stat returns the file at a path. Symbolic links are resolved: the file
+// describes the link's target, and its path is the target's path.
+func (fs *FileSystem) Stat(ctx context.Context, target Target, filePath string) (File, error) {
+	filePath, err := cleanPath(filePath)
+	if err != nil {
+		return File{}, err
+	}
+
+	file, err := fs.stat(ctx, target, filePath)
+	if err != nil {
+		return File{}, err
+	}
+
+	if file.LinkTarget == "" {
+		return file, nil
+	}
+
+	out, err := fs.run(ctx, target, []string{"readlink", "-f", "--", filePath}, nil)
+	if err != nil {
+		return File{}, errors.Wrapf(err, "resolve %s", filePath)
+	}
+
+	resolved, err := cleanPath(strings.TrimSpace(out))
+	if err != nil {
+		return File{}, errors.Wrapf(err, "resolve %s", filePath)
+	}
+
+	linkTarget := file.LinkTarget
+	file, err = fs.stat(ctx, target, resolved)
+	if err != nil {
+		return File{}, err
+	}
+
+	file.Name = path.Base(filePath)
+	file.LinkTarget = linkTarget
+
+	return file, nil
+}
+
+// stat returns the file at a path without resolving symbolic links.
+func (fs *FileSystem) stat(ctx context.Context, target Target, filePath string) (File, error) {
+	out, err := fs.run(ctx, target, []string{"ls", "-ld", "--", filePath}, nil)
+	if err != nil {
+		return File{}, errors.Wrapf(err, "stat %s", filePath)
+	}
+
+	files, err := parseListing(path.Dir(filePath), out)
+	if err != nil {
+		return File{}, err
+	}
+	if len(files) != 1 {
+		return File{}, errors.Errorf("stat %s: unexpected ls output", filePath)
+	}
+
+	file := files[0]
+	file.Name = path.Base(filePath)
+	file.Path = filePath
+
+	return file, nil
+}
+
+// DownloadArchive writes a file or a directory to w as a gzipped tar archive.
+func (fs *FileSystem) DownloadArchive(ctx context.Context, target Target, filePath string, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+
+	if err := fs.tar(ctx, target, filePath, false, gz); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// DownloadFile writes the contents of a single file to w. A symbolic link is
+// followed to the file it points to. Nothing is written to w if the file
+// can't be read.
+func (fs *FileSystem) DownloadFile(ctx context.Context, target Target, filePath string, w io.Writer) error {
+	pr, pw := io.Pipe()
+
+	go func() {
+		pw.CloseWithError(fs.tar(ctx, target, filePath, true, pw))
+	}()
+
+	defer pr.Close()
+
+	tr := tar.NewReader(pr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return errors.Errorf("%s is not a regular file", filePath)
+		}
+		if err != nil {
+			return errors.Wrap(err, "read archive")
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if _, err := io.Copy(w, tr); err != nil {
+			return errors.Wrap(err, "copy file")
+		}
+
+		// Drain the rest of the archive so the command can exit.
+		_, err = io.Copy(ioutil.Discard, pr)
+		return err
+	}
+}
+
+// Upload writes a file named name to a directory.
+func (fs *FileSystem) Upload(ctx context.Context, target Target, dir, name string, r io.Reader, size int64) error {
+	dir, err := cleanPath(dir)
+	if err != nil {
+		return err
+	}
+
+	name = path.Base(name)
+	if name == "." || name == "/" || name == ".." {
+		return errors.Errorf("invalid file name %q", name)
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	go func() {
+		tw := tar.NewWriter(pw)
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    size,
+			ModTime: time.Now(),
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		if _, err := io.CopyN(tw, r, size); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+
+		pw.CloseWithError(tw.Close())
+	}()
+
+	if _, err := fs.run(ctx, target, []string{"tar", "-xmf", "-", "-C", dir}, pr); err != nil {
+		return errors.Wrapf(err, "upload %s to %s", name, dir)
+	}
+
+	return nil
+}
+
+// tar writes a tar archive of a file or a directory to w. If dereference is
+// true, symbolic links are archived as the files they point to.
+func (fs *FileSystem) tar(ctx context.Context, target Target, filePath string, dereference bool, w io.Writer) error {
+	filePath, err := cleanPath(filePath)
+	if err != nil {
+		return err
+	}
+
+	flags := "-cf"
+	if dereference {
+		flags = "-chf"
+	}
+
+	var stderr bytes.Buffer
+	command := []string{"tar", flags, "-", "-C", path.Dir(filePath), path.Base(filePath)}
+	if err := fs.exec(ctx, target, command, nil, w, &stderr); err != nil {
+		return commandError(errors.Wrapf(err, "archive %s", filePath), &stderr)
+	}
+
+	return nil
+}
+
+func (fs *FileSystem) run(ctx context.Context, target Target, command []string, stdin io.Reader) (string, error) {
+	var stdout, stderr bytes.Buffer
+	if err := fs.exec(ctx, target, command, stdin, &stdout, &stderr); err != nil {
+		return "", commandError(err, &stderr)
+	}
+
+	return stdout.String(), nil
+}
+
+func commandError(err error, stderr *bytes.Buffer) error {
+	if message := strings.TrimSpace(stderr.String()); message != "" {
+		return errors.Wrap(err, message)
+	}
+
+	return err
+}
+
+// cleanPath cleans an absolute path in a container.
+func cleanPath(p string) (string, error) {
+	if p == "" {
+		return "/", nil
+	}
+
+	if !path.IsAbs(p) {
+		return "", errors.Errorf("path %q is not absolute", p)
+	}
+
+	return path.Clean(p), nil
+}
+
+// parseListing parses the output of ls -l for the files in dir.
+func parseListing(dir, out string) ([]File, error) {
+	var files []File
+
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" || strings.HasPrefix(line, "total ") {
+			continue
+		}
+
+		file, err := parseListingLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		file.Path = path.Join(dir, file.Name)
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// parseListingLine parses a line of ls -l output, e.g.
+// "drwxr-xr-x    2 root     root          4096 Jan  2 15:04 bin".
+func parseListingLine(line string) (File, error) {
+	fields, rest := splitFields(line, 5)
+	if len(fields) < 5 {
+		return File{}, errors.Errorf("unable to parse ls output %q", line)
+	}
+
+	// Device files list their major and minor numbers instead of a size.
+	size := fields[4]
+	if strings.HasSuffix(size, ",") {
+		var minor []string
+		minor, rest = splitFields(rest, 1)
+		if len(minor) < 1 {
+			return File{}, errors.Errorf("unable to parse ls output %q", line)
+		}
+		size = "0"
+	}
+
+	var modified []string
+	modified, rest = splitFields(rest, 3)
+	if len(modified) < 3 || rest == "" {
+		return File{}, errors.Errorf("unable to parse ls output %q", line)
+	}
+
+	file := File{
+		Name:     rest,
+		Mode:     fields[0],
+		Owner:    fields[2],
+		Group:    fields[3],
+		Modified: strings.Join(modified, " "),
+		IsDir:    strings.HasPrefix(fields[0], "d"),
+	}
+
+	if n, err := strconv.ParseInt(size, 10, 64); err == nil {
+		file.Size = n
+	}
+
+	if strings.HasPrefix(file.Mode, "l") {
+		if i := strings.Index(file.Name, " -> "); i != -1 {
+			file.LinkTarget = file.Name[i+4:]
+			file.Name = file.Name[:i]
+		}
+	}
+
+	file.Name = path.Base(file.Name)
+
+	return file, nil
+}
+
+// splitFields splits up to n whitespace separated fields from the start of s
+// and returns them with the remainder of s.
+func splitFields(s string, n int) ([]string, string) {
+	var fields []string
+
+	for len(fields) < n {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if s == "" {
+			break
+		}
+
+		end := strings.IndexFunc(s, unicode.IsSpace)
+		if end == -1 {
+			end = len(s)
+		}
+
+		fields = append(fields, s[:end])
+		s = s[end:]
+	}
+
+	return fields, strings.TrimLeftFunc(s, unicode.IsSpace)
+}
@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package containerfs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var target = Target{Namespace: "default", Pod: "pod", Container: "app"}
+
+type execCall struct {
+	command []string
+	stdin   []byte
+}
+
+// fakeExec records the commands it runs and replies with stdout, stderr and err.
+func fakeExec(t *testing.T, calls *[]execCall, stdout []byte, stderr string, err error) ExecFunc {
+	return func(ctx context.Context, got Target, command []string, stdin io.Reader, w, errW io.Writer) error {
+		assert.Equal(t, target, got)
+
+		call := execCall{command: command}
+		if stdin != nil {
+			data, readErr := ioutil.ReadAll(stdin)
+			require.NoError(t, readErr)
+			call.stdin = data
+		}
+		*calls = append(*calls, call)
+
+		_, writeErr := w.Write(stdout)
+		require.NoError(t, writeErr)
+		_, writeErr = errW.Write([]byte(stderr))
+		require.NoError(t, writeErr)
+
+		return err
+	}
+}
+
+// scriptedExec replies to each command with its stdout in outputs. Other
+// commands fail.
+func scriptedExec(t *testing.T, calls *[]execCall, outputs map[string]string) ExecFunc {
+	return func(ctx context.Context, got Target, command []string, stdin io.Reader, w, errW io.Writer) error {
+		assert.Equal(t, target, got)
+		*calls = append(*calls, execCall{command: command})
+
+		out, ok := outputs[strings.Join(command, " ")]
+		if !ok {
+			_, _ = io.WriteString(errW, "No such file or directory")
+			return errors.New("exit code 1")
+		}
+
+		_, err := io.WriteString(w, out)
+		require.NoError(t, err)
+		return nil
+	}
+}
+
+func createArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "dir/", Typeflag: tar.TypeDir, Mode: 0755}))
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(contents))}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+
+	return buf.Bytes()
+}
+
+func TestFileSystem_List(t *testing.T) {
+	out := strings.Join([]string{
+		"total 12",
+		"drwxr-xr-x    2 root     root          4096 Jan  2 15:04 bin",
+		"-rw-r--r--    1 app      app       1048576 Mar 10  2019 heap dump.hprof",
+		"lrwxrwxrwx    1 root     root            12 Jan  2 15:04 current -> /app/v1",
+		"crw-rw-rw-    1 root     root        1,   3 Jan  2 15:04 null",
+		"",
+	}, "\n")
+
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, []byte(out), "", nil))
+
+	files, err := fs.List(context.Background(), target, "/tmp/")
+	require.NoError(t, err)
+
+	expected := []File{
+		{Name: "bin", Path: "/tmp/bin", Mode: "drwxr-xr-x", Owner: "root", Group: "root", Size: 4096, Modified: "Jan 2 15:04", IsDir: true},
+		{Name: "heap dump.hprof", Path: "/tmp/heap dump.hprof", Mode: "-rw-r--r--", Owner: "app", Group: "app", Size: 1048576, Modified: "Mar 10 2019"},
+		{Name: "current", Path: "/tmp/current", Mode: "lrwxrwxrwx", Owner: "root", Group: "root", Size: 12, Modified: "Jan 2 15:04", LinkTarget: "/app/v1"},
+		{Name: "null", Path: "/tmp/null", Mode: "crw-rw-rw-", Owner: "root", Group: "root", Modified: "Jan 2 15:04"},
+	}
+	assert.Equal(t, expected, files)
+
+	require.Len(t, calls, 1)
+	assert.Equal(t, []string{"ls", "-lA", "--", "/tmp"}, calls[0].command)
+}
+
+func TestFileSystem_List_errors(t *testing.T) {
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, nil, "ls: /missing: No such file or directory", errors.New("command terminated with exit code 1")))
+
+	_, err := fs.List(context.Background(), target, "/missing")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No such file or directory")
+
+	_, err = fs.List(context.Background(), target, "relative")
+	require.Error(t, err)
+}
+
+func TestFileSystem_Stat(t *testing.T) {
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, []byte("drwxr-xr-x    2 root     root          4096 Jan  2 15:04 /var/log\n"), "", nil))
+
+	file, err := fs.Stat(context.Background(), target, "/var/log")
+	require.NoError(t, err)
+
+	assert.Equal(t, "log", file.Name)
+	assert.Equal(t, "/var/log", file.Path)
+	assert.True(t, file.IsDir)
+	assert.Equal(t, []string{"ls", "-ld", "--", "/var/log"}, calls[0].command)
+}
+
+func TestFileSystem_Stat_link(t *testing.T) {
+	var calls []execCall
+	fs := New(scriptedExec(t, &calls, map[string]string{
+		"ls -ld -- /etc/config/app.conf":              "lrwxrwxrwx    1 root     root            15 Jan  2 15:04 /etc/config/app.conf -> ..data/app.conf\n",
+		"readlink -f -- /etc/config/app.conf":         "/etc/config/..2020_01_02/app.conf\n",
+		"ls -ld -- /etc/config/..2020_01_02/app.conf": "-rw-r--r--    1 root     root            11 Jan  2 15:04 /etc/config/..2020_01_02/app.conf\n",
+	}))
+
+	file, err := fs.Stat(context.Background(), target, "/etc/config/app.conf")
+	require.NoError(t, err)
+
+	assert.Equal(t, "app.conf", file.Name)
+	assert.Equal(t, "/etc/config/..2020_01_02/app.conf", file.Path)
+	assert.Equal(t, "..data/app.conf", file.LinkTarget)
+	assert.Equal(t, int64(11), file.Size)
+	assert.False(t, file.IsDir)
+}
+
+func TestFileSystem_DownloadArchive(t *testing.T) {
+	archive := createArchive(t, map[string]string{"dir/config.yaml": "key: value"})
+
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, archive, "", nil))
+
+	var buf bytes.Buffer
+	require.NoError(t, fs.DownloadArchive(context.Background(), target, "/etc/dir", &buf))
+
+	gz, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	got, err := ioutil.ReadAll(gz)
+	require.NoError(t, err)
+	assert.Equal(t, archive, got)
+
+	assert.Equal(t, []string{"tar", "-cf", "-", "-C", "/etc", "dir"}, calls[0].command)
+}
+
+func TestFileSystem_DownloadFile(t *testing.T) {
+	archive := createArchive(t, map[string]string{"config.yaml": "key: value"})
+
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, archive, "", nil))
+
+	var buf bytes.Buffer
+	require.NoError(t, fs.DownloadFile(context.Background(), target, "/etc/config.yaml", &buf))
+	assert.Equal(t, "key: value", buf.String())
+
+	assert.Equal(t, []string{"tar", "-chf", "-", "-C", "/etc", "config.yaml"}, calls[0].command)
+}
+
+func TestFileSystem_DownloadFile_error(t *testing.T) {
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, nil, "tar: /etc/missing: No such file or directory", errors.New("exit code 1")))
+
+	var buf bytes.Buffer
+	err := fs.DownloadFile(context.Background(), target, "/etc/missing", &buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No such file or directory")
+}
+
+func TestFileSystem_Upload(t *testing.T) {
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, nil, "", nil))
+
+	contents := "key: value"
+	err := fs.Upload(context.Background(), target, "/etc/app", "../config.yaml", strings.NewReader(contents), int64(len(contents)))
+	require.NoError(t, err)
+
+	require.Len(t, calls, 1)
+	assert.Equal(t, []string{"tar", "-xmf", "-", "-C", "/etc/app"}, calls[0].command)
+
+	tr := tar.NewReader(bytes.NewReader(calls[0].stdin))
+	header, err := tr.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "config.yaml", header.Name)
+
+	got, err := ioutil.ReadAll(tr)
+	require.NoError(t, err)
+	assert.Equal(t, contents, string(got))
+}
+
+func TestFileSystem_Upload_invalidName(t *testing.T) {
+	var calls []execCall
+	fs := New(fakeExec(t, &calls, nil, "", nil))
+
+	err := fs.Upload(context.Background(), target, "/tmp", "..", strings.NewReader(""), 0)
+	require.Error(t, err)
+	assert.Empty(t, calls)
+}
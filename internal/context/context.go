@@ -18,3 +18,17 @@ func KubeConfigChFrom(ctx context.Context) chan string {
 func WithKubeConfigCh(ctx context.Context) context.Context {
 	return context.WithValue(ctx, KubeConfigKey, make(chan string))
 }
+
+const ClientIDKey = OctantContextKey("clientID")
+
+// WithClientID returns a context with the ID of the client the work is for.
+func WithClientID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ClientIDKey, id)
+}
+
+// ClientIDFrom returns the client ID in the context. It returns an empty string
+// if there is no client ID.
+func ClientIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(ClientIDKey).(string)
+	return id
+}
@@ -30,6 +30,7 @@ func defaultObjectTabs() []Tab {
 		{Name: "YAML", Factory: YAMLViewerTab},
 		{Name: "Logs", Factory: LogsTab},
 		{Name: "Terminal", Factory: TerminalTab},
+		{Name: "Files", Factory: FilesTab},
 	}
 }
 
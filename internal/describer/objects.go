@@ -13,8 +13,10 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
 	"k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -90,6 +92,15 @@ func initNamespacedOverview() *Section {
 		RootPath:       ResourceLink{Title: "Workloads", Url: "/overview/namespace/($NAMESPACE)/workloads"},
 	})
 
+	workloadsPodDisruptionBudgets := NewResource(ResourceOptions{
+		Path:           "/workloads/pod-disruption-budgets",
+		ObjectStoreKey: store.Key{APIVersion: "policy/v1beta1", Kind: "PodDisruptionBudget"},
+		ListType:       &policyv1beta1.PodDisruptionBudgetList{},
+		ObjectType:     &policyv1beta1.PodDisruptionBudget{},
+		Titles:         ResourceTitle{List: "Pod Disruption Budgets", Object: "Pod Disruption Budgets"},
+		RootPath:       ResourceLink{Title: "Workloads", Url: "/overview/namespace/($NAMESPACE)/workloads"},
+	})
+
 	workloadsPods := NewResource(ResourceOptions{
 		Path:           "/workloads/pods",
 		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "Pod"},
@@ -132,6 +143,7 @@ func initNamespacedOverview() *Section {
 		workloadsDaemonSets,
 		workloadsDeployments,
 		workloadsJobs,
+		workloadsPodDisruptionBudgets,
 		workloadsPods,
 		workloadsReplicaSets,
 		workloadsReplicationControllers,
@@ -165,6 +177,24 @@ func initNamespacedOverview() *Section {
 		RootPath:       ResourceLink{Title: "Discovery and Load Balancing", Url: "/overview/namespace/($NAMESPACE)/discovery-and-load-balancing"},
 	})
 
+	dlbEndpoints := NewResource(ResourceOptions{
+		Path:           "/discovery-and-load-balancing/endpoints",
+		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "Endpoints"},
+		ListType:       &corev1.EndpointsList{},
+		ObjectType:     &corev1.Endpoints{},
+		Titles:         ResourceTitle{List: "Endpoints", Object: "Endpoints"},
+		RootPath:       ResourceLink{Title: "Discovery and Load Balancing", Url: "/overview/namespace/($NAMESPACE)/discovery-and-load-balancing"},
+	})
+
+	dlbEndpointSlices := NewResource(ResourceOptions{
+		Path:           "/discovery-and-load-balancing/endpoint-slices",
+		ObjectStoreKey: store.Key{APIVersion: "discovery.k8s.io/v1beta1", Kind: "EndpointSlice"},
+		ListType:       &discoveryv1beta1.EndpointSliceList{},
+		ObjectType:     &discoveryv1beta1.EndpointSlice{},
+		Titles:         ResourceTitle{List: "Endpoint Slices", Object: "Endpoint Slices"},
+		RootPath:       ResourceLink{Title: "Discovery and Load Balancing", Url: "/overview/namespace/($NAMESPACE)/discovery-and-load-balancing"},
+	})
+
 	dlbNetworkPolicies := NewResource(ResourceOptions{
 		Path:           "/discovery-and-load-balancing/network-policies",
 		ObjectStoreKey: store.Key{APIVersion: "networking.k8s.io/v1", Kind: "NetworkPolicy"},
@@ -180,6 +210,8 @@ func initNamespacedOverview() *Section {
 		dlbHorizontalPodAutoscalers,
 		dlbIngresses,
 		dlbServices,
+		dlbEndpoints,
+		dlbEndpointSlices,
 		dlbNetworkPolicies,
 	)
 
@@ -192,6 +224,15 @@ func initNamespacedOverview() *Section {
 		RootPath:       ResourceLink{Title: "Config and Storage", Url: "/overview/namespace/($NAMESPACE)/config-and-storage"},
 	})
 
+	csLimitRanges := NewResource(ResourceOptions{
+		Path:           "/config-and-storage/limit-ranges",
+		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "LimitRange"},
+		ListType:       &corev1.LimitRangeList{},
+		ObjectType:     &corev1.LimitRange{},
+		Titles:         ResourceTitle{List: "Limit Ranges", Object: "Limit Ranges"},
+		RootPath:       ResourceLink{Title: "Config and Storage", Url: "/overview/namespace/($NAMESPACE)/config-and-storage"},
+	})
+
 	csPVCs := NewResource(ResourceOptions{
 		Path:           "/config-and-storage/persistent-volume-claims",
 		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
@@ -201,6 +242,15 @@ func initNamespacedOverview() *Section {
 		RootPath:       ResourceLink{Title: "Config and Storage", Url: "/overview/namespace/($NAMESPACE)/config-and-storage"},
 	})
 
+	csResourceQuotas := NewResource(ResourceOptions{
+		Path:           "/config-and-storage/resource-quotas",
+		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "ResourceQuota"},
+		ListType:       &corev1.ResourceQuotaList{},
+		ObjectType:     &corev1.ResourceQuota{},
+		Titles:         ResourceTitle{List: "Resource Quotas", Object: "Resource Quotas"},
+		RootPath:       ResourceLink{Title: "Config and Storage", Url: "/overview/namespace/($NAMESPACE)/config-and-storage"},
+	})
+
 	csSecrets := NewResource(ResourceOptions{
 		Path:           "/config-and-storage/secrets",
 		ObjectStoreKey: store.Key{APIVersion: "v1", Kind: "Secret"},
@@ -223,7 +273,9 @@ func initNamespacedOverview() *Section {
 		"/config-and-storage",
 		"Config and Storage",
 		csConfigMaps,
+		csLimitRanges,
 		csPVCs,
+		csResourceQuotas,
 		csSecrets,
 		csServiceAccounts,
 	)
@@ -10,16 +10,20 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/internal/link"
 	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/modules/overview/container"
+	"github.com/vmware-tanzu/octant/internal/modules/overview/fieldmanagerviewer"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/logviewer"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/terminalviewer"
 	"github.com/vmware-tanzu/octant/internal/modules/overview/yamlviewer"
 	"github.com/vmware-tanzu/octant/internal/printer"
 	"github.com/vmware-tanzu/octant/internal/resourceviewer"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -108,10 +112,21 @@ func YAMLViewerTab(_ context.Context, object runtime.Object, _ Options) (compone
 	return yvComponent, nil
 }
 
-// LogsTab generates a logs tab for a pod. If the object is not a pod, the
-// returned component will be nil with a nil error.
+// FieldManagersTab generates a field managers viewer for an object.
+func FieldManagersTab(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
+	fmComponent, err := fieldmanagerviewer.ToComponent(object)
+	if err != nil {
+		return nil, fmt.Errorf("create field managers viewer: %w", err)
+	}
+
+	fmComponent.SetAccessor("fieldManagers")
+	return fmComponent, nil
+}
+
+// LogsTab generates a logs tab for a pod or a workload. If the object is
+// neither, the returned component will be nil with a nil error.
 func LogsTab(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
-	if isPod(object) {
+	if isPod(object) || container.IsLogWorkloadKind(object.GetObjectKind().GroupVersionKind().Kind) {
 		logsComponent, err := logviewer.ToComponent(object)
 		if err != nil {
 			return nil, fmt.Errorf("create log viewer: %w", err)
@@ -141,3 +156,43 @@ func TerminalTab(ctx context.Context, object runtime.Object, options Options) (c
 
 	return nil, nil
 }
+
+// FilesTab generates a file browser tab for a pod with running containers. If the
+// object is not a pod, the returned component will be nil with a nil error.
+func FilesTab(_ context.Context, object runtime.Object, _ Options) (component.Component, error) {
+	if !isPod(object) {
+		return nil, nil
+	}
+
+	pod := &corev1.Pod{}
+	switch t := object.(type) {
+	case *unstructured.Unstructured:
+		if err := kubernetes.FromUnstructured(t, pod); err != nil {
+			return nil, fmt.Errorf("create file browser: %w", err)
+		}
+	case *corev1.Pod:
+		pod = t
+	default:
+		return nil, fmt.Errorf("can't create file browser for a %T", object)
+	}
+
+	var containers []string
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Running != nil {
+			containers = append(containers, status.Name)
+		}
+	}
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		if status.State.Running != nil {
+			containers = append(containers, status.Name)
+		}
+	}
+
+	if len(containers) == 0 {
+		return nil, nil
+	}
+
+	fileBrowser := component.NewFileBrowser(pod.Namespace, pod.Name, containers, containers[0])
+	fileBrowser.SetAccessor("files")
+	return fileBrowser, nil
+}
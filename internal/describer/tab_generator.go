@@ -150,7 +150,7 @@ func objectTabsFactory(
 	object runtime.Object,
 	descriptors []Tab, options Options) TabsFactory {
 	return func() ([]Tab, error) {
-		list := append(descriptors)
+		list := withFieldManagersTab(descriptors)
 		pluginList, err := pluginTabsFactory(ctx, object, options)
 		if err != nil {
 			return nil, fmt.Errorf("generate plugin tabs: %w", err)
@@ -160,6 +160,20 @@ func objectTabsFactory(
 	}
 }
 
+// withFieldManagersTab adds a field managers tab after the YAML tab. If there
+// is no YAML tab, the descriptors are returned unchanged.
+func withFieldManagersTab(descriptors []Tab) []Tab {
+	var list []Tab
+	for _, descriptor := range descriptors {
+		list = append(list, descriptor)
+		if descriptor.Name == "YAML" {
+			list = append(list, Tab{Name: "Field Managers", Factory: FieldManagersTab})
+		}
+	}
+
+	return list
+}
+
 // pluginTabsFactory generates plugin tabs for an object.
 func pluginTabsFactory(
 	ctx context.Context,
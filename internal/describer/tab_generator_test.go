@@ -57,3 +57,18 @@ func TestCreateErrorTab(t *testing.T) {
 
 	testutil.AssertJSONEqual(t, wanted, actual)
 }
+
+func Test_withFieldManagersTab(t *testing.T) {
+	descriptors := []Tab{
+		{Name: "Summary", Factory: SummaryTab},
+		{Name: "YAML", Factory: YAMLViewerTab},
+		{Name: "Logs", Factory: LogsTab},
+	}
+
+	var actual []string
+	for _, tab := range withFieldManagersTab(descriptors) {
+		actual = append(actual, tab.Name)
+	}
+
+	require.Equal(t, []string{"Summary", "YAML", "Field Managers", "Logs"}, actual)
+}
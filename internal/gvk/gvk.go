@@ -18,16 +18,21 @@ var (
 	ClusterRoleBinding             = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRoleBinding"}
 	ClusterRole                    = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}
 	ConfigMap                      = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+	CSIDriver                      = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "CSIDriver"}
+	CSINode                        = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "CSINode"}
 	CronJob                        = schema.GroupVersionKind{Group: "batch", Version: "v1beta1", Kind: "CronJob"}
 	CustomResourceDefinition       = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
 	DaemonSet                      = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}
 	Deployment                     = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	Endpoints                      = schema.GroupVersionKind{Version: "v1", Kind: "Endpoints"}
+	EndpointSlice                  = schema.GroupVersionKind{Group: "discovery.k8s.io", Version: "v1beta1", Kind: "EndpointSlice"}
 	ExtDeployment                  = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Deployment"}
 	ExtReplicaSet                  = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "ReplicaSet"}
 	Event                          = schema.GroupVersionKind{Version: "v1", Kind: "Event"}
 	HorizontalPodAutoscaler        = schema.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"}
 	Ingress                        = schema.GroupVersionKind{Group: "extensions", Version: "v1beta1", Kind: "Ingress"}
 	Job                            = schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	LimitRange                     = schema.GroupVersionKind{Version: "v1", Kind: "LimitRange"}
 	MutatingWebhookConfiguration   = schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "MutatingWebhookConfiguration"}
 	Node                           = schema.GroupVersionKind{Version: "v1", Kind: "Node"}
 	Namespace                      = schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
@@ -36,14 +41,20 @@ var (
 	Secret                         = schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
 	Service                        = schema.GroupVersionKind{Version: "v1", Kind: "Service"}
 	Pod                            = schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	PodDisruptionBudget            = schema.GroupVersionKind{Group: "policy", Version: "v1beta1", Kind: "PodDisruptionBudget"}
 	PodMetrics                     = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetrics"}
 	PersistentVolume               = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolume"}
 	PersistentVolumeClaim          = schema.GroupVersionKind{Version: "v1", Kind: "PersistentVolumeClaim"}
 	ReplicationController          = schema.GroupVersionKind{Version: "v1", Kind: "ReplicationController"}
+	ResourceQuota                  = schema.GroupVersionKind{Version: "v1", Kind: "ResourceQuota"}
 	StatefulSet                    = schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}
+	StorageClass                   = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass"}
 	RoleBinding                    = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "RoleBinding"}
 	Role                           = schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"}
 	ValidatingWebhookConfiguration = schema.GroupVersionKind{Group: "admissionregistration.k8s.io", Version: "v1beta1", Kind: "ValidatingWebhookConfiguration"}
+	VolumeAttachment               = schema.GroupVersionKind{Group: "storage.k8s.io", Version: "v1", Kind: "VolumeAttachment"}
+	VolumeSnapshot                 = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1beta1", Kind: "VolumeSnapshot"}
+	VolumeSnapshotContent          = schema.GroupVersionKind{Group: "snapshot.storage.k8s.io", Version: "v1beta1", Kind: "VolumeSnapshotContent"}
 )
 
 // CustomResource generates a `schema.GroupVersionKind` for a custom resource given a version.
@@ -0,0 +1,347 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package applications
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/diff"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// helmReleaseSecretType is the type of secrets Helm 3 uses to store releases.
+	helmReleaseSecretType = "helm.sh/release.v1"
+	// helmReleaseNameAnnotation is added by Helm 3.2+ to objects it manages.
+	helmReleaseNameAnnotation = "meta.helm.sh/release-name"
+	// helmReleaseNamespaceAnnotation is added by Helm 3.2+ to objects it manages.
+	helmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+
+	helmReleaseStatusDeployed   = "deployed"
+	helmReleaseStatusSuperseded = "superseded"
+	helmReleaseStatusFailed     = "failed"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// helmRelease is the subset of a Helm 3 release Octant displays.
+type helmRelease struct {
+	Name      string                 `json:"name"`
+	Namespace string                 `json:"namespace"`
+	Version   int                    `json:"version"`
+	Info      helmReleaseInfo        `json:"info"`
+	Chart     helmChart              `json:"chart"`
+	Config    map[string]interface{} `json:"config,omitempty"`
+	Manifest  string                 `json:"manifest"`
+}
+
+type helmReleaseInfo struct {
+	FirstDeployed time.Time `json:"first_deployed"`
+	LastDeployed  time.Time `json:"last_deployed"`
+	Description   string    `json:"description"`
+	Status        string    `json:"status"`
+}
+
+type helmChart struct {
+	Metadata helmChartMetadata `json:"metadata"`
+}
+
+type helmChartMetadata struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	AppVersion string `json:"appVersion"`
+}
+
+// ChartName returns the chart name and version, e.g. `nginx-1.2.3`.
+func (r *helmRelease) ChartName() string {
+	if r.Chart.Metadata.Version == "" {
+		return r.Chart.Metadata.Name
+	}
+
+	return r.Chart.Metadata.Name + "-" + r.Chart.Metadata.Version
+}
+
+// helmReleaseHistory is every stored revision of a release. Revisions are
+// sorted newest first.
+type helmReleaseHistory struct {
+	Name      string
+	Namespace string
+	Revisions []helmRelease
+}
+
+// Latest returns the newest revision of the release.
+func (h *helmReleaseHistory) Latest() *helmRelease {
+	if len(h.Revisions) == 0 {
+		return nil
+	}
+
+	return &h.Revisions[0]
+}
+
+// Revision returns a revision of the release. The second return value is false
+// if the revision is not stored in the cluster.
+func (h *helmReleaseHistory) Revision(version int) (*helmRelease, bool) {
+	for i := range h.Revisions {
+		if h.Revisions[i].Version == version {
+			return &h.Revisions[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// Previous returns the revision stored before version.
+func (h *helmReleaseHistory) Previous(version int) (*helmRelease, bool) {
+	for i := range h.Revisions {
+		if h.Revisions[i].Version < version {
+			return &h.Revisions[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// decodeHelmRelease decodes a release from a Helm 3 release secret. The release
+// is stored as base64 encoded, gzipped JSON.
+func decodeHelmRelease(secret *unstructured.Unstructured) (*helmRelease, error) {
+	if secret == nil {
+		return nil, errors.New("secret is nil")
+	}
+
+	secretType, _, err := unstructured.NestedString(secret.Object, "type")
+	if err != nil {
+		return nil, errors.Wrap(err, "read secret type")
+	}
+
+	if secretType != helmReleaseSecretType {
+		return nil, errors.Errorf("secret %s is not a helm release", secret.GetName())
+	}
+
+	data, found, err := unstructured.NestedString(secret.Object, "data", "release")
+	if err != nil {
+		return nil, errors.Wrap(err, "read release data")
+	}
+
+	if !found {
+		return nil, errors.Errorf("secret %s does not contain a release", secret.GetName())
+	}
+
+	// Secret data is base64 encoded by Kubernetes, and Helm encodes the release
+	// with base64 before storing it.
+	encoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode secret data")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(string(encoded))
+	if err != nil {
+		return nil, errors.Wrap(err, "decode release")
+	}
+
+	if bytes.HasPrefix(b, gzipMagic) {
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, errors.Wrap(err, "create gzip reader")
+		}
+		defer r.Close()
+
+		b, err = ioutil.ReadAll(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "decompress release")
+		}
+	}
+
+	var release helmRelease
+	if err := json.Unmarshal(b, &release); err != nil {
+		return nil, errors.Wrap(err, "unmarshal release")
+	}
+
+	return &release, nil
+}
+
+// listHelmReleases lists Helm 3 releases in a namespace. Secrets which can't
+// be decoded are skipped.
+func listHelmReleases(ctx context.Context, objectStore store.Store, namespace string) ([]helmReleaseHistory, error) {
+	if objectStore == nil {
+		return nil, errors.New("object store is nil")
+	}
+
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Selector: &labels.Set{
+			"owner": "helm",
+		},
+	}
+
+	secrets, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "list helm release secrets")
+	}
+
+	releases := make(map[string]*helmReleaseHistory)
+	for i := range secrets.Items {
+		release, err := decodeHelmRelease(&secrets.Items[i])
+		if err != nil {
+			continue
+		}
+
+		history, ok := releases[release.Name]
+		if !ok {
+			history = &helmReleaseHistory{
+				Name:      release.Name,
+				Namespace: release.Namespace,
+			}
+			releases[release.Name] = history
+		}
+
+		history.Revisions = append(history.Revisions, *release)
+	}
+
+	var list []helmReleaseHistory
+	for _, history := range releases {
+		sort.Slice(history.Revisions, func(i, j int) bool {
+			return history.Revisions[i].Version > history.Revisions[j].Version
+		})
+		list = append(list, *history)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list, nil
+}
+
+// listVisibleHelmReleases lists Helm releases for views which show other
+// applications as well. Releases are stored in secrets, which the user might
+// not be allowed to list, so an error is logged and no releases are returned.
+func listVisibleHelmReleases(ctx context.Context, objectStore store.Store, namespace string) []helmReleaseHistory {
+	releases, err := listHelmReleases(ctx, objectStore, namespace)
+	if err != nil {
+		log.From(ctx).WithErr(err).Errorf("list helm releases")
+		return nil
+	}
+
+	return releases
+}
+
+// getHelmRelease returns the history for a single release.
+func getHelmRelease(ctx context.Context, objectStore store.Store, namespace, name string) (*helmReleaseHistory, error) {
+	list, err := listHelmReleases(ctx, objectStore, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		if list[i].Name == name {
+			return &list[i], nil
+		}
+	}
+
+	return nil, errors.Errorf("helm release %s was not found in namespace %s", name, namespace)
+}
+
+// helmReleaseObjects returns the objects rendered in a release manifest.
+// Objects are returned as rendered, so namespaced objects without a namespace
+// don't have the release namespace set.
+func helmReleaseObjects(release *helmRelease) ([]*unstructured.Unstructured, error) {
+	if release == nil {
+		return nil, errors.New("release is nil")
+	}
+
+	decoder := kyaml.NewYAMLOrJSONDecoder(strings.NewReader(release.Manifest), 4096)
+
+	var objects []*unstructured.Unstructured
+	for {
+		object := map[string]interface{}{}
+		if err := decoder.Decode(&object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrapf(err, "decode manifest for release %s", release.Name)
+		}
+
+		if len(object) == 0 {
+			continue
+		}
+
+		u := &unstructured.Unstructured{Object: object}
+		if u.GetKind() == "" || u.GetName() == "" {
+			continue
+		}
+
+		objects = append(objects, u)
+	}
+
+	return objects, nil
+}
+
+// helmReleaseForObject returns the name of the release which manages an object.
+// Helm 3.2+ annotates the objects it creates with their release.
+func helmReleaseForObject(object *unstructured.Unstructured) (string, bool) {
+	if object == nil {
+		return "", false
+	}
+
+	annotations := object.GetAnnotations()
+	name, ok := annotations[helmReleaseNameAnnotation]
+	if !ok || name == "" {
+		return "", false
+	}
+
+	if namespace, ok := annotations[helmReleaseNamespaceAnnotation]; ok && namespace != object.GetNamespace() {
+		return "", false
+	}
+
+	return name, true
+}
+
+// helmValuesDiff creates a line diff between the values of two revisions.
+// Removed lines are prefixed with `-` and added lines with `+`.
+func helmValuesDiff(previous, current map[string]interface{}) (string, error) {
+	previousLines, err := helmValuesLines(previous)
+	if err != nil {
+		return "", err
+	}
+
+	currentLines, err := helmValuesLines(current)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(diff.Lines(previousLines, currentLines), "\n"), nil
+}
+
+func helmValuesLines(values map[string]interface{}) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	b, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal values")
+	}
+
+	return strings.Split(strings.TrimSuffix(string(b), "\n"), "\n"), nil
+}
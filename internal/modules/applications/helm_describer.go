@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	helmReleaseListColumns    = component.NewTableCols("Name", "Revision", "Chart", "App Version", "Status", "Updated")
+	helmReleaseHistoryColumns = component.NewTableCols("Revision", "Chart", "App Version", "Status", "Updated", "Description")
+	helmReleaseObjectsColumns = component.NewTableCols("Kind", "Name", "Namespace", "Status")
+)
+
+// helmReleasePath returns the content path for a release.
+func helmReleasePath(prefix, namespace, name string) string {
+	return path.Join("/", prefix, "namespace", namespace, "helm", name)
+}
+
+// helmReleaseRevisionPath returns the content path for a release revision.
+func helmReleaseRevisionPath(prefix, namespace, name string, revision int) string {
+	return path.Join(helmReleasePath(prefix, namespace, name), "revisions", strconv.Itoa(revision))
+}
+
+// helmSummarizer summarizes Helm releases for a namespace.
+type helmSummarizer struct{}
+
+var _ Summarizer = (*helmSummarizer)(nil)
+
+// Summarize converts Helm releases in namespace to a table.
+func (s *helmSummarizer) Summarize(ctx context.Context, namespace string, config SummarizerConfig) (*component.Table, error) {
+	if config == nil {
+		return nil, errors.Errorf("config is nil")
+	}
+
+	releases := listVisibleHelmReleases(ctx, config.ObjectStore(), namespace)
+
+	table := component.NewTable("Helm Releases", "There are no Helm releases!", helmReleaseListColumns)
+	for i := range releases {
+		release := releases[i].Latest()
+		if release == nil {
+			continue
+		}
+
+		table.Add(component.TableRow{
+			"Name":        component.NewLink("", release.Name, helmReleasePath("applications", namespace, release.Name)),
+			"Revision":    component.NewText(strconv.Itoa(release.Version)),
+			"Chart":       component.NewText(release.ChartName()),
+			"App Version": component.NewText(release.Chart.Metadata.AppVersion),
+			"Status":      helmReleaseStatusText(release.Info.Status),
+			"Updated":     component.NewTimestamp(release.Info.LastDeployed),
+		})
+	}
+
+	return table, nil
+}
+
+// HelmReleaseDescriber describes a Helm release.
+type HelmReleaseDescriber struct{}
+
+var _ describer.Describer = (*HelmReleaseDescriber)(nil)
+
+// NewHelmReleaseDescriber creates an instance of HelmReleaseDescriber.
+func NewHelmReleaseDescriber() *HelmReleaseDescriber {
+	return &HelmReleaseDescriber{}
+}
+
+// Describe creates a content response for a release. It includes a summary, the
+// release history, the values changed since the previous revision and the
+// objects the release manages.
+func (h *HelmReleaseDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	name := options.Fields["release"]
+	if name == "" {
+		return component.EmptyContentResponse, errors.New("release is blank")
+	}
+
+	history, err := getHelmRelease(ctx, options.ObjectStore(), namespace, name)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+
+	release := history.Latest()
+	if revisionField := options.Fields["revision"]; revisionField != "" {
+		revision, err := strconv.Atoi(revisionField)
+		if err != nil {
+			return component.EmptyContentResponse, errors.Wrapf(err, "parse revision %q", revisionField)
+		}
+
+		var ok bool
+		release, ok = history.Revision(revision)
+		if !ok {
+			return component.EmptyContentResponse, errors.Errorf("helm release %s does not have revision %d", name, revision)
+		}
+	}
+
+	objects, err := createHelmReleaseObjectsView(ctx, release, options)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "create managed objects view")
+	}
+
+	values, err := createHelmReleaseValuesView(history, release)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "create values view")
+	}
+
+	resp := component.ContentResponse{
+		Title: component.TitleFromString(fmt.Sprintf("%s (revision %d)", release.Name, release.Version)),
+		Components: []component.Component{
+			createHelmReleaseSummary(release),
+			createHelmReleaseHistoryView(namespace, history),
+			values,
+			objects,
+		},
+	}
+
+	return resp, nil
+}
+
+// PathFilters creates PathFilters for a release. The path for a release is
+// /helm/release-name, and a revision of the release is /helm/release-name/revisions/revision.
+func (h *HelmReleaseDescriber) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter("/helm/(?P<release>[^/]*)", h),
+		*describer.NewPathFilter("/helm/(?P<release>[^/]*)/revisions/(?P<revision>[0-9]+)", h),
+	}
+}
+
+// Reset does nothing.
+func (h HelmReleaseDescriber) Reset(ctx context.Context) error {
+	return nil
+}
+
+func createHelmReleaseSummary(release *helmRelease) *component.Summary {
+	sections := component.SummarySections{}
+	sections.AddText("Chart", release.ChartName())
+	sections.AddText("App Version", release.Chart.Metadata.AppVersion)
+	sections.Add("Status", helmReleaseStatusText(release.Info.Status))
+	sections.AddText("Revision", strconv.Itoa(release.Version))
+	sections.Add("First Deployed", component.NewTimestamp(release.Info.FirstDeployed))
+	sections.Add("Last Deployed", component.NewTimestamp(release.Info.LastDeployed))
+	if release.Info.Description != "" {
+		sections.AddText("Description", release.Info.Description)
+	}
+
+	return component.NewSummary("Release", sections...)
+}
+
+func createHelmReleaseHistoryView(namespace string, history *helmReleaseHistory) *component.Table {
+	table := component.NewTable("History", "There is no release history!", helmReleaseHistoryColumns)
+	for i := range history.Revisions {
+		release := history.Revisions[i]
+
+		revision := strconv.Itoa(release.Version)
+		table.Add(component.TableRow{
+			"Revision":    component.NewLink("", revision, helmReleaseRevisionPath("applications", namespace, release.Name, release.Version)),
+			"Chart":       component.NewText(release.ChartName()),
+			"App Version": component.NewText(release.Chart.Metadata.AppVersion),
+			"Status":      helmReleaseStatusText(release.Info.Status),
+			"Updated":     component.NewTimestamp(release.Info.LastDeployed),
+			"Description": component.NewText(release.Info.Description),
+		})
+	}
+
+	return table
+}
+
+func createHelmReleaseValuesView(history *helmReleaseHistory, release *helmRelease) (component.Component, error) {
+	previous, ok := history.Previous(release.Version)
+	if !ok {
+		lines, err := helmValuesLines(release.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		card := component.NewCard(component.TitleFromString("Values"))
+		card.SetBody(component.NewCodeBlock(strings.Join(lines, "\n")))
+		return card, nil
+	}
+
+	diff, err := helmValuesDiff(previous.Config, release.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	title := fmt.Sprintf("Values (changes since revision %d)", previous.Version)
+	card := component.NewCard(component.TitleFromString(title))
+	card.SetBody(component.NewCodeBlock(diff))
+	return card, nil
+}
+
+func createHelmReleaseObjectsView(ctx context.Context, release *helmRelease, options describer.Options) (*component.Table, error) {
+	objects, err := helmReleaseObjects(release)
+	if err != nil {
+		return nil, err
+	}
+
+	table := component.NewTable("Managed Objects", "This release does not manage any objects!", helmReleaseObjectsColumns)
+
+	for _, object := range objects {
+		namespace := object.GetNamespace()
+		if namespace == "" && isNamespacedObject(options, object) {
+			namespace = release.Namespace
+		}
+
+		apiVersion, kind, name := object.GetAPIVersion(), object.GetKind(), object.GetName()
+
+		var nameComponent component.Component = component.NewText(name)
+		if options.Link != nil {
+			if link, err := options.Link.ForGVK(namespace, apiVersion, kind, name, name); err == nil {
+				nameComponent = link
+			}
+		}
+
+		key := store.Key{
+			Namespace:  namespace,
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+		}
+
+		table.Add(component.TableRow{
+			"Kind":      component.NewText(kind),
+			"Name":      nameComponent,
+			"Namespace": component.NewText(namespace),
+			"Status":    helmManagedObjectStatus(ctx, options.ObjectStore(), key, release.Name),
+		})
+	}
+
+	return table, nil
+}
+
+// isNamespacedObject returns true if an object's kind is namespaced. Kinds
+// which can't be discovered are treated as namespaced.
+func isNamespacedObject(options describer.Options, object *unstructured.Unstructured) bool {
+	if options.Dash == nil {
+		return true
+	}
+
+	_, namespaced, err := options.ClusterClient().Resource(object.GroupVersionKind().GroupKind())
+	if err != nil {
+		return true
+	}
+
+	return namespaced
+}
+
+// helmManagedObjectStatus describes whether an object in a release manifest
+// exists in the cluster and is managed by the release.
+func helmManagedObjectStatus(ctx context.Context, objectStore store.Store, key store.Key, releaseName string) *component.Text {
+	object, err := objectStore.Get(ctx, key)
+	if err != nil && !kerrors.IsNotFound(err) {
+		text := component.NewText(err.Error())
+		text.SetStatus(component.TextStatusError)
+		return text
+	}
+
+	if object == nil {
+		text := component.NewText("Missing")
+		text.SetStatus(component.TextStatusError)
+		return text
+	}
+
+	if owner, ok := helmReleaseForObject(object); ok && owner != releaseName {
+		text := component.NewText(fmt.Sprintf("Managed by %s", owner))
+		text.SetStatus(component.TextStatusWarning)
+		return text
+	}
+
+	return component.NewText("Present")
+}
+
+func helmReleaseStatusText(status string) *component.Text {
+	text := component.NewText(status)
+	switch status {
+	case helmReleaseStatusDeployed, helmReleaseStatusSuperseded:
+	case helmReleaseStatusFailed:
+		text.SetStatus(component.TextStatusError)
+	default:
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
+}
@@ -0,0 +1,342 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package applications
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const testHelmManifest = `---
+# Source: nginx/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: nginx
+---
+# Source: nginx/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  namespace: web
+`
+
+func createHelmReleaseSecret(t *testing.T, release helmRelease) *unstructured.Unstructured {
+	data, err := json.Marshal(release)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	secret := testutil.CreateSecret(fmt.Sprintf("sh.helm.release.v1.%s.v%d", release.Name, release.Version))
+	secret.Type = helmReleaseSecretType
+	secret.Labels = map[string]string{
+		"owner": "helm",
+		"name":  release.Name,
+	}
+	secret.Data = map[string][]byte{
+		"release": []byte(base64.StdEncoding.EncodeToString(buf.Bytes())),
+	}
+
+	return testutil.ToUnstructured(t, secret)
+}
+
+func testHelmRelease(name string, version int, status string, config map[string]interface{}) helmRelease {
+	release := helmRelease{
+		Name:      name,
+		Namespace: "default",
+		Version:   version,
+		Config:    config,
+		Manifest:  testHelmManifest,
+	}
+	release.Info.Status = status
+	release.Chart.Metadata = helmChartMetadata{
+		Name:       "nginx",
+		Version:    "1.2.3",
+		AppVersion: "1.19",
+	}
+
+	return release
+}
+
+func Test_decodeHelmRelease(t *testing.T) {
+	release := testHelmRelease("web", 2, helmReleaseStatusDeployed, map[string]interface{}{"replicas": float64(2)})
+
+	tests := []struct {
+		name     string
+		secret   *unstructured.Unstructured
+		expected *helmRelease
+		isErr    bool
+	}{
+		{
+			name:     "release secret",
+			secret:   createHelmReleaseSecret(t, release),
+			expected: &release,
+		},
+		{
+			name:   "not a release",
+			secret: testutil.ToUnstructured(t, testutil.CreateSecret("secret")),
+			isErr:  true,
+		},
+		{
+			name:   "nil secret",
+			secret: nil,
+			isErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := decodeHelmRelease(test.secret)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected.Name, actual.Name)
+			assert.Equal(t, test.expected.Version, actual.Version)
+			assert.Equal(t, test.expected.Config, actual.Config)
+			assert.Equal(t, "nginx-1.2.3", actual.ChartName())
+		})
+	}
+}
+
+func Test_listHelmReleases(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+
+	key := store.Key{
+		Namespace:  "default",
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Selector:   &labels.Set{"owner": "helm"},
+	}
+
+	list := &unstructured.UnstructuredList{}
+	for _, release := range []helmRelease{
+		testHelmRelease("web", 1, helmReleaseStatusSuperseded, nil),
+		testHelmRelease("web", 2, helmReleaseStatusDeployed, nil),
+		testHelmRelease("api", 1, helmReleaseStatusFailed, nil),
+	} {
+		list.Items = append(list.Items, *createHelmReleaseSecret(t, release))
+	}
+	list.Items = append(list.Items, *testutil.ToUnstructured(t, testutil.CreateSecret("secret")))
+
+	objectStore.EXPECT().List(gomock.Any(), key).Return(list, false, nil)
+
+	actual, err := listHelmReleases(context.Background(), objectStore, "default")
+	require.NoError(t, err)
+
+	require.Len(t, actual, 2)
+	assert.Equal(t, "api", actual[0].Name)
+	assert.Equal(t, "web", actual[1].Name)
+	require.Len(t, actual[1].Revisions, 2)
+	assert.Equal(t, 2, actual[1].Latest().Version)
+
+	previous, ok := actual[1].Previous(2)
+	require.True(t, ok)
+	assert.Equal(t, 1, previous.Version)
+
+	_, ok = actual[1].Previous(1)
+	assert.False(t, ok)
+}
+
+func Test_helmReleaseObjects(t *testing.T) {
+	release := testHelmRelease("web", 1, helmReleaseStatusDeployed, nil)
+
+	actual, err := helmReleaseObjects(&release)
+	require.NoError(t, err)
+
+	require.Len(t, actual, 2)
+	assert.Equal(t, "Service", actual[0].GetKind())
+	assert.Equal(t, "", actual[0].GetNamespace())
+	assert.Equal(t, "Deployment", actual[1].GetKind())
+	assert.Equal(t, "web", actual[1].GetNamespace())
+}
+
+func Test_helmReleaseForObject(t *testing.T) {
+	object := testutil.ToUnstructured(t, testutil.CreateService("nginx"))
+
+	_, ok := helmReleaseForObject(object)
+	assert.False(t, ok)
+
+	object.SetAnnotations(map[string]string{
+		helmReleaseNameAnnotation:      "web",
+		helmReleaseNamespaceAnnotation: object.GetNamespace(),
+	})
+
+	name, ok := helmReleaseForObject(object)
+	require.True(t, ok)
+	assert.Equal(t, "web", name)
+}
+
+func Test_helmValuesDiff(t *testing.T) {
+	previous := map[string]interface{}{
+		"image":    "nginx:1.18",
+		"replicas": 1,
+	}
+	current := map[string]interface{}{
+		"image":    "nginx:1.19",
+		"replicas": 1,
+	}
+
+	actual, err := helmValuesDiff(previous, current)
+	require.NoError(t, err)
+
+	expected := "- image: nginx:1.18\n+ image: nginx:1.19\n  replicas: 1"
+	assert.Equal(t, expected, actual)
+}
+
+func Test_helmSummarizer(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+
+	list := &unstructured.UnstructuredList{}
+	list.Items = append(list.Items, *createHelmReleaseSecret(t, testHelmRelease("web", 3, helmReleaseStatusDeployed, nil)))
+	objectStore.EXPECT().List(gomock.Any(), gomock.Any()).Return(list, false, nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	s := helmSummarizer{}
+	actual, err := s.Summarize(context.Background(), "default", dashConfig)
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Helm Releases", "There are no Helm releases!", helmReleaseListColumns, []component.TableRow{
+		{
+			"Name":        component.NewLink("", "web", "/applications/namespace/default/helm/web"),
+			"Revision":    component.NewText("3"),
+			"Chart":       component.NewText("nginx-1.2.3"),
+			"App Version": component.NewText("1.19"),
+			"Status":      component.NewText("deployed"),
+			"Updated":     component.NewTimestamp(time.Time{}),
+		},
+	})
+
+	component.AssertEqual(t, expected, actual)
+}
+
+func Test_helmSummarizer_listError(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, false, errors.New("forbidden"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore)
+
+	s := helmSummarizer{}
+	actual, err := s.Summarize(context.Background(), "default", dashConfig)
+	require.NoError(t, err)
+
+	expected := component.NewTable("Helm Releases", "There are no Helm releases!", helmReleaseListColumns)
+	component.AssertEqual(t, expected, actual)
+}
+
+func Test_createHelmReleaseObjectsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	release := testHelmRelease("web", 1, helmReleaseStatusDeployed, nil)
+	release.Manifest = `---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: nginx
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: nginx
+`
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().
+		Resource(schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}).
+		Return(schema.GroupVersionResource{}, false, nil)
+	clusterClient.EXPECT().
+		Resource(schema.GroupKind{Kind: "Service"}).
+		Return(schema.GroupVersionResource{}, true, nil)
+
+	objectStore := fake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "nginx"}).
+		Return(testutil.ToUnstructured(t, testutil.CreateClusterRole("nginx")), nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "nginx"}).
+		Return(testutil.ToUnstructured(t, testutil.CreateService("nginx")), nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ClusterClient().Return(clusterClient).AnyTimes()
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+
+	actual, err := createHelmReleaseObjectsView(context.Background(), &release, describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Managed Objects", "This release does not manage any objects!", helmReleaseObjectsColumns, []component.TableRow{
+		{
+			"Kind":      component.NewText("ClusterRole"),
+			"Name":      component.NewText("nginx"),
+			"Namespace": component.NewText(""),
+			"Status":    component.NewText("Present"),
+		},
+		{
+			"Kind":      component.NewText("Service"),
+			"Name":      component.NewText("nginx"),
+			"Namespace": component.NewText("default"),
+			"Status":    component.NewText("Present"),
+		},
+	})
+	component.AssertEqual(t, expected, actual)
+}
+
+func Test_helmManagedObjectStatus(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Service", Name: "nginx"}
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(nil, nil)
+
+	actual := helmManagedObjectStatus(context.Background(), objectStore, key, "web")
+
+	expected := component.NewText("Missing")
+	expected.SetStatus(component.TextStatusError)
+	component.AssertEqual(t, expected, actual)
+}
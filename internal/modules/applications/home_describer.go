@@ -24,9 +24,17 @@ func WithHomeDescriberSummarizer(s Summarizer) HomeDescriberOption {
 	}
 }
 
+// WithHomeDescriberHelmSummarizer configures the Summarizer for Helm releases.
+func WithHomeDescriberHelmSummarizer(s Summarizer) HomeDescriberOption {
+	return func(d *HomeDescriber) {
+		d.helmSummarizer = s
+	}
+}
+
 // HomeDescriber describes content for applications.
 type HomeDescriber struct {
-	summarizer Summarizer
+	summarizer     Summarizer
+	helmSummarizer Summarizer
 }
 
 var _ describer.Describer = (*HomeDescriber)(nil)
@@ -43,19 +51,28 @@ func NewHomeDescriber(options ...HomeDescriberOption) *HomeDescriber {
 		d.summarizer = &summarizer{}
 	}
 
+	if d.helmSummarizer == nil {
+		d.helmSummarizer = &helmSummarizer{}
+	}
+
 	return d
 }
 
-// Describe prints a summary of applications.
+// Describe prints a summary of applications and Helm releases.
 func (l *HomeDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
 	table, err := l.summarizer.Summarize(ctx, namespace, options)
 	if err != nil {
 		return component.EmptyContentResponse, errors.Wrap(err, "summarize applications")
 	}
 
+	helmTable, err := l.helmSummarizer.Summarize(ctx, namespace, options)
+	if err != nil {
+		return component.EmptyContentResponse, errors.Wrap(err, "summarize helm releases")
+	}
+
 	contentResponse := component.ContentResponse{
 		Title:      component.TitleFromString("Applications"),
-		Components: []component.Component{table},
+		Components: []component.Component{table, helmTable},
 	}
 
 	return contentResponse, nil
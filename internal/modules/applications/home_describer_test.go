@@ -24,15 +24,23 @@ func Test_homeDescriber_Describe(t *testing.T) {
 	defer controller.Finish()
 
 	table := component.NewTable("table", "table", component.NewTableCols("col"))
+	helmTable := component.NewTable("helm", "helm", component.NewTableCols("col"))
 
 	s := fake.NewMockSummarizer(controller)
 	s.EXPECT().
 		Summarize(gomock.Any(), "default", gomock.Any()).
 		Return(table, nil)
 
+	hs := fake.NewMockSummarizer(controller)
+	hs.EXPECT().
+		Summarize(gomock.Any(), "default", gomock.Any()).
+		Return(helmTable, nil)
+
 	dashConfig := configFake.NewMockDash(controller)
 
-	d := applications.NewHomeDescriber(applications.WithHomeDescriberSummarizer(s))
+	d := applications.NewHomeDescriber(
+		applications.WithHomeDescriberSummarizer(s),
+		applications.WithHomeDescriberHelmSummarizer(hs))
 
 	ctx := context.Background()
 	options := describer.Options{
@@ -43,7 +51,7 @@ func Test_homeDescriber_Describe(t *testing.T) {
 
 	expected := component.ContentResponse{
 		Title:      component.TitleFromString("Applications"),
-		Components: []component.Component{table},
+		Components: []component.Component{table, helmTable},
 	}
 	require.Equal(t, expected, actual)
 }
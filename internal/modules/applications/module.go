@@ -7,6 +7,7 @@ package applications
 
 import (
 	"context"
+	"fmt"
 	"path"
 	"path/filepath"
 
@@ -48,6 +49,11 @@ func New(ctx context.Context, options Options) *Module {
 		pm.Register(ctx, pf)
 	}
 
+	helmDescriber := NewHelmReleaseDescriber()
+	for _, pf := range helmDescriber.PathFilters() {
+		pm.Register(ctx, pf)
+	}
+
 	return &Module{
 		Options:     options,
 		pathMatcher: pm,
@@ -102,6 +108,13 @@ func (m *Module) Navigation(ctx context.Context, namespace, root string) ([]navi
 		})
 	}
 
+	for _, release := range listVisibleHelmReleases(ctx, m.DashConfig.ObjectStore(), namespace) {
+		rootNav.Children = append(rootNav.Children, navigation.Navigation{
+			Title: fmt.Sprintf("%s (Helm)", release.Name),
+			Path:  path.Join(rootPath, "helm", release.Name),
+		})
+	}
+
 	return []navigation.Navigation{rootNav}, nil
 }
 
@@ -288,6 +288,14 @@ func storageEntries(ctx context.Context, prefix, namespace string, objectStore s
 
 	neh.Add("Persistent Volumes", "persistent-volumes",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.PersistentVolume), objectStore))
+	neh.Add("Storage Classes", "storage-classes",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.StorageClass), objectStore))
+	neh.Add("CSI Drivers", "csi-drivers",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.CSIDriver), objectStore))
+	neh.Add("CSI Nodes", "csi-nodes",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.CSINode), objectStore))
+	neh.Add("Volume Attachments", "volume-attachments",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.VolumeAttachment), objectStore))
 
 	children, err := neh.Generate(prefix, namespace, "")
 	if err != nil {
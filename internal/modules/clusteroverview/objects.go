@@ -9,6 +9,7 @@ import (
 	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 
@@ -116,10 +117,58 @@ var (
 		RootPath:       describer.ResourceLink{Title: "Cluster Overview", Url: "/cluster-overview"},
 	})
 
+	storageStorageClassDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/storage-classes",
+		ObjectStoreKey: store.Key{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+		ListType:       &storagev1.StorageClassList{},
+		ObjectType:     &storagev1.StorageClass{},
+		Titles:         describer.ResourceTitle{List: "Storage Classes", Object: "Storage Classes"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewPersistentVolume,
+		RootPath:       describer.ResourceLink{Title: "Cluster Overview", Url: "/cluster-overview"},
+	})
+
+	storageCSIDriverDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/csi-drivers",
+		ObjectStoreKey: store.Key{APIVersion: "storage.k8s.io/v1", Kind: "CSIDriver"},
+		ListType:       &storagev1.CSIDriverList{},
+		ObjectType:     &storagev1.CSIDriver{},
+		Titles:         describer.ResourceTitle{List: "CSI Drivers", Object: "CSI Drivers"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewPersistentVolume,
+		RootPath:       describer.ResourceLink{Title: "Cluster Overview", Url: "/cluster-overview"},
+	})
+
+	storageCSINodeDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/csi-nodes",
+		ObjectStoreKey: store.Key{APIVersion: "storage.k8s.io/v1", Kind: "CSINode"},
+		ListType:       &storagev1.CSINodeList{},
+		ObjectType:     &storagev1.CSINode{},
+		Titles:         describer.ResourceTitle{List: "CSI Nodes", Object: "CSI Nodes"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewPersistentVolume,
+		RootPath:       describer.ResourceLink{Title: "Cluster Overview", Url: "/cluster-overview"},
+	})
+
+	storageVolumeAttachmentDescriber = describer.NewResource(describer.ResourceOptions{
+		Path:           "/storage/volume-attachments",
+		ObjectStoreKey: store.Key{APIVersion: "storage.k8s.io/v1", Kind: "VolumeAttachment"},
+		ListType:       &storagev1.VolumeAttachmentList{},
+		ObjectType:     &storagev1.VolumeAttachment{},
+		Titles:         describer.ResourceTitle{List: "Volume Attachments", Object: "Volume Attachments"},
+		ClusterWide:    true,
+		IconName:       icon.ClusterOverviewPersistentVolume,
+		RootPath:       describer.ResourceLink{Title: "Cluster Overview", Url: "/cluster-overview"},
+	})
+
 	storageDescriber = describer.NewSection(
 		"/storage",
 		"Storage",
 		storagePersistentVolumeDescriber,
+		storageStorageClassDescriber,
+		storageCSIDriverDescriber,
+		storageCSINodeDescriber,
+		storageVolumeAttachmentDescriber,
 	)
 
 	namespacesDescriber = describer.NewResource(describer.ResourceOptions{
@@ -20,6 +20,10 @@ var (
 		gvk.ClusterRole,
 		gvk.Node,
 		gvk.PersistentVolume,
+		gvk.StorageClass,
+		gvk.CSIDriver,
+		gvk.CSINode,
+		gvk.VolumeAttachment,
 		gvk.Namespace,
 		gvk.CustomResourceDefinition,
 		gvk.APIService,
@@ -28,7 +32,10 @@ var (
 	}
 )
 
-const rbacAPIVersion = "rbac.authorization.k8s.io/v1"
+const (
+	rbacAPIVersion    = "rbac.authorization.k8s.io/v1"
+	storageAPIVersion = "storage.k8s.io/v1"
+)
 
 func crdPath(namespace, crdName, version, name string) (string, error) {
 	return path.Join("/cluster-overview/custom-resources", crdName, version, name), nil
@@ -46,6 +53,14 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/nodes"
 	case apiVersion == "v1" && kind == "PersistentVolume":
 		p = "/storage/persistent-volumes"
+	case apiVersion == storageAPIVersion && kind == "StorageClass":
+		p = "/storage/storage-classes"
+	case apiVersion == storageAPIVersion && kind == "CSIDriver":
+		p = "/storage/csi-drivers"
+	case apiVersion == storageAPIVersion && kind == "CSINode":
+		p = "/storage/csi-nodes"
+	case apiVersion == storageAPIVersion && kind == "VolumeAttachment":
+		p = "/storage/volume-attachments"
 	case apiVersion == "v1" && kind == "Namespace":
 		p = "/namespaces"
 	case apiVersion == gvk.CustomResourceDefinition.GroupVersion().String() &&
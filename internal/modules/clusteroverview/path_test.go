@@ -44,6 +44,20 @@ func Test_gvk_path(t *testing.T) {
 			objectName: "cluster-role-binding",
 			expected:   path.Join("/cluster-overview", "rbac", "cluster-role-bindings", "cluster-role-binding"),
 		},
+		{
+			name:       "StorageClass",
+			apiVersion: storageAPIVersion,
+			kind:       "StorageClass",
+			objectName: "standard",
+			expected:   path.Join("/cluster-overview", "storage", "storage-classes", "standard"),
+		},
+		{
+			name:       "VolumeAttachment",
+			apiVersion: storageAPIVersion,
+			kind:       "VolumeAttachment",
+			objectName: "csi-attachment",
+			expected:   path.Join("/cluster-overview", "storage", "volume-attachments", "csi-attachment"),
+		},
 		{
 			name:       "unknown",
 			apiVersion: "unknown",
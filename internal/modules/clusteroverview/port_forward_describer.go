@@ -7,11 +7,14 @@ package clusteroverview
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -51,9 +54,23 @@ func (d *PortForwardListDescriber) Describe(ctx context.Context, namespace strin
 			"Ports":     component.NewPorts(describePortForwardPorts(pf)),
 			"Age":       component.NewTimestamp(pf.CreatedAt),
 		}
+		if pf.Profile == "" {
+			pfRow.AddAction(component.GridAction{
+				Name:       "Save Profile",
+				ActionPath: octant.ActionSavePortForwardProfile,
+				Payload:    action.Payload{"id": pf.ID},
+				Type:       component.GridActionPrimary,
+			})
+		}
 		tbl.Add(pfRow)
 	}
 
+	profileTable, err := describePortForwardProfiles(portForwarder, options)
+	if err != nil {
+		return component.EmptyContentResponse, err
+	}
+	list.Add(profileTable)
+
 	return component.ContentResponse{
 		Components: []component.Component{list},
 	}, nil
@@ -68,6 +85,73 @@ func (d *PortForwardListDescriber) Reset(ctx context.Context) error {
 	return nil
 }
 
+func describePortForwardProfiles(portForwarder portforward.PortForwarder, options describer.Options) (*component.Table, error) {
+	cols := component.NewTableCols("Name", "Target", "Namespace", "Ports", "Status")
+	tbl := component.NewTable("Port Forward Profiles",
+		"There are no port forward profiles! Save a port forward to create one.", cols)
+
+	profiles, err := portForwarder.Profiles()
+	if err != nil {
+		tbl.SetPlaceholder(fmt.Sprintf("Port forward profiles are unavailable: %s", err))
+		return tbl, nil
+	}
+
+	contextName := options.ContextName()
+	for _, profile := range profiles {
+		// Profiles saved in other kube contexts can't be started here.
+		if !profile.InContext(contextName) {
+			continue
+		}
+
+		apiVersion, kind := profile.GVK().ToAPIVersionAndKind()
+		targetLink, err := options.Link.ForGVK(profile.Namespace, apiVersion, kind, profile.Target, profile.Target)
+		if err != nil {
+			return nil, err
+		}
+
+		payload := action.Payload{"profileName": profile.Name}
+
+		status := "Stopped"
+		toggle := component.GridAction{
+			Name:       "Start",
+			ActionPath: octant.ActionStartPortForwardProfile,
+			Payload:    payload,
+			Type:       component.GridActionPrimary,
+		}
+		if portForwarder.ProfileRunning(profile.Name) {
+			status = "Running"
+			toggle = component.GridAction{
+				Name:       "Stop",
+				ActionPath: octant.ActionStopPortForwardProfile,
+				Payload:    payload,
+				Type:       component.GridActionPrimary,
+			}
+		}
+
+		row := component.TableRow{
+			"Name":      component.NewText(profile.Name),
+			"Target":    targetLink,
+			"Namespace": component.NewText(profile.Namespace),
+			"Ports":     component.NewText(fmt.Sprintf("%d -> %d", profile.LocalPort, profile.RemotePort)),
+			"Status":    component.NewText(status),
+		}
+		row.AddAction(toggle)
+		row.AddAction(component.GridAction{
+			Name:       "Delete",
+			ActionPath: octant.ActionDeletePortForwardProfile,
+			Payload:    payload,
+			Confirmation: &component.Confirmation{
+				Title: "Delete Port Forward Profile",
+				Body:  fmt.Sprintf("Are you sure you want to delete port forward profile **%s**?", profile.Name),
+			},
+			Type: component.GridActionDanger,
+		})
+		tbl.Add(row)
+	}
+
+	return tbl, nil
+}
+
 func describePortForwardPorts(pf portforward.State) []component.Port {
 	var list []component.Port
 	apiVersion, kind := pf.Target.GVK.ToAPIVersionAndKind()
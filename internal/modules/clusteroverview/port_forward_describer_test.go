@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package clusteroverview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	linkFake "github.com/vmware-tanzu/octant/internal/link/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/portforward"
+	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestPortForwardListDescriber_profiles(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	profiles := []portforward.Profile{
+		{Name: "db", Context: "cluster", APIVersion: "v1", Kind: "Pod", Namespace: "default", Target: "db-0", RemotePort: 5432, LocalPort: 5432},
+		{Name: "other", Context: "other", APIVersion: "v1", Kind: "Pod", Namespace: "default", Target: "other-0", RemotePort: 80, LocalPort: 8081},
+		{Name: "web", APIVersion: "v1", Kind: "Service", Namespace: "default", Target: "web", RemotePort: 80, LocalPort: 8080},
+	}
+
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+	portForwarder.EXPECT().List(gomock.Any()).Return(nil)
+	portForwarder.EXPECT().Profiles().Return(profiles, nil)
+	portForwarder.EXPECT().ProfileRunning("db").Return(false)
+	portForwarder.EXPECT().ProfileRunning("web").Return(true)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PortForwarder().Return(portForwarder)
+	dashConfig.EXPECT().ContextName().Return("cluster")
+
+	dbLink := component.NewLink("", "db-0", "/db-0")
+	webLink := component.NewLink("", "web", "/web")
+	lnk := linkFake.NewMockInterface(controller)
+	lnk.EXPECT().ForGVK("default", "v1", "Pod", "db-0", "db-0").Return(dbLink, nil)
+	lnk.EXPECT().ForGVK("default", "v1", "Service", "web", "web").Return(webLink, nil)
+
+	d := NewPortForwardListDescriber()
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig, Link: lnk})
+	require.NoError(t, err)
+
+	require.Len(t, cResponse.Components, 1)
+	list, ok := cResponse.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 2)
+
+	profileTable, ok := list.Config.Items[1].(*component.Table)
+	require.True(t, ok)
+
+	rows := profileTable.Rows()
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, component.NewText("Stopped"), rows[0]["Status"])
+	assert.Equal(t, component.NewText("5432 -> 5432"), rows[0]["Ports"])
+	assert.Equal(t, dbLink, rows[0]["Target"])
+
+	assert.Equal(t, component.NewText("Running"), rows[1]["Status"])
+
+	actions, ok := rows[1][component.GridActionKey].(*component.GridActions)
+	require.True(t, ok)
+	require.Len(t, actions.Config.Actions, 2)
+	assert.Equal(t, octant.ActionStopPortForwardProfile, actions.Config.Actions[0].ActionPath)
+	assert.Equal(t, action.Payload{"profileName": "web"}, actions.Config.Actions[0].Payload)
+	assert.Equal(t, octant.ActionDeletePortForwardProfile, actions.Config.Actions[1].ActionPath)
+}
+
+func TestPortForwardListDescriber_profilesUnavailable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+	portForwarder.EXPECT().List(gomock.Any()).Return(nil)
+	portForwarder.EXPECT().Profiles().Return(nil, errors.New("not configured"))
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().PortForwarder().Return(portForwarder)
+
+	d := NewPortForwardListDescriber()
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	list := cResponse.Components[0].(*component.List)
+	profileTable := list.Config.Items[1].(*component.Table)
+	assert.Equal(t, "Port forward profiles are unavailable: not configured", profileTable.Config.EmptyContent)
+}
@@ -7,26 +7,66 @@ package configuration
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	sigyaml "sigs.k8s.io/yaml"
 
 	"github.com/vmware-tanzu/octant/internal/describer"
 	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/diff"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
+// previewIgnoredFields are fields which are managed by the cluster. They are
+// removed from objects before they are compared.
+var previewIgnoredFields = [][]string{
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "uid"},
+	{"metadata", "selfLink"},
+	{"metadata", "creationTimestamp"},
+	{"status"},
+}
+
 // ApplyYamlDescriber describes an apply
 type ApplyYamlDescriber struct {
+	previews *octant.ApplyYamlPreviews
 }
 
 var _ describer.Describer = (*ApplyYamlDescriber)(nil)
 
-// Describe describes the apply yaml interface
+// Describe describes the apply yaml interface. YAML is previewed with a
+// server-side dry-run, and the preview is shown with an option to apply it.
 func (d *ApplyYamlDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	preview := d.previews.Get(ctx, namespace)
+
+	value := ""
+	if preview != nil {
+		value = preview.Update
+	}
+
 	title := append([]component.TitleComponent{}, component.NewText("Apply YAML"))
-	editor := component.NewEditor(component.TitleFromString("YAML"), "", false)
-	editor.Config.SubmitLabel = "Apply"
-	editor.Config.SubmitAction = octant.ActionApplyYaml
+	editor := component.NewEditor(component.TitleFromString("YAML"), value, false)
+	editor.Config.SubmitLabel = "Preview"
+	editor.Config.SubmitAction = octant.ActionPreviewYaml
 	list := component.NewList(title, []component.Component{editor})
 
+	if preview != nil {
+		list.Add(applyYamlPreviewActions(preview))
+		for _, result := range preview.Results {
+			card, err := applyYamlPreviewCard(result)
+			if err != nil {
+				return component.EmptyContentResponse, err
+			}
+			list.Add(card)
+		}
+	}
+
 	return component.ContentResponse{
 		Components: []component.Component{list},
 	}, nil
@@ -41,6 +81,106 @@ func (d *ApplyYamlDescriber) Reset(ctx context.Context) error {
 	return nil
 }
 
+// Previews returns the previews shown by the describer.
+func (d *ApplyYamlDescriber) Previews() *octant.ApplyYamlPreviews {
+	return d.previews
+}
+
 func NewApplyYamlDescriber() *ApplyYamlDescriber {
-	return &ApplyYamlDescriber{}
+	return &ApplyYamlDescriber{
+		previews: octant.NewApplyYamlPreviews(),
+	}
+}
+
+// applyYamlPreviewActions creates buttons for applying or discarding a preview.
+// Previews with errors can't be applied.
+func applyYamlPreviewActions(preview *octant.ApplyYamlPreview) *component.ButtonGroup {
+	buttonGroup := component.NewButtonGroup()
+
+	if !preview.HasErrors() {
+		confirmationTitle := "Apply YAML"
+		confirmationBody := fmt.Sprintf("Are you sure you want to apply %d resources to **%s**?",
+			len(preview.Results), preview.Namespace)
+		if preview.HasConflicts() {
+			confirmationBody += " Fields owned by other managers will be taken over."
+		}
+		buttonGroup.AddButton(component.NewButton("Apply",
+			action.CreatePayload(octant.ActionApplyYamlPreview, action.Payload{
+				"namespace": preview.Namespace,
+			}),
+			component.WithButtonConfirmation(confirmationTitle, confirmationBody)))
+	}
+
+	buttonGroup.AddButton(component.NewButton("Discard",
+		action.CreatePayload(octant.ActionDiscardYamlPreview, action.Payload{
+			"namespace": preview.Namespace,
+		})))
+
+	return buttonGroup
+}
+
+// applyYamlPreviewCard shows the changes a document would make to the cluster,
+// or the error the cluster returned for it.
+func applyYamlPreviewCard(result store.DryRunResult) (*component.Card, error) {
+	title := fmt.Sprintf("%s %s", result.Key.Kind, result.Key.Name)
+	if result.Key.Namespace != "" {
+		title = fmt.Sprintf("%s in %s", title, result.Key.Namespace)
+	}
+
+	card := component.NewCard(component.TitleFromString(title))
+
+	if result.Err != nil {
+		text := component.NewText(result.Err.Error())
+		text.SetStatus(component.TextStatusError)
+		card.SetBody(text)
+		return card, nil
+	}
+
+	live, err := previewYAML(result.Live)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun, err := previewYAML(result.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := diff.Lines(diff.SplitLines(live), diff.SplitLines(dryRun))
+	switch {
+	case result.Live == nil:
+		card.SetAlert(component.NewAlert(component.AlertTypeInfo, "This resource will be created"))
+	case len(result.Conflicts) > 0:
+		var conflicts []string
+		for _, conflict := range result.Conflicts {
+			conflicts = append(conflicts, conflict.String())
+		}
+		message := fmt.Sprintf("Applying takes over fields from other managers: %s", strings.Join(conflicts, "; "))
+		card.SetAlert(component.NewAlert(component.AlertTypeWarning, message))
+	case !diff.Changed(lines):
+		card.SetBody(component.NewText("No changes"))
+		return card, nil
+	}
+
+	card.SetBody(component.NewCodeBlock(strings.Join(lines, "\n")))
+	return card, nil
+}
+
+// previewYAML converts an object to YAML without fields managed by the cluster.
+func previewYAML(object *unstructured.Unstructured) (string, error) {
+	if object == nil {
+		return "", nil
+	}
+
+	object = object.DeepCopy()
+	for _, fields := range previewIgnoredFields {
+		unstructured.RemoveNestedField(object.Object, fields...)
+	}
+
+	data, err := sigyaml.Marshal(object.Object)
+	if err != nil {
+		return "", fmt.Errorf("convert %s %s to yaml: %w", object.GetKind(), object.GetName(), err)
+	}
+
+	return string(data), nil
 }
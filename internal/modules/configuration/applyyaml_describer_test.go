@@ -7,13 +7,21 @@ package configuration
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -37,8 +45,8 @@ func TestApplyYamlDescriber(t *testing.T) {
 	list := component.NewList(append([]component.TitleComponent{}, component.NewText("Apply YAML")), nil)
 
 	editor := component.NewEditor(component.TitleFromString("YAML"), "", false)
-	editor.Config.SubmitAction = "action.octant.dev/apply"
-	editor.Config.SubmitLabel = "Apply"
+	editor.Config.SubmitAction = "action.octant.dev/previewApply"
+	editor.Config.SubmitLabel = "Preview"
 	list.Add(editor)
 
 	require.Len(t, cResponse.Components, 1)
@@ -50,3 +58,119 @@ func TestApplyYamlDescriber(t *testing.T) {
 	err = p.Reset(context.TODO())
 	require.NoError(t, err)
 }
+
+func TestApplyYamlDescriber_Preview(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	p := NewApplyYamlDescriber()
+
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "greeting",
+			"namespace":       "default",
+			"resourceVersion": "1",
+		},
+		"data": map[string]interface{}{"hello": "world"},
+	}}
+	dryRun := live.DeepCopy()
+	dryRun.SetResourceVersion("2")
+	require.NoError(t, unstructured.SetNestedField(dryRun.Object, "octant", "data", "hello"))
+
+	update := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: greeting\ndata:\n  hello: octant\n"
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+
+	conflicts := []store.FieldConflict{{Field: ".data.hello", Manager: "kubectl"}}
+
+	ctx := ocontext.WithClientID(context.TODO(), "client")
+	p.Previews().Set(ctx, &octant.ApplyYamlPreview{
+		Namespace: "default",
+		Update:    update,
+		Results: []store.DryRunResult{
+			{Key: key, Live: live, Result: dryRun, Conflicts: conflicts},
+		},
+	})
+
+	options := describer.Options{
+		Dash: dashConfig,
+	}
+
+	cResponse, err := p.Describe(ctx, "default", options)
+	require.NoError(t, err)
+
+	editor := component.NewEditor(component.TitleFromString("YAML"), update, false)
+	editor.Config.SubmitAction = "action.octant.dev/previewApply"
+	editor.Config.SubmitLabel = "Preview"
+
+	buttonGroup := component.NewButtonGroup()
+	buttonGroup.AddButton(component.NewButton("Apply",
+		action.CreatePayload(octant.ActionApplyYamlPreview, action.Payload{
+			"namespace": "default",
+		}),
+		component.WithButtonConfirmation("Apply YAML",
+			"Are you sure you want to apply 1 resources to **default**? Fields owned by other managers will be taken over.")))
+	buttonGroup.AddButton(component.NewButton("Discard",
+		action.CreatePayload(octant.ActionDiscardYamlPreview, action.Payload{
+			"namespace": "default",
+		})))
+
+	card := component.NewCard(component.TitleFromString("ConfigMap greeting in default"))
+	card.SetAlert(component.NewAlert(component.AlertTypeWarning,
+		"Applying takes over fields from other managers: field .data.hello is owned by kubectl"))
+	card.SetBody(component.NewCodeBlock(strings.Join([]string{
+		"  apiVersion: v1",
+		"  data:",
+		"-   hello: world",
+		"+   hello: octant",
+		"  kind: ConfigMap",
+		"  metadata:",
+		"    name: greeting",
+		"    namespace: default",
+	}, "\n")))
+
+	list := component.NewList(append([]component.TitleComponent{}, component.NewText("Apply YAML")), nil)
+	list.Add(editor, buttonGroup, card)
+
+	require.Len(t, cResponse.Components, 1)
+	component.AssertEqual(t, list, cResponse.Components[0])
+
+	otherResponse, err := p.Describe(ocontext.WithClientID(context.TODO(), "other"), "default", options)
+	require.NoError(t, err)
+	require.Len(t, otherResponse.Components, 1)
+	otherList, ok := otherResponse.Components[0].(*component.List)
+	require.True(t, ok)
+	assert.Len(t, otherList.Config.Items, 1)
+}
+
+func Test_applyYamlPreviewCard(t *testing.T) {
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+
+	t.Run("error", func(t *testing.T) {
+		card, err := applyYamlPreviewCard(store.DryRunResult{Key: key, Err: fmt.Errorf("admission webhook denied the request")})
+		require.NoError(t, err)
+
+		text := component.NewText("admission webhook denied the request")
+		text.SetStatus(component.TextStatusError)
+
+		expected := component.NewCard(component.TitleFromString("ConfigMap greeting in default"))
+		expected.SetBody(text)
+		component.AssertEqual(t, expected, card)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		object := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+		}}
+		card, err := applyYamlPreviewCard(store.DryRunResult{Key: key, Live: object, Result: object})
+		require.NoError(t, err)
+
+		expected := component.NewCard(component.TitleFromString("ConfigMap greeting in default"))
+		expected.SetBody(component.NewText("No changes"))
+		component.AssertEqual(t, expected, card)
+	})
+}
@@ -100,6 +100,12 @@ func (c *Configuration) Navigation(ctx context.Context, namespace, root string)
 			Path:     path.Join(c.ContentPath(), "plugins"),
 			IconName: icon.ConfigurationPlugin,
 		},
+		{
+			Module:   "Configuration",
+			Title:    "Terminal Recordings",
+			Path:     path.Join(c.ContentPath(), "terminal-recordings"),
+			IconName: icon.ConfigurationTerminalRecordings,
+		},
 	}, nil
 }
 
@@ -144,7 +150,16 @@ func (c Configuration) Generators() []octant.Generator {
 func (c *Configuration) ActionPaths() map[string]action.DispatcherFunc {
 	objectDeleter := NewObjectDeleter(c.DashConfig.Logger(), c.DashConfig.ObjectStore())
 
+	previewYaml := octant.NewPreviewYaml(c.DashConfig.Logger(), c.DashConfig.ObjectStore(), applyYamlDescriber.Previews())
+	applyYamlPreview := octant.NewApplyYamlFromPreview(c.DashConfig.Logger(), c.DashConfig.ObjectStore(), applyYamlDescriber.Previews())
+	discardYamlPreview := octant.NewDiscardYamlPreview(applyYamlDescriber.Previews())
+	pluginEnabler := NewPluginEnabler(c.DashConfig.Logger(), c.DashConfig.PluginManager())
+
 	return map[string]action.DispatcherFunc{
-		objectDeleter.ActionName(): objectDeleter.Handle,
+		objectDeleter.ActionName():      objectDeleter.Handle,
+		previewYaml.ActionName():        previewYaml.Handle,
+		applyYamlPreview.ActionName():   applyYamlPreview.Handle,
+		discardYamlPreview.ActionName(): discardYamlPreview.Handle,
+		pluginEnabler.ActionName():      pluginEnabler.Handle,
 	}
 }
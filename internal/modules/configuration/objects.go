@@ -12,10 +12,13 @@ var (
 
 	applyYamlDescriber = NewApplyYamlDescriber()
 
+	terminalRecordingDescriber = NewTerminalRecordingDescriber()
+
 	rootDescriber = describer.NewSection(
 		"/",
 		"Configuration",
 		pluginDescriber,
 		applyYamlDescriber,
+		terminalRecordingDescriber,
 	)
 )
@@ -9,10 +9,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -25,21 +28,46 @@ var _ describer.Describer = (*PluginListDescriber)(nil)
 
 // Describe describes a list of plugins
 func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
-	pluginStore := options.PluginManager().Store()
+	pluginManager := options.PluginManager()
+	pluginStore := pluginManager.Store()
 	title := append([]component.TitleComponent{}, component.NewText("Plugins"))
 	list := component.NewList(title, nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Description", "Capabilities", "Status", "Restarts",
+		"Calls", "Errors", "Timeouts", "P95 Latency", "Actions")
 	tbl := component.NewTable("Plugins", "There are no plugins!", tableCols)
 	list.Add(tbl)
 
-	for _, n := range pluginStore.ClientNames() {
+	names := pluginStore.ClientNames()
+	inStore := make(map[string]bool)
+	for _, n := range names {
+		inStore[n] = true
+	}
+
+	// Plugins which are disabled or restarting aren't in the store.
+	statuses := pluginManager.Statuses()
+	statusByName := make(map[string]plugin.PluginStatus)
+	for _, status := range statuses {
+		statusByName[status.Name] = status
+		if !inStore[status.Name] {
+			names = append(names, status.Name)
+		}
+	}
+
+	for _, n := range names {
+		status, hasStatus := statusByName[n]
+
 		var metadata *plugin.Metadata
-		if plugin.IsJavaScriptPlugin(n) {
+		var stats *plugin.JSPluginStats
+		if !inStore[n] {
+			metadata = &status.Metadata
+		} else if plugin.IsJavaScriptPlugin(n) {
 			jsPlugin, ok := pluginStore.GetJS(n)
 			if !ok {
 				return component.EmptyContentResponse, fmt.Errorf("plugin %s not found", n)
 			}
 			metadata = jsPlugin.Metadata()
+			jsStats := jsPlugin.Stats()
+			stats = &jsStats
 		} else {
 			var err error
 			metadata, err = pluginStore.GetMetadata(n)
@@ -62,6 +90,7 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 			name string
 			list []schema.GroupVersionKind
 		}{
+			{name: "Object Relations", list: metadata.Capabilities.SupportsObjectRelations},
 			{name: "Object Status", list: metadata.Capabilities.SupportsObjectStatus},
 			{name: "Printer Config", list: metadata.Capabilities.SupportsPrinterConfig},
 			{name: "Printer Items", list: metadata.Capabilities.SupportsPrinterItems},
@@ -85,15 +114,28 @@ func (d *PluginListDescriber) Describe(ctx context.Context, namespace string, op
 		}
 
 		row := component.TableRow{
-			"Name":         component.NewText(metadata.Name),
+			"Name":         component.NewText(pluginDisplayName(n, *metadata)),
 			"Description":  component.NewText(metadata.Description),
 			"Capabilities": component.NewText(sb.String()),
 		}
+		if hasStatus {
+			addPluginStatus(row, status, stats)
+		}
+		if stats != nil {
+			addJSPluginStats(row, *stats)
+		}
 		tbl.Add(row)
 	}
 
 	tbl.Sort("Name", false)
 
+	for _, status := range statuses {
+		if status.LastError == "" {
+			continue
+		}
+		list.Add(pluginLogTail(status))
+	}
+
 	return component.ContentResponse{
 		Components: []component.Component{list},
 	}, nil
@@ -112,6 +154,90 @@ func NewPluginListDescriber() *PluginListDescriber {
 	return &PluginListDescriber{}
 }
 
+// pluginDisplayName is the name a plugin registered with, or its name in the
+// store if it hasn't registered.
+func pluginDisplayName(name string, metadata plugin.Metadata) string {
+	if metadata.Name != "" {
+		return metadata.Name
+	}
+	return name
+}
+
+// addPluginStatus adds a plugin's state and a button which enables or disables
+// it to a row. A running JavaScript plugin is unhealthy if its calls keep
+// being interrupted.
+func addPluginStatus(row component.TableRow, status plugin.PluginStatus, stats *plugin.JSPluginStats) {
+	var text *component.Text
+	switch {
+	case status.State == plugin.PluginStateRunning && stats != nil && !stats.Healthy:
+		text = component.NewText("Unhealthy")
+		text.SetStatus(component.TextStatusError)
+	case status.State == plugin.PluginStateRunning:
+		text = component.NewText("Running")
+		text.SetStatus(component.TextStatusOK)
+	case status.State == plugin.PluginStateRestarting:
+		text = component.NewText("Restarting")
+		text.SetStatus(component.TextStatusWarning)
+	case status.State == plugin.PluginStateCrashLooping:
+		text = component.NewText("Crash Looping")
+		text.SetStatus(component.TextStatusError)
+	default:
+		text = component.NewText(string(status.State))
+	}
+	row["Status"] = text
+
+	if !plugin.IsJavaScriptPlugin(status.Name) {
+		row["Restarts"] = component.NewText(fmt.Sprintf("%d", status.Restarts))
+	}
+
+	buttonGroup := component.NewButtonGroup()
+	if status.State == plugin.PluginStateDisabled {
+		buttonGroup.AddButton(component.NewButton("Enable",
+			action.CreatePayload(octant.ActionSetPluginEnabled, action.Payload{
+				"pluginName": status.Name,
+				"enabled":    true,
+			})))
+	} else {
+		confirmationBody := fmt.Sprintf("Are you sure you want to disable plugin **%s**?",
+			pluginDisplayName(status.Name, status.Metadata))
+		buttonGroup.AddButton(component.NewButton("Disable",
+			action.CreatePayload(octant.ActionSetPluginEnabled, action.Payload{
+				"pluginName": status.Name,
+				"enabled":    false,
+			}),
+			component.WithButtonConfirmation("Disable Plugin", confirmationBody)))
+	}
+	row["Actions"] = buttonGroup
+}
+
+// pluginLogTail shows why a plugin last stopped and its most recent output.
+func pluginLogTail(status plugin.PluginStatus) *component.Code {
+	output := strings.Join(status.LogTail, "\n")
+	if output == "" {
+		output = "No output"
+	}
+
+	code := component.NewCodeBlock(output)
+	code.Metadata.SetTitleText(fmt.Sprintf("%s: %s",
+		pluginDisplayName(status.Name, status.Metadata), status.LastError))
+	return code
+}
+
+// addJSPluginStats adds a JavaScript plugin's call statistics to a row.
+func addJSPluginStats(row component.TableRow, stats plugin.JSPluginStats) {
+	row["Calls"] = component.NewText(fmt.Sprintf("%d", stats.Calls))
+	row["Errors"] = component.NewText(fmt.Sprintf("%d", stats.Errors))
+	row["Timeouts"] = component.NewText(fmt.Sprintf("%d", stats.Timeouts))
+	row["P95 Latency"] = component.NewText(formatLatency(stats.P95Latency))
+}
+
+func formatLatency(d time.Duration) string {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond).String()
+	}
+	return d.Round(time.Millisecond).String()
+}
+
 func summarizeSupports(name string, list []schema.GroupVersionKind) (string, bool) {
 	if len(list) < 1 {
 		return "", false
@@ -8,6 +8,7 @@ package configuration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/go-plugin"
@@ -17,6 +18,8 @@ import (
 	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
 	"github.com/vmware-tanzu/octant/internal/describer"
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
 	dashPlugin "github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
 	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
@@ -33,13 +36,14 @@ func TestPluginDescriber(t *testing.T) {
 		Name:        name,
 		Description: "this is a test",
 		Capabilities: dashPlugin.Capabilities{
-			SupportsPrinterConfig: []schema.GroupVersionKind{gvk.Pod},
-			SupportsPrinterStatus: []schema.GroupVersionKind{gvk.Pod},
-			SupportsPrinterItems:  []schema.GroupVersionKind{gvk.Pod},
-			SupportsObjectStatus:  []schema.GroupVersionKind{gvk.Pod},
-			SupportsTab:           []schema.GroupVersionKind{gvk.Pod},
-			IsModule:              true,
-			ActionNames:           []string{"action"},
+			SupportsPrinterConfig:   []schema.GroupVersionKind{gvk.Pod},
+			SupportsPrinterStatus:   []schema.GroupVersionKind{gvk.Pod},
+			SupportsPrinterItems:    []schema.GroupVersionKind{gvk.Pod},
+			SupportsObjectStatus:    []schema.GroupVersionKind{gvk.Pod},
+			SupportsTab:             []schema.GroupVersionKind{gvk.Pod},
+			SupportsObjectRelations: []schema.GroupVersionKind{gvk.Pod},
+			IsModule:                true,
+			ActionNames:             []string{"action"},
 		},
 	}
 
@@ -47,8 +51,47 @@ func TestPluginDescriber(t *testing.T) {
 	client := newFakePluginClient(name, controller)
 	require.NoError(t, store.Store(name, client, metadata, "cmd"))
 
+	jsName := "js-plugin.js"
+	jsPlugin := pluginFake.NewMockJSPlugin(controller)
+	jsMetadata := &dashPlugin.Metadata{
+		Name:        "js-plugin",
+		Description: "a JavaScript plugin",
+	}
+	jsPlugin.EXPECT().Metadata().Return(jsMetadata)
+	jsPlugin.EXPECT().Stats().Return(dashPlugin.JSPluginStats{
+		Calls:      10,
+		Errors:     4,
+		Timeouts:   3,
+		P95Latency: 1500 * time.Millisecond,
+		Healthy:    false,
+	})
+	require.NoError(t, store.StoreJS(jsName, jsPlugin))
+
 	pluginManager := pluginFake.NewMockManagerInterface(controller)
 	pluginManager.EXPECT().Store().Return(store).AnyTimes()
+	pluginManager.EXPECT().Statuses().Return([]dashPlugin.PluginStatus{
+		{
+			Name:     jsName,
+			Metadata: *jsMetadata,
+			State:    dashPlugin.PluginStateRunning,
+		},
+		{
+			Name:      name,
+			Metadata:  *metadata,
+			State:     dashPlugin.PluginStateRunning,
+			Restarts:  2,
+			LastError: "plugin exited",
+			LogTail:   []string{"[DEBUG] panic: boom", "[DEBUG] goroutine 1 [running]:"},
+		},
+		{
+			Name: "stopped-plugin",
+			Metadata: dashPlugin.Metadata{
+				Name:        "stopped",
+				Description: "a disabled plugin",
+			},
+			State: dashPlugin.PluginStateDisabled,
+		},
+	})
 
 	dashConfig := configFake.NewMockDash(controller)
 	dashConfig.EXPECT().PluginManager().Return(pluginManager)
@@ -63,23 +106,75 @@ func TestPluginDescriber(t *testing.T) {
 	cResponse, err := p.Describe(ctx, namespace, options)
 	require.NoError(t, err)
 
-	capabilitiesData := "[Module], [Actions: action], [Object Status: v1 Pod], [Printer Config: v1 Pod], [Printer Items: v1 Pod], [Printer Status: v1 Pod], [Tab: v1 Pod]"
+	capabilitiesData := "[Module], [Actions: action], [Object Relations: v1 Pod], [Object Status: v1 Pod], [Printer Config: v1 Pod], [Printer Items: v1 Pod], [Printer Status: v1 Pod], [Tab: v1 Pod]"
 
 	list := component.NewList(append([]component.TitleComponent{}, component.NewText("Plugins")), nil)
-	tableCols := component.NewTableCols("Name", "Description", "Capabilities")
+	tableCols := component.NewTableCols("Name", "Description", "Capabilities", "Status", "Restarts",
+		"Calls", "Errors", "Timeouts", "P95 Latency", "Actions")
 	table := component.NewTable("Plugins", "There are no plugins!", tableCols)
+
+	unhealthy := component.NewText("Unhealthy")
+	unhealthy.SetStatus(component.TextStatusError)
+	table.Add(component.TableRow{
+		"Name":         component.NewText("js-plugin"),
+		"Description":  component.NewText("a JavaScript plugin"),
+		"Capabilities": component.NewText(""),
+		"Status":       unhealthy,
+		"Calls":        component.NewText("10"),
+		"Errors":       component.NewText("4"),
+		"Timeouts":     component.NewText("3"),
+		"P95 Latency":  component.NewText("1.5s"),
+		"Actions":      disableButton(jsName, "js-plugin"),
+	})
+
+	running := component.NewText("Running")
+	running.SetStatus(component.TextStatusOK)
 	table.Add(component.TableRow{
 		"Name":         component.NewText(name),
 		"Description":  component.NewText("this is a test"),
 		"Capabilities": component.NewText(capabilitiesData),
+		"Status":       running,
+		"Restarts":     component.NewText("2"),
+		"Actions":      disableButton(name, name),
+	})
+
+	enable := component.NewButtonGroup()
+	enable.AddButton(component.NewButton("Enable",
+		action.CreatePayload(octant.ActionSetPluginEnabled, action.Payload{
+			"pluginName": "stopped-plugin",
+			"enabled":    true,
+		})))
+	table.Add(component.TableRow{
+		"Name":         component.NewText("stopped"),
+		"Description":  component.NewText("a disabled plugin"),
+		"Capabilities": component.NewText(""),
+		"Status":       component.NewText("Disabled"),
+		"Restarts":     component.NewText("0"),
+		"Actions":      enable,
 	})
 
 	list.Add(table)
 
+	logTail := component.NewCodeBlock("[DEBUG] panic: boom\n[DEBUG] goroutine 1 [running]:")
+	logTail.Metadata.SetTitleText("plugin-test: plugin exited")
+	list.Add(logTail)
+
 	require.Len(t, cResponse.Components, 1)
 	component.AssertEqual(t, list, cResponse.Components[0])
 }
 
+func disableButton(pluginName, displayName string) *component.ButtonGroup {
+	buttonGroup := component.NewButtonGroup()
+	buttonGroup.AddButton(component.NewButton("Disable",
+		action.CreatePayload(octant.ActionSetPluginEnabled, action.Payload{
+			"pluginName": pluginName,
+			"enabled":    false,
+		}),
+		component.WithButtonConfirmation("Disable Plugin",
+			"Are you sure you want to disable plugin **"+displayName+"**?")))
+	return buttonGroup
+}
+
 func newFakePluginClient(name string, controller *gomock.Controller) *fakePluginClient {
 	service := fake.NewMockService(controller)
 	metadata := dashPlugin.Metadata{
@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+)
+
+// PluginEnabler enables and disables plugins.
+type PluginEnabler struct {
+	logger        log.Logger
+	pluginManager plugin.ManagerInterface
+}
+
+// NewPluginEnabler creates an instance of PluginEnabler.
+func NewPluginEnabler(logger log.Logger, pluginManager plugin.ManagerInterface) *PluginEnabler {
+	return &PluginEnabler{
+		logger:        logger.With("action", octant.ActionSetPluginEnabled),
+		pluginManager: pluginManager,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *PluginEnabler) ActionName() string {
+	return octant.ActionSetPluginEnabled
+}
+
+// Handle enables or disables the plugin in the payload.
+func (e *PluginEnabler) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	e.logger.With("payload", payload).Debugf("setting plugin enabled")
+
+	name, err := payload.String("pluginName")
+	if err != nil {
+		return err
+	}
+
+	enabled, err := payload.Bool("enabled")
+	if err != nil {
+		return err
+	}
+
+	verb := "Disabled"
+	if enabled {
+		verb = "Enabled"
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("%s plugin %q", verb, name)
+	if err := e.pluginManager.SetEnabled(ctx, name, enabled); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to set plugin %q enabled to %t: %s", name, enabled, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
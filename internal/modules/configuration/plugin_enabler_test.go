@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
+)
+
+func TestPluginEnabler_ActionName(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+
+	e := NewPluginEnabler(log.NopLogger(), pluginManager)
+	require.Equal(t, octant.ActionSetPluginEnabled, e.ActionName())
+}
+
+func TestPluginEnabler_Handle(t *testing.T) {
+	cases := []struct {
+		name      string
+		enabled   bool
+		err       error
+		alertType action.AlertType
+		message   string
+	}{
+		{
+			name:      "enable",
+			enabled:   true,
+			alertType: action.AlertTypeInfo,
+			message:   `Enabled plugin "plugin"`,
+		},
+		{
+			name:      "disable",
+			enabled:   false,
+			alertType: action.AlertTypeInfo,
+			message:   `Disabled plugin "plugin"`,
+		},
+		{
+			name:      "failure",
+			enabled:   true,
+			err:       assert.AnError,
+			alertType: action.AlertTypeWarning,
+			message:   `Unable to set plugin "plugin" enabled to true: ` + assert.AnError.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			pluginManager := pluginFake.NewMockManagerInterface(controller)
+			pluginManager.EXPECT().
+				SetEnabled(gomock.Any(), "plugin", tc.enabled).
+				Return(tc.err)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				Do(func(alert action.Alert) {
+					assert.Equal(t, tc.alertType, alert.Type)
+					assert.Equal(t, tc.message, alert.Message)
+				})
+
+			e := NewPluginEnabler(log.NopLogger(), pluginManager)
+
+			payload := action.Payload{
+				"pluginName": "plugin",
+				"enabled":    tc.enabled,
+			}
+
+			ctx := context.Background()
+			require.NoError(t, e.Handle(ctx, alerter, payload))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/internal/terminal"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const terminalRecordingsPath = "/terminal-recordings"
+
+// TerminalRecordingDescriber describes the list of terminal recordings, and
+// replays a recording.
+type TerminalRecordingDescriber struct {
+}
+
+var _ describer.Describer = (*TerminalRecordingDescriber)(nil)
+
+// NewTerminalRecordingDescriber creates an instance of TerminalRecordingDescriber.
+func NewTerminalRecordingDescriber() *TerminalRecordingDescriber {
+	return &TerminalRecordingDescriber{}
+}
+
+// Describe describes a terminal recording if the path has a name. Otherwise it
+// describes the list of terminal recordings.
+func (d *TerminalRecordingDescriber) Describe(ctx context.Context, namespace string, options describer.Options) (component.ContentResponse, error) {
+	if name := options.Fields["name"]; name != "" {
+		return d.describeRecording(name, options)
+	}
+
+	return d.describeList(options)
+}
+
+func (d *TerminalRecordingDescriber) describeList(options describer.Options) (component.ContentResponse, error) {
+	title := append([]component.TitleComponent{}, component.NewText("Terminal Recordings"))
+	list := component.NewList(title, nil)
+
+	dir := options.TerminalRecordingDir()
+	placeholder := "There are no terminal recordings!"
+	if dir == "" {
+		placeholder = "Terminal sessions are not being recorded. Start Octant with --terminal-recording-dir to record them."
+	}
+
+	tableCols := component.NewTableCols("Name", "Namespace", "Pod", "Container", "Command", "Started", "Duration")
+	tbl := component.NewTable("Terminal Recordings", placeholder, tableCols)
+	list.Add(tbl)
+
+	if dir != "" {
+		recordings, err := terminal.ListRecordings(dir)
+		if err != nil {
+			return component.EmptyContentResponse, fmt.Errorf("list terminal recordings: %w", err)
+		}
+
+		for _, recording := range recordings {
+			ref := path.Join("/configuration", terminalRecordingsPath, recording.Name)
+			source := recording.Header.Source
+
+			row := component.TableRow{
+				"Name":      component.NewLink("", recording.Name, ref),
+				"Namespace": component.NewText(source.Namespace),
+				"Pod":       component.NewText(source.Pod),
+				"Container": component.NewText(source.Container),
+				"Command":   component.NewText(recording.Header.Command),
+				"Started":   component.NewTimestamp(recording.StartedAt()),
+				"Duration":  component.NewText(recording.Duration.Round(time.Second).String()),
+			}
+			tbl.Add(row)
+		}
+	}
+
+	return component.ContentResponse{
+		Components: []component.Component{list},
+	}, nil
+}
+
+func (d *TerminalRecordingDescriber) describeRecording(name string, options describer.Options) (component.ContentResponse, error) {
+	dir := options.TerminalRecordingDir()
+	if dir == "" {
+		return component.EmptyContentResponse, fmt.Errorf("terminal sessions are not being recorded")
+	}
+
+	// The recording is fetched by the component, so it isn't sent again each
+	// time the content is refreshed.
+	f, err := terminal.OpenRecording(dir, name)
+	if err != nil {
+		return component.EmptyContentResponse, fmt.Errorf("open terminal recording: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return component.EmptyContentResponse, fmt.Errorf("close terminal recording: %w", err)
+	}
+
+	title := component.Title(
+		component.NewLink("", "Terminal Recordings", path.Join("/configuration", terminalRecordingsPath)),
+		component.NewText(name))
+
+	return component.ContentResponse{
+		Title:      title,
+		Components: []component.Component{component.NewTerminalRecording(name, name)},
+	}, nil
+}
+
+// PathFilters returns the path filters for the terminal recording list and
+// for a terminal recording.
+func (d *TerminalRecordingDescriber) PathFilters() []describer.PathFilter {
+	return []describer.PathFilter{
+		*describer.NewPathFilter(terminalRecordingsPath, d),
+		*describer.NewPathFilter(path.Join(terminalRecordingsPath, "(?P<name>[^/]+)"), d),
+	}
+}
+
+// Reset does nothing.
+func (d *TerminalRecordingDescriber) Reset(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package configuration
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/describer"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func TestTerminalRecordingDescriber(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cast := `{"version":2,"width":80,"height":24,"timestamp":100,"command":"/bin/sh","octant":{"namespace":"default","pod":"web","container":"app"}}` + "\n" +
+		`[61.5,"o","exit"]` + "\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "session.cast"), []byte(cast), 0600))
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().TerminalRecordingDir().Return(dir).AnyTimes()
+
+	d := NewTerminalRecordingDescriber()
+	ctx := context.Background()
+
+	t.Run("list", func(t *testing.T) {
+		cResponse, err := d.Describe(ctx, "", describer.Options{Dash: dashConfig})
+		require.NoError(t, err)
+
+		list := component.NewList(append([]component.TitleComponent{}, component.NewText("Terminal Recordings")), nil)
+		tableCols := component.NewTableCols("Name", "Namespace", "Pod", "Container", "Command", "Started", "Duration")
+		table := component.NewTable("Terminal Recordings", "There are no terminal recordings!", tableCols)
+		table.Add(component.TableRow{
+			"Name":      component.NewLink("", "session", "/configuration/terminal-recordings/session"),
+			"Namespace": component.NewText("default"),
+			"Pod":       component.NewText("web"),
+			"Container": component.NewText("app"),
+			"Command":   component.NewText("/bin/sh"),
+			"Started":   component.NewTimestamp(time.Unix(100, 0)),
+			"Duration":  component.NewText("1m2s"),
+		})
+		list.Add(table)
+
+		require.Len(t, cResponse.Components, 1)
+		component.AssertEqual(t, list, cResponse.Components[0])
+	})
+
+	t.Run("recording", func(t *testing.T) {
+		options := describer.Options{
+			Dash:   dashConfig,
+			Fields: map[string]string{"name": "session"},
+		}
+		cResponse, err := d.Describe(ctx, "", options)
+		require.NoError(t, err)
+
+		require.Len(t, cResponse.Components, 1)
+		component.AssertEqual(t, component.NewTerminalRecording("session", "session"), cResponse.Components[0])
+	})
+
+	t.Run("missing recording", func(t *testing.T) {
+		options := describer.Options{
+			Dash:   dashConfig,
+			Fields: map[string]string{"name": "missing"},
+		}
+		_, err := d.Describe(ctx, "", options)
+		assert.Error(t, err)
+	})
+}
+
+func TestTerminalRecordingDescriber_notRecording(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().TerminalRecordingDir().Return("").AnyTimes()
+
+	d := NewTerminalRecordingDescriber()
+
+	cResponse, err := d.Describe(context.Background(), "", describer.Options{Dash: dashConfig})
+	require.NoError(t, err)
+
+	require.Len(t, cResponse.Components, 1)
+	list, ok := cResponse.Components[0].(*component.List)
+	require.True(t, ok)
+	require.Len(t, list.Config.Items, 1)
+	table, ok := list.Config.Items[0].(*component.Table)
+	require.True(t, ok)
+	assert.Contains(t, table.Config.EmptyContent, "--terminal-recording-dir")
+}
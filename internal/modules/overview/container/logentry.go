@@ -8,21 +8,39 @@ package container
 var _ LogEntry = (*logEntry)(nil)
 
 func NewLogEntry(container, line string) logEntry {
+	return NewPodLogEntry("", container, line)
+}
+
+// NewPodLogEntry creates a log entry for a container in a pod. It is used
+// when logs for multiple pods are streamed together.
+func NewPodLogEntry(pod, container, line string) logEntry {
 	return logEntry{
-		container: container,
-		line:      line,
+		pod:        pod,
+		container:  container,
+		line:       line,
+		structured: ParseLogMessage(logMessage(line)),
 	}
 }
 
 type logEntry struct {
-	line      string
-	container string
+	line       string
+	pod        string
+	container  string
+	structured StructuredLog
 }
 
 func (l logEntry) Line() string {
 	return l.line
 }
 
+func (l logEntry) Pod() string {
+	return l.pod
+}
+
 func (l logEntry) Container() string {
 	return l.container
 }
+
+func (l logEntry) Structured() StructuredLog {
+	return l.structured
+}
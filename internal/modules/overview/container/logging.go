@@ -9,7 +9,13 @@ import "context"
 
 type LogEntry interface {
 	Line() string
+	// Pod returns the name of the pod the entry is from. It is empty if
+	// the stream is for a single pod.
+	Pod() string
 	Container() string
+	// Structured returns the fields parsed from the message. Its format is
+	// empty if the message isn't structured.
+	Structured() StructuredLog
 }
 
 type LogStreamer interface {
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogOptions are options for querying container logs.
+type LogOptions struct {
+	// Previous returns logs for the previously terminated container.
+	Previous bool
+	// SinceTime returns logs newer than a time.
+	SinceTime *time.Time
+	// SinceSeconds returns logs newer than a relative duration in seconds.
+	SinceSeconds *int64
+	// TailLines returns this many lines from the end of the logs.
+	TailLines *int64
+	// LimitBytes limits the number of bytes returned for each container.
+	LimitBytes *int64
+	// Include only sends lines matching this expression.
+	Include *regexp.Regexp
+	// Exclude drops lines matching this expression.
+	Exclude *regexp.Regexp
+	// FieldFilters only sends structured lines matching all of the filters.
+	FieldFilters []FieldFilter
+}
+
+// PodLogOptions converts LogOptions to options for the pod logs API.
+func (o LogOptions) PodLogOptions(container string) *corev1.PodLogOptions {
+	podLogOptions := &corev1.PodLogOptions{
+		Container:    container,
+		Follow:       true,
+		Timestamps:   true,
+		Previous:     o.Previous,
+		SinceSeconds: o.SinceSeconds,
+		TailLines:    o.TailLines,
+		LimitBytes:   o.LimitBytes,
+	}
+
+	if o.SinceTime != nil {
+		sinceTime := metav1.NewTime(*o.SinceTime)
+		podLogOptions.SinceTime = &sinceTime
+	}
+
+	return podLogOptions
+}
+
+// Matches returns true if a log line should be sent. The expressions are
+// matched against the message, and not the timestamp prefixing it.
+func (o LogOptions) Matches(line string) bool {
+	return o.MatchesEntry(NewLogEntry("", line))
+}
+
+// MatchesEntry returns true if a log entry should be sent. The entry's parsed
+// message is reused for the field filters.
+func (o LogOptions) MatchesEntry(entry LogEntry) bool {
+	message := logMessage(entry.Line())
+
+	if o.Include != nil && !o.Include.MatchString(message) {
+		return false
+	}
+
+	if o.Exclude != nil && o.Exclude.MatchString(message) {
+		return false
+	}
+
+	structured := entry.Structured()
+	for _, filter := range o.FieldFilters {
+		if !filter.Matches(structured) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// logMessage strips the timestamp from a log line.
+func logMessage(line string) string {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) != 2 {
+		return line
+	}
+
+	if _, err := time.Parse(time.RFC3339Nano, parts[0]); err != nil {
+		return line
+	}
+
+	return parts[1]
+}
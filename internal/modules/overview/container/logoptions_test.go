@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLogOptions_PodLogOptions(t *testing.T) {
+	tailLines := int64(10)
+	sinceTime := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	options := LogOptions{
+		Previous:  true,
+		SinceTime: &sinceTime,
+		TailLines: &tailLines,
+	}
+
+	expected := &corev1.PodLogOptions{
+		Container:  "app",
+		Follow:     true,
+		Timestamps: true,
+		Previous:   true,
+		SinceTime:  &metav1.Time{Time: sinceTime},
+		TailLines:  &tailLines,
+	}
+
+	assert.Equal(t, expected, options.PodLogOptions("app"))
+}
+
+func TestLogOptions_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  LogOptions
+		line     string
+		expected bool
+	}{
+		{
+			name:     "no filters",
+			line:     "2020-05-01T10:00:00.123456789Z hello",
+			expected: true,
+		},
+		{
+			name:     "include matches message",
+			options:  LogOptions{Include: regexp.MustCompile("^hello")},
+			line:     "2020-05-01T10:00:00.123456789Z hello",
+			expected: true,
+		},
+		{
+			name:     "include does not match",
+			options:  LogOptions{Include: regexp.MustCompile("error")},
+			line:     "2020-05-01T10:00:00.123456789Z hello",
+			expected: false,
+		},
+		{
+			name:     "exclude matches",
+			options:  LogOptions{Exclude: regexp.MustCompile("^debug")},
+			line:     "2020-05-01T10:00:00.123456789Z debug: noisy",
+			expected: false,
+		},
+		{
+			name:     "field filter matches",
+			options:  LogOptions{FieldFilters: []FieldFilter{{Field: "level", Operator: ">=", Value: "warn"}}},
+			line:     `2020-05-01T10:00:00.123456789Z {"level":"error","msg":"failed"}`,
+			expected: true,
+		},
+		{
+			name:     "field filter does not match",
+			options:  LogOptions{FieldFilters: []FieldFilter{{Field: "level", Operator: ">=", Value: "warn"}}},
+			line:     `2020-05-01T10:00:00.123456789Z {"level":"info","msg":"ok"}`,
+			expected: false,
+		},
+		{
+			name:     "line without timestamp",
+			options:  LogOptions{Include: regexp.MustCompile("^hello")},
+			line:     "hello",
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.options.Matches(test.line))
+		})
+	}
+}
@@ -0,0 +1,387 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// LogFormatJSON is the format of a JSON log line.
+	LogFormatJSON = "json"
+	// LogFormatLogfmt is the format of a logfmt log line.
+	LogFormatLogfmt = "logfmt"
+)
+
+var (
+	levelKeys   = []string{"level", "lvl", "severity"}
+	messageKeys = []string{"msg", "message"}
+	callerKeys  = []string{"caller", "source"}
+
+	// levelSeverity orders levels so they can be compared.
+	levelSeverity = map[string]int{
+		"trace":    0,
+		"debug":    1,
+		"info":     2,
+		"warn":     3,
+		"warning":  3,
+		"error":    4,
+		"err":      4,
+		"fatal":    5,
+		"critical": 5,
+		"dpanic":   5,
+		"panic":    5,
+	}
+
+	// numericLevels are the levels logged as numbers by pino and bunyan.
+	numericLevels = map[string]string{
+		"10": "trace",
+		"20": "debug",
+		"30": "info",
+		"40": "warn",
+		"50": "error",
+		"60": "fatal",
+	}
+)
+
+// StructuredLog is a log message parsed into fields.
+type StructuredLog struct {
+	// Format is the format the message was parsed from. It is empty if the
+	// message is not structured.
+	Format string
+	Level  string
+	// Message is the message field. It is empty if the message does not
+	// have a message field.
+	Message string
+	Caller  string
+	// Fields are the remaining fields in the message.
+	Fields map[string]string
+}
+
+// IsStructured returns true if the message was parsed.
+func (l StructuredLog) IsStructured() bool {
+	return l.Format != ""
+}
+
+// Field returns a field. Level, message, and caller are returned by their
+// canonical names, i.e. level, msg, and caller.
+func (l StructuredLog) Field(name string) (string, bool) {
+	switch name {
+	case "level":
+		return l.Level, l.Level != ""
+	case "msg":
+		return l.Message, l.Message != ""
+	case "caller":
+		return l.Caller, l.Caller != ""
+	}
+
+	value, ok := l.Fields[name]
+	return value, ok
+}
+
+// ParseLogMessage detects JSON and logfmt log messages and extracts their
+// fields. If the message isn't structured, the returned StructuredLog has
+// an empty format.
+func ParseLogMessage(message string) StructuredLog {
+	trimmed := strings.TrimSpace(message)
+
+	if strings.HasPrefix(trimmed, "{") {
+		if fields, ok := parseJSONFields(trimmed); ok {
+			return newStructuredLog(LogFormatJSON, fields)
+		}
+	}
+
+	if fields, ok := parseLogfmtFields(trimmed); ok {
+		return newStructuredLog(LogFormatLogfmt, fields)
+	}
+
+	return StructuredLog{}
+}
+
+func newStructuredLog(format string, fields map[string]string) StructuredLog {
+	l := StructuredLog{
+		Format: format,
+		Fields: fields,
+	}
+
+	l.Level = normalizeLevel(takeField(fields, levelKeys))
+	l.Message = takeField(fields, messageKeys)
+	l.Caller = takeField(fields, callerKeys)
+
+	return l
+}
+
+// normalizeLevel lower cases a level and converts numeric levels to their names.
+func normalizeLevel(level string) string {
+	level = strings.ToLower(level)
+	if name, ok := numericLevels[level]; ok {
+		return name
+	}
+
+	return level
+}
+
+// severity returns the severity of a level.
+func severity(level string) (int, bool) {
+	value, ok := levelSeverity[normalizeLevel(level)]
+	return value, ok
+}
+
+// takeField removes the first key found from the fields and returns its value.
+func takeField(fields map[string]string, keys []string) string {
+	for _, key := range keys {
+		if value, ok := fields[key]; ok {
+			delete(fields, key)
+			return value
+		}
+	}
+
+	return ""
+}
+
+func parseJSONFields(line string) (map[string]string, bool) {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &m); err != nil {
+		return nil, false
+	}
+
+	fields := map[string]string{}
+	for k, v := range m {
+		switch t := v.(type) {
+		case string:
+			fields[k] = t
+		case nil:
+			fields[k] = ""
+		case float64:
+			// format without an exponent, e.g. pino's millisecond timestamps
+			fields[k] = strconv.FormatFloat(t, 'f', -1, 64)
+		case bool:
+			fields[k] = fmt.Sprint(t)
+		default:
+			data, err := json.Marshal(t)
+			if err != nil {
+				return nil, false
+			}
+			fields[k] = string(data)
+		}
+	}
+
+	return fields, true
+}
+
+// parseLogfmtFields parses a logfmt line. The line is only considered logfmt
+// if every token is a key=value pair and there are at least two pairs, so
+// prose containing an equals sign isn't mistaken for logfmt.
+func parseLogfmtFields(line string) (map[string]string, bool) {
+	fields := map[string]string{}
+
+	i := 0
+	for i < len(line) {
+		for i < len(line) && line[i] == ' ' {
+			i++
+		}
+		if i == len(line) {
+			break
+		}
+
+		start := i
+		for i < len(line) && line[i] != '=' && line[i] != ' ' {
+			i++
+		}
+		key := line[start:i]
+		if key == "" || !isLogfmtKey(key) || i == len(line) || line[i] != '=' {
+			return nil, false
+		}
+		i++
+
+		var value string
+		if i < len(line) && line[i] == '"' {
+			end := i + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(line) {
+				return nil, false
+			}
+
+			unquoted, err := strconv.Unquote(line[i : end+1])
+			if err != nil {
+				return nil, false
+			}
+			value = unquoted
+			i = end + 1
+		} else {
+			start := i
+			for i < len(line) && line[i] != ' ' {
+				i++
+			}
+			value = line[start:i]
+		}
+
+		fields[key] = value
+	}
+
+	if len(fields) < 2 {
+		return nil, false
+	}
+
+	return fields, true
+}
+
+func isLogfmtKey(key string) bool {
+	for _, r := range key {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.' || r == '/') {
+			return false
+		}
+	}
+	return true
+}
+
+// FieldFilter filters structured log messages on the value of a field.
+type FieldFilter struct {
+	Field    string
+	Operator string
+	Value    string
+}
+
+// fieldFilterOperators are the supported operators. Longer operators are listed
+// first so they are matched before their prefixes at the same position.
+var fieldFilterOperators = []string{">=", "<=", "!=", "=", ">", "<"}
+
+// ParseFieldFilters parses a comma separated list of field filters, e.g.
+// `level>=warn,request_id=abc`. All of the filters must match for a message
+// to match.
+func ParseFieldFilters(s string) ([]FieldFilter, error) {
+	var filters []FieldFilter
+
+	for _, clause := range strings.Split(s, ",") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+
+		filter, err := parseFieldFilter(clause)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+func parseFieldFilter(clause string) (FieldFilter, error) {
+	i, operator := findFieldFilterOperator(clause)
+	if i < 0 {
+		return FieldFilter{}, fmt.Errorf("field filter %q has no operator", clause)
+	}
+
+	filter := FieldFilter{
+		Field:    strings.TrimSpace(clause[:i]),
+		Operator: operator,
+		Value:    strings.TrimSpace(clause[i+len(operator):]),
+	}
+
+	if filter.Field == "" {
+		return FieldFilter{}, fmt.Errorf("field filter %q has no field", clause)
+	}
+
+	if filter.Field == "level" && filter.Operator != "=" && filter.Operator != "!=" {
+		if _, ok := severity(filter.Value); !ok {
+			return FieldFilter{}, fmt.Errorf("field filter %q has unknown level %q", clause, filter.Value)
+		}
+	}
+
+	return filter, nil
+}
+
+// findFieldFilterOperator finds the first operator in a clause, so operators
+// in the value are left alone. It returns -1 if there is no operator.
+func findFieldFilterOperator(clause string) (int, string) {
+	for i := range clause {
+		for _, operator := range fieldFilterOperators {
+			if strings.HasPrefix(clause[i:], operator) {
+				return i, operator
+			}
+		}
+	}
+
+	return -1, ""
+}
+
+// Matches returns true if a structured log matches the filter. Messages
+// without the field never match.
+func (f FieldFilter) Matches(l StructuredLog) bool {
+	value, ok := l.Field(f.Field)
+	if !ok {
+		return false
+	}
+
+	filterValue := f.Value
+	if f.Field == "level" {
+		filterValue = normalizeLevel(filterValue)
+	}
+
+	switch f.Operator {
+	case "=":
+		return strings.EqualFold(value, filterValue)
+	case "!=":
+		return !strings.EqualFold(value, filterValue)
+	}
+
+	cmp, ok := f.compare(value)
+	if !ok {
+		return false
+	}
+
+	switch f.Operator {
+	case ">=":
+		return cmp >= 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	default:
+		return false
+	}
+}
+
+// compare compares a value to the filter's value. Levels are compared by
+// severity, and numbers are compared numerically.
+func (f FieldFilter) compare(value string) (int, bool) {
+	if f.Field == "level" {
+		a, ok := severity(value)
+		if !ok {
+			return 0, false
+		}
+		b, _ := severity(f.Value)
+		return a - b, true
+	}
+
+	a, errA := strconv.ParseFloat(value, 64)
+	b, errB := strconv.ParseFloat(f.Value, 64)
+	if errA != nil || errB != nil {
+		return strings.Compare(value, f.Value), true
+	}
+
+	switch {
+	case a < b:
+		return -1, true
+	case a > b:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected StructuredLog
+	}{
+		{
+			name:    "json",
+			message: `{"level":"INFO","msg":"started","caller":"main.go:10","port":8080,"tls":false,"tags":["a"]}`,
+			expected: StructuredLog{
+				Format:  LogFormatJSON,
+				Level:   "info",
+				Message: "started",
+				Caller:  "main.go:10",
+				Fields:  map[string]string{"port": "8080", "tls": "false", "tags": `["a"]`},
+			},
+		},
+		{
+			name:    "logfmt",
+			message: `level=warn message="slow request" request_id=abc duration=1.5s`,
+			expected: StructuredLog{
+				Format:  LogFormatLogfmt,
+				Level:   "warn",
+				Message: "slow request",
+				Fields:  map[string]string{"request_id": "abc", "duration": "1.5s"},
+			},
+		},
+		{
+			name:    "numeric level",
+			message: `{"level":30,"time":1593600000000,"msg":"started"}`,
+			expected: StructuredLog{
+				Format:  LogFormatJSON,
+				Level:   "info",
+				Message: "started",
+				Fields:  map[string]string{"time": "1593600000000"},
+			},
+		},
+		{
+			name:     "plain text",
+			message:  "listening on port 8080",
+			expected: StructuredLog{},
+		},
+		{
+			name:     "text with an equals sign",
+			message:  "set retries=3 for client",
+			expected: StructuredLog{},
+		},
+		{
+			name:     "invalid json",
+			message:  `{"level": `,
+			expected: StructuredLog{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ParseLogMessage(test.message))
+		})
+	}
+}
+
+func TestParseFieldFilters(t *testing.T) {
+	filters, err := ParseFieldFilters("level>=warn, request_id=abc,status!=200")
+	require.NoError(t, err)
+
+	expected := []FieldFilter{
+		{Field: "level", Operator: ">=", Value: "warn"},
+		{Field: "request_id", Operator: "=", Value: "abc"},
+		{Field: "status", Operator: "!=", Value: "200"},
+	}
+	assert.Equal(t, expected, filters)
+
+	filters, err = ParseFieldFilters("url=a>=b,path<=/a=b")
+	require.NoError(t, err)
+
+	expected = []FieldFilter{
+		{Field: "url", Operator: "=", Value: "a>=b"},
+		{Field: "path", Operator: "<=", Value: "/a=b"},
+	}
+	assert.Equal(t, expected, filters)
+
+	_, err = ParseFieldFilters("level")
+	assert.Error(t, err)
+
+	_, err = ParseFieldFilters("level>=loud")
+	assert.Error(t, err)
+
+	_, err = ParseFieldFilters("=abc")
+	assert.Error(t, err)
+}
+
+func TestFieldFilter_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		message  string
+		expected bool
+	}{
+		{name: "level above", filter: "level>=warn", message: `{"level":"error"}`, expected: true},
+		{name: "level equal", filter: "level>=warn", message: `{"level":"warning"}`, expected: true},
+		{name: "level below", filter: "level>=warn", message: `{"level":"info"}`, expected: false},
+		{name: "numeric level above", filter: "level>=warn", message: `{"level":50}`, expected: true},
+		{name: "numeric level below", filter: "level>=warn", message: `{"level":30}`, expected: false},
+		{name: "numeric level equal", filter: "level=warn", message: `{"level":40}`, expected: true},
+		{name: "numeric filter", filter: "level>=40", message: `{"level":"error"}`, expected: true},
+		{name: "zap dpanic", filter: "level>=error", message: `{"level":"dpanic"}`, expected: true},
+		{name: "field equal", filter: "request_id=abc", message: `request_id=abc msg=done`, expected: true},
+		{name: "field not equal", filter: "request_id=abc", message: `request_id=def msg=done`, expected: false},
+		{name: "number", filter: "status>=500", message: `{"status":503}`, expected: true},
+		{name: "number below", filter: "status>=500", message: `{"status":99}`, expected: false},
+		{name: "missing field", filter: "request_id=abc", message: `{"level":"info"}`, expected: false},
+		{name: "plain text", filter: "level>=warn", message: "error: it broke", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filters, err := ParseFieldFilters(test.filter)
+			require.NoError(t, err)
+			require.Len(t, filters, 1)
+
+			assert.Equal(t, test.expected, filters[0].Matches(ParseLogMessage(test.message)))
+		})
+	}
+}
@@ -23,6 +23,7 @@ type logStreamer struct {
 	namespace  string
 	pod        string
 	containers []string
+	options    LogOptions
 	stream     chan LogEntry
 
 	ctx      context.Context
@@ -34,7 +35,8 @@ type logStreamer struct {
 var _ LogStreamer = (*logStreamer)(nil)
 
 // NewLogStreamer returns an instance of a logStream configured to stream logs for the given namespace/pod/container(s).
-func NewLogStreamer(ctx context.Context, dashConfig config.Dash, key store.Key, containerNames ...string) (*logStreamer, error) {
+// Options control which logs are queried, and which lines are sent.
+func NewLogStreamer(ctx context.Context, dashConfig config.Dash, key store.Key, options LogOptions, containerNames ...string) (*logStreamer, error) {
 	ctx, cancelFn := context.WithCancel(ctx)
 
 	if shouldFetchContainerNames(containerNames) {
@@ -64,6 +66,7 @@ func NewLogStreamer(ctx context.Context, dashConfig config.Dash, key store.Key,
 		namespace:  key.Namespace,
 		pod:        key.Name,
 		containers: containerNames,
+		options:    options,
 		config:     dashConfig,
 		ctx:        ctx,
 		cancelFn:   cancelFn,
@@ -112,6 +115,9 @@ func (s *logStreamer) Stream(ctx context.Context, logCh chan<- LogEntry) {
 			scanner := bufio.NewScanner(stream)
 			for ctx.Err() == nil && scanner.Scan() {
 				entry := NewLogEntry(container, scanner.Text())
+				if !s.options.MatchesEntry(entry) {
+					continue
+				}
 				logCh <- entry
 			}
 			return
@@ -138,10 +144,6 @@ func (s *logStreamer) containerStream(container string) (io.ReadCloser, error) {
 	if err != nil {
 		return nil, err
 	}
-	request := client.CoreV1().Pods(s.namespace).GetLogs(s.pod, &corev1.PodLogOptions{
-		Container:  container,
-		Follow:     true,
-		Timestamps: true,
-	})
+	request := client.CoreV1().Pods(s.namespace).GetLogs(s.pod, s.options.PodLogOptions(container))
 	return request.Stream(s.ctx)
 }
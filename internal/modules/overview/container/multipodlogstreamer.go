@@ -0,0 +1,314 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// mergeInterval is how long entries are buffered before they are sent. Entries
+// from different pods received within the interval are sent in timestamp order.
+const mergeInterval = 250 * time.Millisecond
+
+type openStreamFunc func(ctx context.Context, pod, container string) (io.ReadCloser, error)
+
+// podStream is a log stream for a container in a pod.
+type podStream struct {
+	cancelFn     context.CancelFunc
+	restartCount int32
+	done         bool
+}
+
+// timedLogEntry is a log entry with the time it was logged.
+type timedLogEntry struct {
+	timestamp time.Time
+	entry     LogEntry
+}
+
+type multiPodLogStreamer struct {
+	namespace  string
+	resolve    PodResolver
+	options    LogOptions
+	config     config.Dash
+	openStream openStreamFunc
+
+	ctx      context.Context
+	cancelFn context.CancelFunc
+
+	mu      sync.Mutex
+	streams map[string]*podStream
+}
+
+var _ LogStreamer = (*multiPodLogStreamer)(nil)
+
+// NewMultiPodLogStreamer returns a log streamer which streams logs for all the
+// containers in a set of pods. The pods are resolved when streaming starts and
+// whenever a pod in the namespace changes, so pods are attached and detached as
+// they come and go. Entries are prefixed with their pod and container.
+func NewMultiPodLogStreamer(ctx context.Context, dashConfig config.Dash, namespace string, resolve PodResolver, options LogOptions) *multiPodLogStreamer {
+	ctx, cancelFn := context.WithCancel(ctx)
+
+	s := &multiPodLogStreamer{
+		namespace: namespace,
+		resolve:   resolve,
+		options:   options,
+		config:    dashConfig,
+		ctx:       ctx,
+		cancelFn:  cancelFn,
+		streams:   map[string]*podStream{},
+	}
+	s.openStream = s.podLogs
+
+	return s
+}
+
+// Names returns the pod/container names logs are being streamed for.
+func (s *multiPodLogStreamer) Names() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := []string{}
+	for name, ps := range s.streams {
+		if !ps.done {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Stream streams logs for the pods to a log channel. The log channel is closed
+// when the context is cancelled or the streamer is closed.
+func (s *multiPodLogStreamer) Stream(ctx context.Context, logCh chan<- LogEntry) {
+	ctx, cancelFn := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			cancelFn()
+		case <-ctx.Done():
+		}
+	}()
+
+	entries := make(chan timedLogEntry, 100)
+	resync := make(chan struct{}, 1)
+	resync <- struct{}{}
+
+	// The object store removes the handler once the stream is done.
+	notify := func(interface{}) {
+		select {
+		case resync <- struct{}{}:
+		default:
+		}
+	}
+	handler := kcache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, newObj interface{}) { notify(newObj) },
+		DeleteFunc: notify,
+	}
+
+	podKey := store.Key{Namespace: s.namespace, APIVersion: "v1", Kind: "Pod"}
+	if err := s.config.ObjectStore().Watch(ctx, podKey, handler); err != nil {
+		s.config.Logger().WithErr(err).Errorf("unable to watch pods for log stream")
+	}
+
+	go s.merge(ctx, entries, logCh)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-resync:
+				s.sync(ctx, entries)
+			}
+		}
+	}()
+}
+
+// Close stops streaming logs. The log channel is closed once the streams have stopped.
+func (s *multiPodLogStreamer) Close(_ chan<- LogEntry) {
+	s.cancelFn()
+}
+
+// sync attaches streams for new pods and containers, and detaches streams for
+// pods which no longer exist.
+func (s *multiPodLogStreamer) sync(ctx context.Context, entries chan<- timedLogEntry) {
+	pods, err := s.resolve(ctx)
+	if err != nil {
+		s.config.Logger().WithErr(err).Errorf("unable to resolve pods for log stream")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := map[string]bool{}
+	for i := range pods {
+		pod := pods[i]
+		for _, status := range StreamableContainers(pod, s.options.Previous) {
+			name := pod.Name + "/" + status.Name
+			current[name] = true
+
+			ps, ok := s.streams[name]
+			if ok && (!ps.done || ps.restartCount == status.RestartCount) {
+				continue
+			}
+
+			s.start(ctx, entries, pod.Name, status.Name, status.RestartCount)
+		}
+	}
+
+	for name, ps := range s.streams {
+		if !current[name] {
+			ps.cancelFn()
+			delete(s.streams, name)
+		}
+	}
+}
+
+// start starts streaming logs for a container. It must be called with the lock held.
+func (s *multiPodLogStreamer) start(ctx context.Context, entries chan<- timedLogEntry, pod, container string, restartCount int32) {
+	name := pod + "/" + container
+
+	streamCtx, cancelFn := context.WithCancel(ctx)
+	ps := &podStream{cancelFn: cancelFn, restartCount: restartCount}
+	s.streams[name] = ps
+
+	go func() {
+		defer func() {
+			cancelFn()
+			s.mu.Lock()
+			if s.streams[name] == ps {
+				ps.done = true
+			}
+			s.mu.Unlock()
+		}()
+
+		stream, err := s.openStream(streamCtx, pod, container)
+		if err != nil {
+			s.config.Logger().WithErr(err).Debugf("unable to stream logs for %s", name)
+			s.mu.Lock()
+			if s.streams[name] == ps {
+				// Forget the stream so it is retried on the next sync.
+				delete(s.streams, name)
+			}
+			s.mu.Unlock()
+			return
+		}
+		defer stream.Close()
+
+		scanner := bufio.NewScanner(stream)
+		for streamCtx.Err() == nil && scanner.Scan() {
+			line := scanner.Text()
+			logEntry := NewPodLogEntry(pod, container, line)
+			if !s.options.MatchesEntry(logEntry) {
+				continue
+			}
+
+			entry := timedLogEntry{
+				timestamp: logTimestamp(line),
+				entry:     logEntry,
+			}
+
+			select {
+			case entries <- entry:
+			case <-streamCtx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// merge buffers entries from all the streams and sends them in timestamp order.
+func (s *multiPodLogStreamer) merge(ctx context.Context, entries <-chan timedLogEntry, logCh chan<- LogEntry) {
+	defer close(logCh)
+
+	ticker := time.NewTicker(mergeInterval)
+	defer ticker.Stop()
+
+	var buffer []timedLogEntry
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-entries:
+			buffer = append(buffer, entry)
+		case <-ticker.C:
+			sortLogEntries(buffer)
+			for _, entry := range buffer {
+				select {
+				case logCh <- entry.entry:
+				case <-ctx.Done():
+					return
+				}
+			}
+			buffer = buffer[:0]
+		}
+	}
+}
+
+func (s *multiPodLogStreamer) podLogs(ctx context.Context, pod, container string) (io.ReadCloser, error) {
+	client, err := s.config.ClusterClient().KubernetesClient()
+	if err != nil {
+		return nil, err
+	}
+	request := client.CoreV1().Pods(s.namespace).GetLogs(pod, s.options.PodLogOptions(container))
+	return request.Stream(ctx)
+}
+
+// StreamableContainers returns the statuses of the containers in a pod which
+// have logs. When previous is true, only containers which have terminated
+// before are returned.
+func StreamableContainers(pod corev1.Pod, previous bool) []corev1.ContainerStatus {
+	var statuses []corev1.ContainerStatus
+	for _, list := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, status := range list {
+			if previous {
+				if status.LastTerminationState.Terminated != nil {
+					statuses = append(statuses, status)
+				}
+				continue
+			}
+
+			if status.State.Running != nil || status.State.Terminated != nil {
+				statuses = append(statuses, status)
+			}
+		}
+	}
+
+	return statuses
+}
+
+// logTimestamp returns the timestamp prefixing a log line. If the line has no
+// timestamp, the current time is returned.
+func logTimestamp(line string) time.Time {
+	parts := strings.SplitN(line, " ", 2)
+	if ts, err := time.Parse(time.RFC3339Nano, parts[0]); err == nil {
+		return ts
+	}
+
+	return time.Now()
+}
+
+func sortLogEntries(entries []timedLogEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].timestamp.Before(entries[j].timestamp)
+	})
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configFake "github.com/vmware-tanzu/octant/internal/config/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func runningPod(name string, containers ...string) corev1.Pod {
+	pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	for _, container := range containers {
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+			Name:  container,
+			State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+		})
+	}
+	return pod
+}
+
+func TestMultiPodLogStreamer_Stream(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}, gomock.Any()).
+		Return(nil)
+
+	dashConfig := configFake.NewMockDash(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().Logger().Return(log.NopLogger()).AnyTimes()
+
+	resolver := func(ctx context.Context) ([]corev1.Pod, error) {
+		pending := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pending"}}
+		pending.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "app"}}
+
+		return []corev1.Pod{runningPod("web-1", "app"), runningPod("web-2", "app"), pending}, nil
+	}
+
+	logs := map[string]string{
+		"web-1/app": "2020-05-01T10:00:01Z second\n2020-05-01T10:00:03Z fourth\n",
+		"web-2/app": "2020-05-01T10:00:00Z first\n2020-05-01T10:00:02Z third\n",
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := NewMultiPodLogStreamer(ctx, dashConfig, "default", resolver, LogOptions{})
+	s.openStream = func(ctx context.Context, pod, container string) (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(logs[pod+"/"+container])), nil
+	}
+
+	logCh := make(chan LogEntry)
+	s.Stream(ctx, logCh)
+
+	var got []string
+	timeout := time.After(5 * time.Second)
+	for len(got) < 4 {
+		select {
+		case entry := <-logCh:
+			got = append(got, entry.Pod()+"/"+entry.Container()+" "+logMessage(entry.Line()))
+		case <-timeout:
+			t.Fatalf("timed out waiting for log entries; got %v", got)
+		}
+	}
+
+	expected := []string{
+		"web-2/app first",
+		"web-1/app second",
+		"web-2/app third",
+		"web-1/app fourth",
+	}
+	assert.Equal(t, expected, got)
+
+	s.Close(logCh)
+	for range logCh {
+	}
+}
+
+func TestStreamableContainers(t *testing.T) {
+	pod := corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "running", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{Name: "waiting", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}}},
+				{
+					Name:                 "restarted",
+					State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}},
+				},
+			},
+		},
+	}
+
+	names := func(statuses []corev1.ContainerStatus) []string {
+		var list []string
+		for _, status := range statuses {
+			list = append(list, status.Name)
+		}
+		return list
+	}
+
+	assert.Equal(t, []string{"running"}, names(StreamableContainers(pod, false)))
+	assert.Equal(t, []string{"restarted"}, names(StreamableContainers(pod, true)))
+}
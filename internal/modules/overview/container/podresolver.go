@@ -0,0 +1,173 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/queryer"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// PodResolver returns the pods logs are streamed for.
+type PodResolver func(ctx context.Context) ([]corev1.Pod, error)
+
+// IsLogWorkloadKind returns true if logs can be streamed for all the pods
+// of a kind.
+func IsLogWorkloadKind(kind string) bool {
+	switch kind {
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "ReplicationController", "Job", "Service":
+		return true
+	default:
+		return false
+	}
+}
+
+// SelectorPodResolver resolves the pods in a namespace matching a label selector.
+func SelectorPodResolver(objectStore store.Store, namespace string, selector labels.Selector) PodResolver {
+	return func(ctx context.Context) ([]corev1.Pod, error) {
+		return listPods(ctx, objectStore, namespace, selector)
+	}
+}
+
+// NamedPodResolver resolves a single pod.
+func NamedPodResolver(objectStore store.Store, key store.Key) PodResolver {
+	return func(ctx context.Context) ([]corev1.Pod, error) {
+		object, err := objectStore.Get(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("get pod %s: %w", key, err)
+		}
+		if object == nil {
+			return nil, fmt.Errorf("pod %s was not found", key)
+		}
+
+		pod := corev1.Pod{}
+		if err := kubernetes.FromUnstructured(object, &pod); err != nil {
+			return nil, fmt.Errorf("convert pod: %w", err)
+		}
+
+		return []corev1.Pod{pod}, nil
+	}
+}
+
+// WorkloadPodResolver resolves the current pods for a workload. Pods for
+// services are found with the queryer. Other workloads use their pod selector.
+// A new queryer is created for each resolution, so pods which have been
+// created since the last resolution are found.
+func WorkloadPodResolver(objectStore store.Store, newQueryer func() queryer.Queryer, key store.Key) PodResolver {
+	return func(ctx context.Context) ([]corev1.Pod, error) {
+		object, err := objectStore.Get(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("get workload %s: %w", key, err)
+		}
+		if object == nil {
+			return nil, fmt.Errorf("workload %s was not found", key)
+		}
+
+		if key.Kind == "Service" {
+			service := &corev1.Service{}
+			if err := kubernetes.FromUnstructured(object, service); err != nil {
+				return nil, fmt.Errorf("convert service: %w", err)
+			}
+
+			pods, err := newQueryer().PodsForService(ctx, service)
+			if err != nil {
+				return nil, fmt.Errorf("find pods for service: %w", err)
+			}
+
+			var list []corev1.Pod
+			for i := range pods {
+				list = append(list, *pods[i])
+			}
+			return list, nil
+		}
+
+		selector, err := WorkloadSelector(object)
+		if err != nil {
+			return nil, err
+		}
+
+		return listPods(ctx, objectStore, key.Namespace, selector)
+	}
+}
+
+// WorkloadSelector returns the selector for the pods of a workload.
+func WorkloadSelector(object *unstructured.Unstructured) (labels.Selector, error) {
+	if object == nil {
+		return nil, fmt.Errorf("workload is nil")
+	}
+
+	if object.GetKind() == "ReplicationController" {
+		m, _, err := unstructured.NestedStringMap(object.Object, "spec", "selector")
+		if err != nil {
+			return nil, fmt.Errorf("read replication controller selector: %w", err)
+		}
+		if len(m) == 0 {
+			return nil, fmt.Errorf("%s %q has no selector", object.GetKind(), object.GetName())
+		}
+		return labels.SelectorFromSet(m), nil
+	}
+
+	m, found, err := unstructured.NestedMap(object.Object, "spec", "selector")
+	if err != nil {
+		return nil, fmt.Errorf("read workload selector: %w", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("%s %q has no selector", object.GetKind(), object.GetName())
+	}
+
+	labelSelector := &metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, labelSelector); err != nil {
+		return nil, fmt.Errorf("convert workload selector: %w", err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("create workload selector: %w", err)
+	}
+
+	if selector.Empty() {
+		return nil, fmt.Errorf("%s %q has an empty selector", object.GetKind(), object.GetName())
+	}
+
+	return selector, nil
+}
+
+func listPods(ctx context.Context, objectStore store.Store, namespace string, selector labels.Selector) ([]corev1.Pod, error) {
+	key := store.Key{
+		Namespace:  namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	objects, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+
+	var pods []corev1.Pod
+	for i := range objects.Items {
+		if !selector.Matches(labels.Set(objects.Items[i].GetLabels())) {
+			continue
+		}
+
+		pod := corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&objects.Items[i], &pod); err != nil {
+			return nil, fmt.Errorf("convert pod: %w", err)
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package container
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestSelectorPodResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	web := testutil.CreatePod("web")
+	web.Labels = map[string]string{"app": "web"}
+	db := testutil.CreatePod("db")
+	db.Labels = map[string]string{"app": "db"}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}).
+		Return(testutil.ToUnstructuredList(t, web, db), false, nil)
+
+	selector, err := labels.Parse("app=web")
+	require.NoError(t, err)
+
+	pods, err := SelectorPodResolver(objectStore, "default", selector)(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pods, 1)
+	assert.Equal(t, "web", pods[0].Name)
+}
+
+func TestNamedPodResolver(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	web := testutil.CreatePod("web")
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Name: "web"}
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, web), nil)
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(nil, nil)
+
+	pods, err := NamedPodResolver(objectStore, key)(context.Background())
+	require.NoError(t, err)
+	require.Len(t, pods, 1)
+	assert.Equal(t, "web", pods[0].Name)
+
+	_, err = NamedPodResolver(objectStore, key)(context.Background())
+	assert.Error(t, err)
+}
+
+func TestWorkloadSelector(t *testing.T) {
+	deployment := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+	require.NoError(t, unstructured.SetNestedField(deployment.Object, map[string]interface{}{
+		"matchLabels": map[string]interface{}{"app": "web"},
+	}, "spec", "selector"))
+
+	selector, err := WorkloadSelector(deployment)
+	require.NoError(t, err)
+	assert.Equal(t, "app=web", selector.String())
+
+	rc := testutil.ToUnstructured(t, testutil.CreateReplicationController("rc"))
+	require.NoError(t, unstructured.SetNestedField(rc.Object, map[string]interface{}{"app": "db"}, "spec", "selector"))
+
+	selector, err = WorkloadSelector(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "app=db", selector.String())
+}
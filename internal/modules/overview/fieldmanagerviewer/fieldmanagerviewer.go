@@ -0,0 +1,467 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fieldmanagerviewer
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	sigyaml "sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var (
+	managerColumns = component.NewTableCols("Manager", "Operation", "API Version", "Updated", "Fields")
+	fieldColumns   = component.NewTableCols("Field", "Manager", "Operation", "Updated")
+)
+
+// ToComponent converts an object into a component which shows the fields
+// owned by each of the object's field managers.
+func ToComponent(object runtime.Object) (*component.FlexLayout, error) {
+	fv, err := new(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "create field manager viewer")
+	}
+
+	return fv.ToComponent()
+}
+
+// fieldManagerViewer is a field manager viewer for objects.
+type fieldManagerViewer struct {
+	object runtime.Object
+}
+
+// new creates an instance of fieldManagerViewer.
+func new(object runtime.Object) (*fieldManagerViewer, error) {
+	if object == nil {
+		return nil, errors.New("can't create field manager view for nil object")
+	}
+
+	return &fieldManagerViewer{
+		object: object,
+	}, nil
+}
+
+// ToComponent converts the fieldManagerViewer to a component.
+func (fv *fieldManagerViewer) ToComponent() (*component.FlexLayout, error) {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(fv.object)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert object to unstructured")
+	}
+	u := &unstructured.Unstructured{Object: m}
+
+	managers, err := parseManagedFields(u.GetManagedFields())
+	if err != nil {
+		return nil, err
+	}
+
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+
+	w := newAnnotatedWriter(managers)
+	w.writeMap(u.Object, 0, "", w.rootSets())
+
+	layout := component.NewFlexLayout("Field Managers")
+	layout.AddSections(
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: w.managerTable()},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: w.fieldTable()},
+		},
+		component.FlexLayoutSection{
+			{Width: component.WidthFull, View: w.codeBlock()},
+		},
+	)
+
+	return layout, nil
+}
+
+// fieldSet is a decoded fieldsV1 set. Keys are prefixed with "f:" for fields,
+// "k:" for keyed list items, "v:" for set values, and "i:" for list indexes.
+// The "." key marks ownership of the containing item.
+type fieldSet map[string]fieldSet
+
+// manager is a field manager and the fields it owns.
+type manager struct {
+	Name       string
+	Operation  string
+	APIVersion string
+	Time       *metav1.Time
+	Fields     fieldSet
+}
+
+// String describes the manager for annotations.
+func (m manager) String() string {
+	return fmt.Sprintf("%s (%s %s)", m.Name, m.Operation, m.updated())
+}
+
+func (m manager) updated() string {
+	if m.Time == nil {
+		return "unknown"
+	}
+	return m.Time.UTC().Format(time.RFC3339)
+}
+
+func parseManagedFields(entries []metav1.ManagedFieldsEntry) ([]manager, error) {
+	var managers []manager
+	for _, entry := range entries {
+		fields := fieldSet{}
+		if entry.FieldsV1 != nil && len(entry.FieldsV1.Raw) > 0 {
+			if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+				return nil, errors.Wrapf(err, "decode fields for manager %q", entry.Manager)
+			}
+		}
+
+		managers = append(managers, manager{
+			Name:       entry.Manager,
+			Operation:  string(entry.Operation),
+			APIVersion: entry.APIVersion,
+			Time:       entry.Time,
+			Fields:     fields,
+		})
+	}
+
+	return managers, nil
+}
+
+// ownedField is a field owned by a manager.
+type ownedField struct {
+	path    string
+	manager int
+}
+
+type annotatedLine struct {
+	indent int
+	dash   bool
+	text   string
+	owners []int
+}
+
+// annotatedWriter writes an object as YAML with each line annotated with the
+// managers which own it.
+type annotatedWriter struct {
+	managers []manager
+	lines    []annotatedLine
+	fields   []ownedField
+}
+
+func newAnnotatedWriter(managers []manager) *annotatedWriter {
+	return &annotatedWriter{managers: managers}
+}
+
+// rootSets returns the field set for each manager at the root of the object.
+func (w *annotatedWriter) rootSets() []fieldSet {
+	sets := make([]fieldSet, len(w.managers))
+	for i := range w.managers {
+		sets[i] = w.managers[i].Fields
+	}
+	return sets
+}
+
+func (w *annotatedWriter) writeMap(m map[string]interface{}, indent int, path string, sets []fieldSet) {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		childPath := k
+		if path != "" {
+			childPath = path + "." + k
+		}
+		w.writeValue(indent, k+":", m[k], childPath, descendField(sets, k))
+	}
+}
+
+func (w *annotatedWriter) writeValue(indent int, label string, value interface{}, path string, sets []fieldSet) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			w.addLine(indent, label+" {}", path, leafOwners(sets))
+			return
+		}
+		w.addLine(indent, label, path, containerOwners(sets))
+		w.writeMap(v, indent+1, path, sets)
+	case []interface{}:
+		if len(v) == 0 {
+			w.addLine(indent, label+" []", path, leafOwners(sets))
+			return
+		}
+		w.addLine(indent, label, path, containerOwners(sets))
+		w.writeList(v, indent, path, sets)
+	default:
+		text := strings.TrimSuffix(scalarString(v), "\n")
+		w.addLine(indent, label+" "+text, path, leafOwners(sets))
+	}
+}
+
+func (w *annotatedWriter) writeList(list []interface{}, indent int, path string, sets []fieldSet) {
+	for i, item := range list {
+		itemSets, key := descendItem(sets, item, i)
+		itemPath := fmt.Sprintf("%s[%s]", path, key)
+
+		switch v := item.(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				w.addLine(indent, "- {}", itemPath, leafOwners(itemSets))
+				continue
+			}
+			// items owned as a whole get their own line so the owners of the
+			// item aren't confused with the owners of its first field.
+			if owners := containerOwners(itemSets); len(owners) > 0 {
+				w.addLine(indent, "-", itemPath, owners)
+				w.writeMap(v, indent+1, itemPath, itemSets)
+				continue
+			}
+			start := len(w.lines)
+			w.writeMap(v, indent+1, itemPath, itemSets)
+			w.lines[start].dash = true
+		case []interface{}:
+			w.addLine(indent, "-", itemPath, containerOwners(itemSets))
+			w.writeList(v, indent+1, itemPath, itemSets)
+		default:
+			text := strings.TrimSuffix(scalarString(v), "\n")
+			w.addLine(indent, "- "+text, itemPath, leafOwners(itemSets))
+		}
+	}
+}
+
+func (w *annotatedWriter) addLine(indent int, text, path string, owners []int) {
+	w.lines = append(w.lines, annotatedLine{
+		indent: indent,
+		text:   text,
+		owners: owners,
+	})
+
+	for _, owner := range owners {
+		w.fields = append(w.fields, ownedField{path: path, manager: owner})
+	}
+}
+
+// codeBlock renders the annotated YAML in a card.
+func (w *annotatedWriter) codeBlock() *component.Card {
+	var sb strings.Builder
+	sb.WriteString("---\n")
+
+	for _, line := range w.lines {
+		prefix := strings.Repeat("  ", line.indent)
+		if line.dash {
+			prefix = strings.Repeat("  ", line.indent-1) + "- "
+		}
+
+		text := strings.Replace(line.text, "\n", "\n"+strings.Repeat("  ", line.indent+1), -1)
+		if len(line.owners) > 0 {
+			var owners []string
+			for _, owner := range line.owners {
+				owners = append(owners, w.managers[owner].String())
+			}
+
+			parts := strings.SplitN(text, "\n", 2)
+			parts[0] = fmt.Sprintf("%s  # %s", parts[0], strings.Join(owners, ", "))
+			text = strings.Join(parts, "\n")
+		}
+
+		sb.WriteString(prefix + text + "\n")
+	}
+
+	card := component.NewCard(component.TitleFromString("Annotated YAML"))
+	card.SetBody(component.NewCodeBlock(sb.String()))
+	return card
+}
+
+// managerTable lists the object's field managers.
+func (w *annotatedWriter) managerTable() *component.Table {
+	counts := make([]int, len(w.managers))
+	for _, field := range w.fields {
+		counts[field.manager]++
+	}
+
+	table := component.NewTable("Managers", "This object has no field managers", managerColumns)
+	for i, m := range w.managers {
+		table.Add(component.TableRow{
+			"Manager":     component.NewText(m.Name),
+			"Operation":   component.NewText(m.Operation),
+			"API Version": component.NewText(m.APIVersion),
+			"Updated":     updatedComponent(m.Time),
+			"Fields":      component.NewText(fmt.Sprintf("%d", counts[i])),
+		})
+	}
+
+	return table
+}
+
+// fieldTable lists each owned field. It can be filtered by manager.
+func (w *annotatedWriter) fieldTable() *component.Table {
+	table := component.NewTable("Fields", "This object has no managed fields", fieldColumns)
+
+	var names []string
+	seen := map[string]bool{}
+	for _, m := range w.managers {
+		if !seen[m.Name] {
+			seen[m.Name] = true
+			names = append(names, m.Name)
+		}
+	}
+
+	for _, field := range w.fields {
+		m := w.managers[field.manager]
+		table.Add(component.TableRow{
+			"Field":     component.NewText(field.path),
+			"Manager":   component.NewText(m.Name),
+			"Operation": component.NewText(m.Operation),
+			"Updated":   updatedComponent(m.Time),
+		})
+	}
+
+	table.AddFilter("Manager", component.TableFilter{
+		Values:   names,
+		Selected: names,
+	})
+
+	return table
+}
+
+func updatedComponent(t *metav1.Time) component.Component {
+	if t == nil {
+		return component.NewText("<unknown>")
+	}
+	return component.NewTimestamp(t.Time)
+}
+
+// descendField returns each manager's field set for a map key.
+func descendField(sets []fieldSet, key string) []fieldSet {
+	out := make([]fieldSet, len(sets))
+	for i, set := range sets {
+		if set == nil {
+			continue
+		}
+		if child, ok := set["f:"+key]; ok {
+			out[i] = ensureSet(child)
+		}
+	}
+	return out
+}
+
+// descendItem returns each manager's field set for a list item, and a key
+// describing the item.
+func descendItem(sets []fieldSet, item interface{}, index int) ([]fieldSet, string) {
+	key := fmt.Sprintf("%d", index)
+	out := make([]fieldSet, len(sets))
+
+	for i, set := range sets {
+		for name, child := range set {
+			switch {
+			case name == fmt.Sprintf("i:%d", index):
+			case strings.HasPrefix(name, "v:") && matchesValue(strings.TrimPrefix(name, "v:"), item):
+			case strings.HasPrefix(name, "k:"):
+				itemKey, ok := matchesKey(strings.TrimPrefix(name, "k:"), item)
+				if !ok {
+					continue
+				}
+				key = itemKey
+			default:
+				continue
+			}
+
+			out[i] = ensureSet(child)
+			break
+		}
+	}
+
+	return out, key
+}
+
+// matchesValue returns true if an item matches the JSON value from a set.
+func matchesValue(value string, item interface{}) bool {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return false
+	}
+	return string(data) == value
+}
+
+// matchesKey returns true if an item has all the fields in a JSON key. It
+// also returns a description of the key.
+func matchesKey(key string, item interface{}) (string, bool) {
+	m, ok := item.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(key), &fields); err != nil {
+		return "", false
+	}
+
+	var names []string
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var parts []string
+	for _, name := range names {
+		expected, err := json.Marshal(fields[name])
+		if err != nil {
+			return "", false
+		}
+		if !matchesValue(string(expected), m[name]) {
+			return "", false
+		}
+		parts = append(parts, fmt.Sprintf("%s=%v", name, fields[name]))
+	}
+
+	return strings.Join(parts, ","), true
+}
+
+// leafOwners returns the managers which own a value.
+func leafOwners(sets []fieldSet) []int {
+	var owners []int
+	for i, set := range sets {
+		if set != nil {
+			owners = append(owners, i)
+		}
+	}
+	return owners
+}
+
+// containerOwners returns the managers which own a map or list as a whole.
+func containerOwners(sets []fieldSet) []int {
+	var owners []int
+	for i, set := range sets {
+		if set == nil {
+			continue
+		}
+		if _, ok := set["."]; ok || len(set) == 0 {
+			owners = append(owners, i)
+		}
+	}
+	return owners
+}
+
+func ensureSet(set fieldSet) fieldSet {
+	if set == nil {
+		return fieldSet{}
+	}
+	return set
+}
+
+func scalarString(v interface{}) string {
+	data, err := sigyaml.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package fieldmanagerviewer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_ToComponent(t *testing.T) {
+	updated := metav1.NewTime(time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC))
+
+	object := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "pod",
+			Labels: map[string]string{"app": "web"},
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{
+					Manager:    "kubectl",
+					Operation:  metav1.ManagedFieldsOperationApply,
+					APIVersion: "v1",
+					Time:       &updated,
+					FieldsType: "FieldsV1",
+					FieldsV1: &metav1.FieldsV1{
+						Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{"f:containers":{"k:{\"name\":\"nginx\"}":{".":{},"f:name":{}}}}}`),
+					},
+				},
+				{
+					Manager:    "controller",
+					Operation:  metav1.ManagedFieldsOperationUpdate,
+					APIVersion: "v1",
+					Time:       &updated,
+					FieldsType: "FieldsV1",
+					FieldsV1: &metav1.FieldsV1{
+						Raw: []byte(`{"f:spec":{"f:containers":{"k:{\"name\":\"nginx\"}":{"f:image":{}}}}}`),
+					},
+				},
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "nginx", Image: "nginx:1.19"},
+			},
+		},
+	}
+
+	got, err := ToComponent(object)
+	require.NoError(t, err)
+	require.Len(t, got.Config.Sections, 3)
+
+	managers, ok := got.Config.Sections[0][0].View.(*component.Table)
+	require.True(t, ok)
+	require.Len(t, managers.Rows(), 2)
+	assert.Equal(t, component.NewText("3"), managers.Rows()[0]["Fields"])
+	assert.Equal(t, component.NewText("1"), managers.Rows()[1]["Fields"])
+
+	fields, ok := got.Config.Sections[1][0].View.(*component.Table)
+	require.True(t, ok)
+
+	var actualFields []string
+	for _, row := range fields.Rows() {
+		actualFields = append(actualFields, row["Field"].(*component.Text).Config.Text+" "+row["Manager"].(*component.Text).Config.Text)
+	}
+	expectedFields := []string{
+		"metadata.labels.app kubectl",
+		"spec.containers[name=nginx] kubectl",
+		"spec.containers[name=nginx].image controller",
+		"spec.containers[name=nginx].name kubectl",
+	}
+	assert.Equal(t, expectedFields, actualFields)
+	assert.Equal(t, []string{"kubectl", "controller"}, fields.Config.Filters["Manager"].Values)
+
+	card, ok := got.Config.Sections[2][0].View.(*component.Card)
+	require.True(t, ok)
+	code, ok := card.Config.Body.(*component.Code)
+	require.True(t, ok)
+
+	assert.Contains(t, code.Config.Code, "    app: web  # kubectl (Apply 2020-07-01T12:00:00Z)\n")
+	assert.Contains(t, code.Config.Code, "  -  # kubectl (Apply 2020-07-01T12:00:00Z)\n    image: nginx:1.19  # controller (Update 2020-07-01T12:00:00Z)\n")
+	assert.Contains(t, code.Config.Code, "    name: nginx  # kubectl (Apply 2020-07-01T12:00:00Z)\n")
+	assert.NotContains(t, code.Config.Code, "managedFields")
+}
+
+func Test_ToComponent_nil(t *testing.T) {
+	_, err := ToComponent(nil)
+	require.Error(t, err)
+}
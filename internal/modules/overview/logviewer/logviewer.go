@@ -8,19 +8,32 @@ package logviewer
 import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/modules/overview/container"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
-// ToComponent converts an object into a log viewer component.
+// ToComponent converts an object into a log viewer component. Workloads
+// get a log viewer for all of their pods.
 func ToComponent(object runtime.Object) (component.Component, error) {
 	if object == nil {
 		return nil, errors.Errorf("object is nil")
 	}
 
+	if gvk := object.GetObjectKind().GroupVersionKind(); container.IsLogWorkloadKind(gvk.Kind) {
+		accessor, err := meta.Accessor(object)
+		if err != nil {
+			return nil, err
+		}
+
+		apiVersion, kind := gvk.ToAPIVersionAndKind()
+		return component.NewWorkloadLogs(accessor.GetNamespace(), apiVersion, kind, accessor.GetName()), nil
+	}
+
 	pod := &corev1.Pod{}
 
 	switch t := object.(type) {
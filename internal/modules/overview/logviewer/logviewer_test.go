@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -59,6 +60,17 @@ func Test_ToComponent(t *testing.T) {
 			},
 			expected: component.NewLogs("default", "pod", []string{"", "init", "one", "two"}...),
 		},
+		{
+			name: "workload",
+			object: &appsv1.Deployment{
+				TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deployment",
+					Namespace: "default",
+				},
+			},
+			expected: component.NewWorkloadLogs("default", "apps/v1", "Deployment", "deployment"),
+		},
 		{
 			name:   "nil",
 			object: nil,
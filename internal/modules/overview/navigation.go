@@ -36,6 +36,8 @@ func workloadEntries(ctx context.Context, prefix, namespace string, objectStore
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Deployment), objectStore))
 	neh.Add("Jobs", "jobs",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Job), objectStore))
+	neh.Add("Pod Disruption Budgets", "pod-disruption-budgets",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.PodDisruptionBudget), objectStore))
 	neh.Add("Pods", "pods",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Pod), objectStore))
 	neh.Add("Replica Sets", "replica-sets",
@@ -63,6 +65,10 @@ func discoAndLBEntries(ctx context.Context, prefix, namespace string, objectStor
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Ingress), objectStore))
 	neh.Add("Services", "services",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Service), objectStore))
+	neh.Add("Endpoints", "endpoints",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Endpoints), objectStore))
+	neh.Add("Endpoint Slices", "endpoint-slices",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.EndpointSlice), objectStore))
 	neh.Add("Network Policies", "network-policies",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.NetworkPolicy), objectStore))
 
@@ -79,8 +85,12 @@ func configAndStorageEntries(ctx context.Context, prefix, namespace string, obje
 
 	neh.Add("Config Maps", "config-maps",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.ConfigMap), objectStore))
+	neh.Add("Limit Ranges", "limit-ranges",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.LimitRange), objectStore))
 	neh.Add("Persistent Volume Claims", "persistent-volume-claims",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.PersistentVolumeClaim), objectStore))
+	neh.Add("Resource Quotas", "resource-quotas",
+		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.ResourceQuota), objectStore))
 	neh.Add("Secrets", "secrets",
 		loading.IsObjectLoading(ctx, namespace, store.KeyFromGroupVersionKind(gvk.Secret), objectStore))
 	neh.Add("Service Accounts", "service-accounts",
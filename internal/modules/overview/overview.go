@@ -273,13 +273,25 @@ func (co *Overview) ActionPaths() map[string]action.DispatcherFunc {
 		octant.NewServiceConfigurationEditor(co.dashConfig.ObjectStore()),
 		octant.NewPortForward(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.PortForwarder()),
 		octant.NewPortForwardDelete(co.logger, co.dashConfig.ObjectStore(), co.dashConfig.PortForwarder()),
+		octant.NewPortForwardProfileSave(co.logger, co.dashConfig.PortForwarder(), co.dashConfig.ContextName),
+		octant.NewPortForwardProfileStart(co.logger, co.dashConfig.PortForwarder(), co.dashConfig.ContextName),
+		octant.NewPortForwardProfileStop(co.logger, co.dashConfig.PortForwarder()),
+		octant.NewPortForwardProfileDelete(co.logger, co.dashConfig.PortForwarder()),
 		octant.NewCordon(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewUncordon(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
+		octant.NewDebugContainer(co.dashConfig.ClusterClient(), co.dashConfig.ObjectPath),
+		octant.NewDebugNode(co.dashConfig.ClusterClient(), co.dashConfig.ObjectPath),
+		octant.NewExecCommand(co.dashConfig.ClusterClient()),
 		octant.NewCronJobTrigger(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobSuspend(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewCronJobResume(co.dashConfig.ObjectStore(), co.dashConfig.ClusterClient()),
 		octant.NewObjectUpdaterDispatcher(co.dashConfig.ObjectStore()),
 		octant.NewApplyYaml(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewRolloutPause(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewRolloutResume(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewRolloutRestart(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewRolloutUndo(co.logger, co.dashConfig.ObjectStore()),
+		octant.NewScale(co.logger, co.dashConfig.ClusterClient()),
 	}
 
 	return dispatchers.ToActionPaths()
@@ -23,15 +23,20 @@ var (
 		gvk.ExtReplicaSet,
 		gvk.Job,
 		gvk.Pod,
+		gvk.PodDisruptionBudget,
 		gvk.ReplicationController,
 		gvk.StatefulSet,
 		gvk.HorizontalPodAutoscaler,
 		gvk.Ingress,
 		gvk.Service,
+		gvk.Endpoints,
+		gvk.EndpointSlice,
 		gvk.NetworkPolicy,
 		gvk.ConfigMap,
 		gvk.Secret,
 		gvk.PersistentVolumeClaim,
+		gvk.LimitRange,
+		gvk.ResourceQuota,
 		gvk.ServiceAccount,
 		gvk.RoleBinding,
 		gvk.Role,
@@ -79,6 +84,10 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/config-and-storage/config-maps"
 	case apiVersion == "v1" && kind == "PersistentVolumeClaim":
 		p = "/config-and-storage/persistent-volume-claims"
+	case apiVersion == "v1" && kind == "LimitRange":
+		p = "/config-and-storage/limit-ranges"
+	case apiVersion == "v1" && kind == "ResourceQuota":
+		p = "/config-and-storage/resource-quotas"
 	case apiVersion == "v1" && kind == "ServiceAccount":
 		p = "/config-and-storage/service-accounts"
 	case (apiVersion == "autoscaling/v1" || apiVersion == "autoscaling/v2beta2") && kind == "HorizontalPodAutoscaler":
@@ -87,6 +96,10 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/discovery-and-load-balancing/ingresses"
 	case apiVersion == "v1" && kind == "Service":
 		p = "/discovery-and-load-balancing/services"
+	case apiVersion == "v1" && kind == "Endpoints":
+		p = "/discovery-and-load-balancing/endpoints"
+	case apiVersion == "discovery.k8s.io/v1beta1" && kind == "EndpointSlice":
+		p = "/discovery-and-load-balancing/endpoint-slices"
 	case apiVersion == "networking.k8s.io/v1" && kind == "NetworkPolicy":
 		p = "/discovery-and-load-balancing/network-policies"
 	case apiVersion == "rbac.authorization.k8s.io/v1" && kind == "Role":
@@ -95,6 +108,8 @@ func gvkPath(namespace, apiVersion, kind, name string) (string, error) {
 		p = "/rbac/role-bindings"
 	case apiVersion == "v1" && kind == "Event":
 		p = "/events"
+	case apiVersion == "policy/v1beta1" && kind == "PodDisruptionBudget":
+		p = "/workloads/pod-disruption-budgets"
 	case apiVersion == "v1" && kind == "Pod":
 		p = "/workloads/pods"
 	default:
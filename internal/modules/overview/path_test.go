@@ -39,6 +39,30 @@ func Test_gvk_path(t *testing.T) {
 			objectName: "pod",
 			expected:   path.Join("/overview", "namespace", "default", "workloads", "pods", "pod"),
 		},
+		{
+			name:       "resource quota",
+			namespace:  "default",
+			apiVersion: "v1",
+			kind:       "ResourceQuota",
+			objectName: "quota",
+			expected:   path.Join("/overview", "namespace", "default", "config-and-storage", "resource-quotas", "quota"),
+		},
+		{
+			name:       "pod disruption budget",
+			namespace:  "default",
+			apiVersion: "policy/v1beta1",
+			kind:       "PodDisruptionBudget",
+			objectName: "pdb",
+			expected:   path.Join("/overview", "namespace", "default", "workloads", "pod-disruption-budgets", "pdb"),
+		},
+		{
+			name:       "endpoint slice",
+			namespace:  "default",
+			apiVersion: "discovery.k8s.io/v1beta1",
+			kind:       "EndpointSlice",
+			objectName: "service-abcde",
+			expected:   path.Join("/overview", "namespace", "default", "discovery-and-load-balancing", "endpoint-slices", "service-abcde"),
+		},
 		{
 			name:       "no namespace",
 			apiVersion: "v1",
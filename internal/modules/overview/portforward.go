@@ -12,7 +12,6 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/api"
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
@@ -28,30 +27,41 @@ type portForwardCreateRequest struct {
 	Name       string `json:"name,omitempty"`
 	Namespace  string `json:"namespace,omitempty"`
 	Port       uint16 `json:"port,omitempty"`
+	PortName   string `json:"portName,omitempty"`
+	Pod        string `json:"pod,omitempty"`
 }
 
 func (req *portForwardCreateRequest) Validate() error {
-	if req.APIVersion != "v1" && req.Kind == "Pod" {
-		return errors.New("only supports forwards for v1 Pods")
+	if req.APIVersion == "" || req.Kind == "" {
+		return errors.New("apiVersion and kind are required")
 	}
 
 	if req.Name == "" {
-		return errors.New("pod name is blank")
+		return errors.New("name is blank")
 	}
 
 	if req.Namespace == "" {
-		return errors.New("pod namespace is blank")
+		return errors.New("namespace is blank")
 	}
 
-	if req.Port < 1 {
+	if req.Port < 1 && req.PortName == "" {
 		return errors.New("port must be greater than 0")
 	}
 
 	return nil
 }
 
-func (req *portForwardCreateRequest) gvk() schema.GroupVersionKind {
-	return schema.FromAPIVersionAndKind(req.APIVersion, req.Kind)
+func (req *portForwardCreateRequest) createRequest() portforward.CreateRequest {
+	return portforward.CreateRequest{
+		Namespace:  req.Namespace,
+		APIVersion: req.APIVersion,
+		Kind:       req.Kind,
+		Name:       req.Name,
+		Ports: []portforward.PortForwardPortSpec{
+			{Remote: req.Port, RemoteName: req.PortName},
+		},
+		Pod: req.Pod,
+	}
 }
 
 type portForwardError struct {
@@ -85,7 +95,7 @@ func createPortForward(ctx context.Context, body io.Reader, pfs portforward.Port
 		}
 	}
 
-	resp, err := pfs.Create(ctx, nil, req.gvk(), req.Name, req.Namespace, req.Port)
+	resp, err := pfs.CreateFromRequest(ctx, nil, req.createRequest())
 	if err != nil {
 		return &portForwardError{
 			code:     http.StatusInternalServerError,
@@ -70,6 +70,17 @@ func (tv *terminalViewer) ToComponent() (*component.Terminal, error) {
 		}
 	}
 
+	// Debug containers are added as ephemeral containers. The newest running
+	// one is selected since it was most likely just added.
+	for _, c := range pod.Spec.EphemeralContainers {
+		for _, s := range pod.Status.EphemeralContainerStatuses {
+			if s.Name == c.Name && s.State.Running != nil {
+				containers = append(containers, c.Name)
+				container = c.Name
+			}
+		}
+	}
+
 	details := component.TerminalDetails{
 		Container: container,
 		Command:   "/bin/sh",
@@ -32,3 +32,36 @@ func Test_ToComponent(t *testing.T) {
 
 	assert.Equal(t, expected, got)
 }
+
+func Test_ToComponent_ephemeralContainers(t *testing.T) {
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}
+
+	object := &corev1.Pod{}
+	object.Name = "pod"
+	object.Namespace = "default"
+	object.Spec.Containers = []corev1.Container{{Name: "app"}}
+	object.Spec.EphemeralContainers = []corev1.EphemeralContainer{
+		{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-old"}},
+		{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-new"}},
+		{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-exited"}},
+	}
+	object.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "app", State: running}}
+	object.Status.EphemeralContainerStatuses = []corev1.ContainerStatus{
+		{Name: "debugger-old", State: running},
+		{Name: "debugger-new", State: running},
+		{Name: "debugger-exited", State: terminated},
+	}
+
+	got, err := ToComponent(context.Background(), object, log.NopLogger())
+	require.NoError(t, err)
+
+	details := component.TerminalDetails{
+		Container: "debugger-new",
+		Command:   "/bin/sh",
+		Active:    true,
+	}
+	expected := component.NewTerminal("default", "Terminal", "pod", []string{"app", "debugger-old", "debugger-new"}, details)
+
+	assert.Equal(t, expected, got)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	conditionStatusTrue  = "True"
+	conditionStatusFalse = "False"
+)
+
+// condition is a duck typed version of the conditions found in the
+// status of most Kubernetes objects.
+type condition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// String describes the condition, e.g. `Ready is False (Reason): message`.
+func (c condition) String() string {
+	s := fmt.Sprintf("%s is %s", c.Type, c.Status)
+	if c.Reason != "" {
+		s = fmt.Sprintf("%s (%s)", s, c.Reason)
+	}
+	if c.Message != "" {
+		s = fmt.Sprintf("%s: %s", s, c.Message)
+	}
+
+	return s
+}
+
+// conditionsStatus creates status for objects without a specialized status
+// function. It follows the rules used by kstatus:
+//
+// * An object whose controller has not observed the latest generation is in progress.
+// * Stalled or Failed conditions which are True are errors.
+// * Reconciling conditions which are True are in progress.
+// * Progressing conditions which are False are errors. Like a Deployment, a
+//   healthy object which is rolling out is Progressing.
+// * Ready or Available conditions which are False are errors, and Unknown is in progress.
+//
+// Objects without conditions are considered OK.
+func conditionsStatus(_ context.Context, object runtime.Object, _ store.Store) (ObjectStatus, error) {
+	if object == nil {
+		return ObjectStatus{}, errors.New("object is nil")
+	}
+
+	apiVersion, kind := object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+
+	u, err := toUnstructuredObject(object)
+	if err != nil {
+		return ObjectStatus{}, errors.Wrapf(err, "convert %s %s to unstructured", apiVersion, kind)
+	}
+
+	os := ObjectStatus{}
+
+	generation, _, _ := unstructured.NestedInt64(u, "metadata", "generation")
+	observedGeneration, found, _ := unstructured.NestedInt64(u, "status", "observedGeneration")
+	if found && observedGeneration < generation {
+		os.SetWarning()
+		os.AddDetailf("%s has not observed generation %d (observed %d)", kind, generation, observedGeneration)
+	}
+
+	for _, c := range conditionsFromObject(u) {
+		switch c.Type {
+		case "Stalled", "Failed":
+			if c.Status == conditionStatusTrue {
+				os.SetError()
+				os.AddDetail(c.String())
+			}
+		case "Reconciling":
+			if c.Status == conditionStatusTrue {
+				os.SetWarning()
+				os.AddDetail(c.String())
+			}
+		case "Progressing":
+			if c.Status == conditionStatusFalse {
+				os.SetError()
+				os.AddDetail(c.String())
+			}
+		case "Ready", "Available":
+			switch c.Status {
+			case conditionStatusTrue:
+			case conditionStatusFalse:
+				os.SetError()
+				os.AddDetail(c.String())
+			default:
+				os.SetWarning()
+				os.AddDetail(c.String())
+			}
+		}
+	}
+
+	if len(os.Details) == 0 {
+		os.nodeStatus = component.NodeStatusOK
+		os.AddDetailf("%s %s is OK", apiVersion, kind)
+	}
+
+	return os, nil
+}
+
+// conditionsFromObject reads `status.conditions` from an unstructured object.
+// Entries which are not shaped like conditions are skipped.
+func conditionsFromObject(object map[string]interface{}) []condition {
+	list, found, err := unstructured.NestedSlice(object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	var conditions []condition
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		c := condition{}
+		c.Type, _, _ = unstructured.NestedString(m, "type")
+		c.Status, _, _ = unstructured.NestedString(m, "status")
+		c.Reason, _, _ = unstructured.NestedString(m, "reason")
+		c.Message, _, _ = unstructured.NestedString(m, "message")
+
+		if c.Type == "" {
+			continue
+		}
+
+		conditions = append(conditions, c)
+	}
+
+	return conditions
+}
+
+func toUnstructuredObject(object runtime.Object) (map[string]interface{}, error) {
+	if u, ok := object.(*unstructured.Unstructured); ok {
+		return u.Object, nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+}
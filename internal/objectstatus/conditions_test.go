@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_conditionsStatus(t *testing.T) {
+	cases := []struct {
+		name     string
+		init     func(*testing.T) runtime.Object
+		expected ObjectStatus
+		isErr    bool
+	}{
+		{
+			name: "ready",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.LoadUnstructuredFromFile(t, "conditions_ready.yaml")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusOK,
+				Details:    []component.Component{component.NewText("cert-manager.io/v1alpha2 Certificate is OK")},
+			},
+		},
+		{
+			name: "not ready",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.LoadUnstructuredFromFile(t, "conditions_not_ready.yaml")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details: []component.Component{
+					component.NewText("Ready is False (Failed): The certificate request has failed to complete"),
+				},
+			},
+		},
+		{
+			name: "stalled",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.LoadUnstructuredFromFile(t, "conditions_stalled.yaml")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details: []component.Component{
+					component.NewText("Stalled is True (InvalidSpec): size must be positive"),
+				},
+			},
+		},
+		{
+			name: "reconciling a new generation",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.LoadUnstructuredFromFile(t, "conditions_reconciling.yaml")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusWarning,
+				Details: []component.Component{
+					component.NewText("Widget has not observed generation 3 (observed 2)"),
+					component.NewText("Reconciling is True (NewGeneration)"),
+					component.NewText("Ready is Unknown"),
+				},
+			},
+		},
+		{
+			name: "progressing",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.LoadUnstructuredFromFile(t, "conditions_progressing.yaml")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusOK,
+				Details:    []component.Component{component.NewText("example.com/v1 Widget is OK")},
+			},
+		},
+		{
+			name: "progress deadline exceeded",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.LoadUnstructuredFromFile(t, "conditions_progress_deadline.yaml")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details: []component.Component{
+					component.NewText(`Progressing is False (ProgressDeadlineExceeded): Widget "widget" has timed out progressing.`),
+				},
+			},
+		},
+		{
+			name: "no conditions",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.LoadUnstructuredFromFile(t, "conditions_none.yaml")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusOK,
+				Details:    []component.Component{component.NewText("example.com/v1 Widget is OK")},
+			},
+		},
+		{
+			name: "typed object",
+			init: func(t *testing.T) runtime.Object {
+				return testutil.CreatePersistentVolumeClaim("pvc")
+			},
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusOK,
+				Details:    []component.Component{component.NewText("v1 PersistentVolumeClaim is OK")},
+			},
+		},
+		{
+			name: "object is nil",
+			init: func(t *testing.T) runtime.Object {
+				return nil
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o := storeFake.NewMockStore(controller)
+
+			object := tc.init(t)
+
+			ctx := context.Background()
+			status, err := conditionsStatus(ctx, object, o)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
@@ -109,11 +109,7 @@ func status(ctx context.Context, object runtime.Object, o store.Store, lookup st
 
 	fn, ok := lookup[statusKey{apiVersion: apiVersion, kind: kind}]
 	if !ok {
-		return ObjectStatus{
-			nodeStatus: component.NodeStatusOK,
-			Details:    []component.Component{component.NewText(fmt.Sprintf("%s %s is OK", apiVersion, kind))},
-		}, nil
-
+		fn = conditionsStatus
 	}
 
 	return fn(ctx, object, o)
@@ -46,6 +46,17 @@ func Test_status(t *testing.T) {
 			lookup:   lookup,
 			expected: deployObjectStatus,
 		},
+		{
+			name:   "falls back to conditions",
+			object: testutil.LoadUnstructuredFromFile(t, "conditions_not_ready.yaml"),
+			lookup: lookup,
+			expected: ObjectStatus{
+				nodeStatus: component.NodeStatusError,
+				Details: []component.Component{
+					component.NewText("Ready is False (Failed): The certificate request has failed to complete"),
+				},
+			},
+		},
 		{
 			name:   "nil object",
 			object: nil,
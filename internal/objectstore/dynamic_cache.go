@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -68,6 +69,7 @@ type DynamicCache struct {
 	access          ResourceAccess
 	updateFns       []store.UpdateFn
 	updateMu        sync.Mutex
+	watchHandlers   *watchHandlers
 
 	syncTimeoutFunc func(context.Context, store.Key, chan bool)
 	waitForSyncFunc func(context.Context, store.Key, *DynamicCache, informers.GenericInformer, chan bool)
@@ -97,6 +99,9 @@ func waitForSync(ctx context.Context, key store.Key, dc *DynamicCache, informer
 }
 
 var _ store.Store = (*DynamicCache)(nil)
+var _ store.DryRunner = (*DynamicCache)(nil)
+var _ store.Applier = (*DynamicCache)(nil)
+var _ store.Patcher = (*DynamicCache)(nil)
 
 // NewDynamicCache creates an instance of DynamicCache.
 func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, options ...DynamicCacheOpt) (*DynamicCache, error) {
@@ -107,6 +112,7 @@ func NewDynamicCache(ctx context.Context, client cluster.ClientInterface, option
 		client:          client,
 		seenGVKs:        initSeenGVKsCache(),
 		informerSynced:  initInformerSynced(),
+		watchHandlers:   initWatchHandlers(),
 	}
 
 	for _, option := range options {
@@ -420,7 +426,9 @@ func (dc *DynamicCache) getFromDynamicClient(ctx context.Context, key store.Key)
 }
 
 // Watch watches the cluster for an event and performs actions with the
-// supplied handler.
+// supplied handler. Before Watch returns, the handler is sent an add event
+// for each object already in the informer's cache. The handler is removed
+// when the context is done.
 func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) error {
 	if dc.isBackingOff(ctx, key) {
 		return nil
@@ -441,7 +449,7 @@ func (dc *DynamicCache) Watch(ctx context.Context, key store.Key, handler kcache
 		return fmt.Errorf("retrieving informer for %s: %w", key, err)
 	}
 
-	informer.Informer().AddEventHandler(handler)
+	dc.watchHandlers.add(ctx, key.GroupVersionKind(), informer.Informer(), handler)
 	return nil
 }
 
@@ -456,6 +464,8 @@ func (dc *DynamicCache) Unwatch(ctx context.Context, groupVersionKinds ...schema
 		}
 	}
 
+	dc.watchHandlers.remove(groupVersionKinds...)
+
 	return nil
 }
 
@@ -510,6 +520,7 @@ func (dc *DynamicCache) UpdateClusterClient(ctx context.Context, client cluster.
 	dc.factories.reset()
 	dc.seenGVKs.reset()
 	dc.informerSynced.reset()
+	dc.watchHandlers.reset()
 	dc.access = NewResourceAccess(client)
 	dc.updateMu.Unlock()
 
@@ -630,35 +641,36 @@ func (dc *DynamicCache) Create(ctx context.Context, object *unstructured.Unstruc
 	return err
 }
 
+// withYAMLDocuments calls cb with each non empty document in input.
+func withYAMLDocuments(input string, cb func(doc map[string]interface{}) error) error {
+	d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
+	for {
+		doc := map[string]interface{}{}
+		if err := d.Decode(&doc); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("unable to parse yaml: %w", err)
+		}
+		if len(doc) == 0 {
+			// skip empty documents
+			continue
+		}
+		if err := cb(doc); err != nil {
+			return err
+		}
+	}
+}
+
 func CreateOrUpdateFromHandler(
 	ctx context.Context, namespace, input string,
 	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
 	create func(context.Context, *unstructured.Unstructured) error,
 	clusterClient cluster.ClientInterface,
 ) ([]string, error) {
-	withDoc := func(cb func(doc map[string]interface{}) error) error {
-		d := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(input), 4096)
-		for {
-			doc := map[string]interface{}{}
-			if err := d.Decode(&doc); err != nil {
-				if err == io.EOF {
-					return nil
-				}
-				return fmt.Errorf("unable to parse yaml: %w", err)
-			}
-			if len(doc) == 0 {
-				// skip empty documents
-				continue
-			}
-			if err := cb(doc); err != nil {
-				return err
-			}
-		}
-	}
-
 	logger := log.From(ctx)
 	results := []string{}
-	err := withDoc(func(doc map[string]interface{}) error {
+	err := withYAMLDocuments(input, func(doc map[string]interface{}) error {
 		logger.Debugf("apply resource %#v", doc)
 
 		unstructuredObj := &unstructured.Unstructured{Object: doc}
@@ -666,7 +678,7 @@ func CreateOrUpdateFromHandler(
 		if err != nil {
 			return err
 		}
-		gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+		_, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
 		if err != nil {
 			return fmt.Errorf("unable to discover resource: %w", err)
 		}
@@ -696,7 +708,204 @@ func CreateOrUpdateFromHandler(
 			return nil
 		}
 
-		// update object
+		// update object. The document is the full intent for the object, so
+		// it is applied with the default field manager.
+		options := store.ApplyOptions{Force: true}
+		if err := applyObject(ctx, clusterClient, key, unstructuredObj, options); err != nil {
+			return fmt.Errorf("unable to patch resource: %w", err)
+		}
+
+		result := fmt.Sprintf("Updated %s (%s) %s", key.Kind, key.APIVersion, key.Name)
+		if namespaced {
+			result = fmt.Sprintf("%s in %s", result, key.Namespace)
+		}
+		results = append(results, result)
+
+		return nil
+	})
+	return results, err
+}
+
+// Apply applies an object to the cluster using server-side apply. Fields
+// owned by other field managers are returned as an ApplyConflictError unless
+// the apply is forced.
+func (dc *DynamicCache) Apply(ctx context.Context, object *unstructured.Unstructured, options store.ApplyOptions) error {
+	_, span := trace.StartSpan(ctx, "dynamicCache:apply")
+	defer span.End()
+
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return fmt.Errorf("key from object: %w", err)
+	}
+
+	if err := dc.access.HasAccess(ctx, key, "patch"); err != nil {
+		return fmt.Errorf("check access to patch %s: %w", key, err)
+	}
+
+	return applyObject(ctx, dc.client, key, object, options)
+}
+
+// Patch patches an object in the cluster. Unlike Apply, a patch doesn't
+// take ownership of the fields it sets, so it doesn't conflict with them being
+// applied later.
+func (dc *DynamicCache) Patch(ctx context.Context, key store.Key, patchType types.PatchType, data []byte) error {
+	_, span := trace.StartSpan(ctx, "dynamicCache:patch")
+	defer span.End()
+
+	if err := dc.access.HasAccess(ctx, key, "patch"); err != nil {
+		return fmt.Errorf("check access to patch %s: %w", key, err)
+	}
+
+	gvr, namespaced, err := dc.client.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return fmt.Errorf("unable to discover resource: %w", err)
+	}
+
+	dynamicClient, err := dc.client.DynamicClient()
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic client: %w", err)
+	}
+
+	if namespaced {
+		_, err = dynamicClient.Resource(gvr).Namespace(key.Namespace).Patch(ctx, key.Name, patchType, data, metav1.PatchOptions{})
+	} else {
+		_, err = dynamicClient.Resource(gvr).Patch(ctx, key.Name, patchType, data, metav1.PatchOptions{})
+	}
+
+	return err
+}
+
+// applyObject patches an object with types.ApplyPatchType.
+func applyObject(ctx context.Context, clusterClient cluster.ClientInterface, key store.Key, object *unstructured.Unstructured, options store.ApplyOptions) error {
+	gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+	if err != nil {
+		return fmt.Errorf("unable to discover resource: %w", err)
+	}
+
+	data, err := sigyaml.Marshal(object.Object)
+	if err != nil {
+		return fmt.Errorf("unable to marshal resource as yaml: %w", err)
+	}
+
+	dynamicClient, err := clusterClient.DynamicClient()
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic client: %w", err)
+	}
+
+	fieldManager := options.FieldManager
+	if fieldManager == "" {
+		fieldManager = store.DefaultFieldManager
+	}
+
+	force := options.Force
+	patchOptions := metav1.PatchOptions{FieldManager: fieldManager, Force: &force}
+
+	if namespaced {
+		_, err = dynamicClient.Resource(gvr).Namespace(key.Namespace).Patch(ctx, key.Name, types.ApplyPatchType, data, patchOptions)
+	} else {
+		_, err = dynamicClient.Resource(gvr).Patch(ctx, key.Name, types.ApplyPatchType, data, patchOptions)
+	}
+
+	if err != nil {
+		return applyConflictError(key, err)
+	}
+
+	return nil
+}
+
+// applyConflictError converts field manager conflicts returned by the API server
+// to an ApplyConflictError. Other errors are returned unchanged.
+func applyConflictError(key store.Key, err error) error {
+	var statusErr *kerrors.StatusError
+	if !errors.As(err, &statusErr) || !kerrors.IsConflict(err) {
+		return err
+	}
+
+	details := statusErr.ErrStatus.Details
+	if details == nil {
+		return err
+	}
+
+	conflictErr := &store.ApplyConflictError{Key: key}
+	for _, cause := range details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+
+		conflictErr.Conflicts = append(conflictErr.Conflicts, store.FieldConflict{
+			Field:   cause.Field,
+			Manager: conflictManager(cause.Message),
+		})
+	}
+
+	if len(conflictErr.Conflicts) == 0 {
+		return err
+	}
+
+	return conflictErr
+}
+
+// conflictManager extracts the manager from a conflict message, e.g.
+// `conflict with "kube-controller-manager" using apps/v1`.
+func conflictManager(message string) string {
+	parts := strings.SplitN(message, `"`, 3)
+	if len(parts) < 3 {
+		return message
+	}
+
+	return parts[1]
+}
+
+// CreateOrUpdateFromYAML creates resources in the cluster from YAML input.
+// Resources are created in the order they are present in the YAML.
+// An error creating a resource halts resource creation.
+// A list of created resources is returned. You may have created resources AND a non-nil error.
+func (dc *DynamicCache) CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error) {
+	return CreateOrUpdateFromHandler(ctx, namespace, input, dc.Get, dc.Create, dc.client)
+}
+
+// DryRunFromHandler runs each document in the YAML input through a server-side
+// apply with dryRun=All. Nothing is persisted in the cluster. Errors returned by
+// the cluster for a document, e.g. validation or admission failures, are recorded
+// in its result and do not halt the dry-run. An error is returned if the input
+// can't be parsed.
+func DryRunFromHandler(
+	ctx context.Context, namespace, input string,
+	get func(context.Context, store.Key) (*unstructured.Unstructured, error),
+	clusterClient cluster.ClientInterface,
+) ([]store.DryRunResult, error) {
+	var results []store.DryRunResult
+	err := withYAMLDocuments(input, func(doc map[string]interface{}) error {
+		unstructuredObj := &unstructured.Unstructured{Object: doc}
+		key, err := store.KeyFromObject(unstructuredObj)
+		if err != nil {
+			return err
+		}
+
+		result := store.DryRunResult{Key: key}
+
+		gvr, namespaced, err := clusterClient.Resource(key.GroupVersionKind().GroupKind())
+		if err != nil {
+			result.Err = fmt.Errorf("unable to discover resource: %w", err)
+			results = append(results, result)
+			return nil
+		}
+		if namespaced && key.Namespace == "" {
+			unstructuredObj.SetNamespace(namespace)
+			key.Namespace = namespace
+			result.Key = key
+		}
+
+		live, err := get(ctx, key)
+		if err != nil && !kerrors.IsNotFound(err) {
+			result.Err = fmt.Errorf("unable to get resource: %w", err)
+			results = append(results, result)
+			return nil
+		}
+		if live != nil && len(live.Object) > 0 {
+			result.Live = live
+		}
+
 		unstructuredYaml, err := sigyaml.Marshal(doc)
 		if err != nil {
 			return fmt.Errorf("unable to marshal resource as yaml: %w", err)
@@ -706,46 +915,41 @@ func CreateOrUpdateFromHandler(
 			return fmt.Errorf("unable to get dynamic client: %w", err)
 		}
 
-		withForce := true
-		if namespaced {
-			_, err = client.Resource(gvr).Namespace(key.Namespace).Patch(
-				ctx,
-				key.Name,
-				types.ApplyPatchType,
-				unstructuredYaml,
-				metav1.PatchOptions{FieldManager: "octant", Force: &withForce},
-			)
-			if err != nil {
-				return fmt.Errorf("unable to patch resource: %w", err)
+		dryRun := func(force bool) (*unstructured.Unstructured, error) {
+			patchOptions := metav1.PatchOptions{
+				FieldManager: store.DefaultFieldManager,
+				Force:        &force,
+				DryRun:       []string{metav1.DryRunAll},
 			}
-		} else {
-			_, err = client.Resource(gvr).Patch(
-				ctx,
-				key.Name,
-				types.ApplyPatchType,
-				unstructuredYaml,
-				metav1.PatchOptions{FieldManager: "octant", Force: &withForce},
-			)
-			if err != nil {
-				return fmt.Errorf("unable to patch resource: %w", err)
+
+			if namespaced {
+				return client.Resource(gvr).Namespace(key.Namespace).Patch(
+					ctx, key.Name, types.ApplyPatchType, unstructuredYaml, patchOptions)
 			}
+			return client.Resource(gvr).Patch(
+				ctx, key.Name, types.ApplyPatchType, unstructuredYaml, patchOptions)
 		}
 
-		result := fmt.Sprintf("Updated %s (%s) %s", key.Kind, key.APIVersion, key.Name)
-		if namespaced {
-			result = fmt.Sprintf("%s in %s", result, key.Namespace)
+		// Dry-run without forcing so fields owned by other managers are
+		// reported. Applying YAML takes those fields over, so the dry-run is
+		// repeated with force to show the result.
+		result.Result, err = dryRun(false)
+		if conflictErr, ok := store.IsApplyConflict(applyConflictError(key, err)); ok {
+			result.Conflicts = conflictErr.Conflicts
+			result.Result, err = dryRun(true)
+		}
+		if err != nil {
+			result.Err = err
 		}
-		results = append(results, result)
 
+		results = append(results, result)
 		return nil
 	})
 	return results, err
 }
 
-// CreateOrUpdateFromYAML creates resources in the cluster from YAML input.
-// Resources are created in the order they are present in the YAML.
-// An error creating a resource halts resource creation.
-// A list of created resources is returned. You may have created resources AND a non-nil error.
-func (dc *DynamicCache) CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error) {
-	return CreateOrUpdateFromHandler(ctx, namespace, input, dc.Get, dc.Create, dc.client)
+// DryRunFromYAML previews the changes applying YAML input would make
+// without persisting them.
+func (dc *DynamicCache) DryRunFromYAML(ctx context.Context, namespace, input string) ([]store.DryRunResult, error) {
+	return DryRunFromHandler(ctx, namespace, input, dc.Get, dc.client)
 }
@@ -2,19 +2,29 @@ package objectstore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
 	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
 func TestDynamicCache_backoff(t *testing.T) {
 	d := &DynamicCache{
-		factories: initFactoriesCache(),
+		factories:     initFactoriesCache(),
+		watchHandlers: initWatchHandlers(),
 	}
 
 	ctx := context.TODO()
@@ -25,3 +35,125 @@ func TestDynamicCache_backoff(t *testing.T) {
 	<-time.After(tD + (time.Millisecond * 250))
 	assert.False(t, d.isBackingOff(ctx, key))
 }
+
+func TestDryRunFromHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+	resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	input := `
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: greeting
+data:
+  hello: world
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: denied
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: owned
+data:
+  hello: world
+`
+	greetingKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+	deniedKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "denied"}
+	ownedKey := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "owned"}
+
+	live := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "ConfigMap"}}
+	dryRun := &unstructured.Unstructured{Object: map[string]interface{}{"kind": "ConfigMap", "data": "updated"}}
+	denied := fmt.Errorf("admission webhook denied the request")
+	conflict := kerrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "kubectl" using v1`,
+			Field:   ".data.hello",
+		},
+	}, "Apply failed with 1 conflict")
+
+	get := func(ctx context.Context, key store.Key) (*unstructured.Unstructured, error) {
+		if key == greetingKey {
+			return live, nil
+		}
+		return nil, kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+	}
+
+	patchOptions := metav1.PatchOptions{
+		FieldManager: "octant",
+		Force:        pointer.BoolPtr(false),
+		DryRun:       []string{metav1.DryRunAll},
+	}
+	forcedPatchOptions := metav1.PatchOptions{
+		FieldManager: "octant",
+		Force:        pointer.BoolPtr(true),
+		DryRun:       []string{metav1.DryRunAll},
+	}
+
+	clusterClient.EXPECT().Resource(gomock.Any()).Return(gvr, true, nil).AnyTimes()
+	clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil).AnyTimes()
+	dynamicClient.EXPECT().Resource(gvr).Return(resourceClient).AnyTimes()
+	resourceClient.EXPECT().Namespace("default").Return(resourceClient).AnyTimes()
+	resourceClient.EXPECT().
+		Patch(gomock.Any(), "greeting", types.ApplyPatchType, gomock.Any(), patchOptions).
+		Return(dryRun, nil)
+	resourceClient.EXPECT().
+		Patch(gomock.Any(), "denied", types.ApplyPatchType, gomock.Any(), patchOptions).
+		Return(nil, denied)
+	resourceClient.EXPECT().
+		Patch(gomock.Any(), "owned", types.ApplyPatchType, gomock.Any(), patchOptions).
+		Return(nil, conflict)
+	resourceClient.EXPECT().
+		Patch(gomock.Any(), "owned", types.ApplyPatchType, gomock.Any(), forcedPatchOptions).
+		Return(dryRun, nil)
+
+	actual, err := DryRunFromHandler(context.Background(), "default", input, get, clusterClient)
+	require.NoError(t, err)
+
+	expected := []store.DryRunResult{
+		{Key: greetingKey, Live: live, Result: dryRun},
+		{Key: deniedKey, Err: denied},
+		{
+			Key:       ownedKey,
+			Result:    dryRun,
+			Conflicts: []store.FieldConflict{{Field: ".data.hello", Manager: "kubectl"}},
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func Test_applyConflictError(t *testing.T) {
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+
+	conflict := kerrors.NewApplyConflict([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldManagerConflict,
+			Message: `conflict with "kube-controller-manager" using apps/v1`,
+			Field:   ".spec.replicas",
+		},
+	}, "Apply failed with 1 conflict")
+
+	got := applyConflictError(key, conflict)
+
+	conflictErr, ok := store.IsApplyConflict(got)
+	require.True(t, ok)
+
+	expected := []store.FieldConflict{
+		{Field: ".spec.replicas", Manager: "kube-controller-manager"},
+	}
+	assert.Equal(t, expected, conflictErr.Conflicts)
+	assert.Equal(t, "apply Deployment deployment: field .spec.replicas is owned by kube-controller-manager", got.Error())
+
+	other := fmt.Errorf("other error")
+	assert.Equal(t, other, applyConflictError(key, other))
+}
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+)
+
+// informerHandlers fans out the events of an informer to event handlers.
+// Handlers can't be removed from an informer, so a single informerHandlers is
+// added to each informer and watches add and remove handlers from it.
+// Events are sent to handlers while holding the lock, so a new handler gets
+// the objects in the informer's cache before any later event.
+type informerHandlers struct {
+	gvk schema.GroupVersionKind
+
+	mu       sync.Mutex
+	nextID   int
+	handlers map[int]kcache.ResourceEventHandler
+}
+
+var _ kcache.ResourceEventHandler = (*informerHandlers)(nil)
+
+func newInformerHandlers(gvk schema.GroupVersionKind) *informerHandlers {
+	return &informerHandlers{
+		gvk:      gvk,
+		handlers: make(map[int]kcache.ResourceEventHandler),
+	}
+}
+
+// add adds a handler and sends it an add event for each object in the
+// informer's cache. The handler is removed when the context is done.
+func (h *informerHandlers) add(ctx context.Context, cache kcache.Store, handler kcache.ResourceEventHandler) {
+	h.mu.Lock()
+	id := h.nextID
+	h.nextID++
+	h.handlers[id] = handler
+
+	for _, obj := range cache.List() {
+		handler.OnAdd(obj)
+	}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		h.mu.Lock()
+		delete(h.handlers, id)
+		h.mu.Unlock()
+	}()
+}
+
+// len returns the number of handlers.
+func (h *informerHandlers) len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return len(h.handlers)
+}
+
+// OnAdd sends an add event to the handlers.
+func (h *informerHandlers) OnAdd(obj interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, handler := range h.handlers {
+		handler.OnAdd(obj)
+	}
+}
+
+// OnUpdate sends an update event to the handlers.
+func (h *informerHandlers) OnUpdate(oldObj, newObj interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, handler := range h.handlers {
+		handler.OnUpdate(oldObj, newObj)
+	}
+}
+
+// OnDelete sends a delete event to the handlers.
+func (h *informerHandlers) OnDelete(obj interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, handler := range h.handlers {
+		handler.OnDelete(obj)
+	}
+}
+
+// watchHandlers holds the handlers added to each informer.
+type watchHandlers struct {
+	mu        sync.Mutex
+	informers map[kcache.SharedIndexInformer]*informerHandlers
+}
+
+func initWatchHandlers() *watchHandlers {
+	return &watchHandlers{
+		informers: make(map[kcache.SharedIndexInformer]*informerHandlers),
+	}
+}
+
+// add adds a handler for an informer's events until the context is done. The
+// handler is sent an add event for each object already in the informer's
+// cache. An object can be sent in more than one add event.
+func (w *watchHandlers) add(ctx context.Context, gvk schema.GroupVersionKind, informer kcache.SharedIndexInformer, handler kcache.ResourceEventHandler) {
+	w.mu.Lock()
+	handlers, ok := w.informers[informer]
+	if !ok {
+		handlers = newInformerHandlers(gvk)
+		w.informers[informer] = handlers
+	}
+	w.mu.Unlock()
+
+	// Add the handler before the informer can send events to handlers, so the
+	// first handler doesn't miss them.
+	handlers.add(ctx, informer.GetStore(), handler)
+
+	if !ok {
+		informer.AddEventHandler(handlers)
+	}
+}
+
+// remove forgets the handlers of informers for the group version kinds. It is
+// called when the informers are stopped.
+func (w *watchHandlers) remove(groupVersionKinds ...schema.GroupVersionKind) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for informer, handlers := range w.informers {
+		for _, gvk := range groupVersionKinds {
+			if handlers.gvk == gvk {
+				delete(w.informers, informer)
+				break
+			}
+		}
+	}
+}
+
+// reset forgets the handlers of all informers. It is called when the
+// informers are stopped because the cluster client changed.
+func (w *watchHandlers) reset() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.informers = make(map[kcache.SharedIndexInformer]*informerHandlers)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kcache "k8s.io/client-go/tools/cache"
+)
+
+type handlerInformer struct {
+	kcache.SharedIndexInformer
+	store    kcache.Store
+	handlers []kcache.ResourceEventHandler
+}
+
+func newHandlerInformer(t *testing.T, objects ...string) *handlerInformer {
+	store := kcache.NewStore(func(obj interface{}) (string, error) {
+		return obj.(string), nil
+	})
+	for _, object := range objects {
+		require.NoError(t, store.Add(object))
+	}
+
+	return &handlerInformer{store: store}
+}
+
+func (i *handlerInformer) GetStore() kcache.Store {
+	return i.store
+}
+
+// AddEventHandler sends the handler the objects in the cache like a started
+// informer does.
+func (i *handlerInformer) AddEventHandler(handler kcache.ResourceEventHandler) {
+	i.handlers = append(i.handlers, handler)
+	for _, obj := range i.store.List() {
+		handler.OnAdd(obj)
+	}
+}
+
+func Test_watchHandlers(t *testing.T) {
+	gvk := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	informer := newHandlerInformer(t, "a")
+	w := initWatchHandlers()
+
+	var first, second []interface{}
+	firstHandler := kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { first = append(first, obj) },
+	}
+	secondHandler := kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) { second = append(second, obj) },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.add(ctx, gvk, informer, firstHandler)
+	w.add(context.Background(), gvk, informer, secondHandler)
+
+	assert.Len(t, informer.handlers, 1, "expected a single handler on the informer")
+	assert.Equal(t, []interface{}{"a", "a"}, first)
+	assert.Equal(t, []interface{}{"a"}, second)
+
+	informer.handlers[0].OnAdd("b")
+	assert.Equal(t, []interface{}{"a", "a", "b"}, first)
+	assert.Equal(t, []interface{}{"a", "b"}, second)
+
+	cancel()
+	handlers := w.informers[informer]
+	assert.Eventually(t, func() bool {
+		return handlers.len() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	informer.handlers[0].OnAdd("c")
+	assert.Equal(t, []interface{}{"a", "a", "b"}, first)
+	assert.Equal(t, []interface{}{"a", "b", "c"}, second)
+}
+
+func Test_watchHandlers_remove(t *testing.T) {
+	podGVK := schema.GroupVersionKind{Version: "v1", Kind: "Pod"}
+	serviceGVK := schema.GroupVersionKind{Version: "v1", Kind: "Service"}
+	pods := newHandlerInformer(t)
+	services := newHandlerInformer(t)
+
+	w := initWatchHandlers()
+	handler := kcache.ResourceEventHandlerFuncs{}
+	w.add(context.Background(), podGVK, pods, handler)
+	w.add(context.Background(), serviceGVK, services, handler)
+
+	w.remove(podGVK)
+	assert.NotContains(t, w.informers, pods)
+	assert.Contains(t, w.informers, services)
+
+	w.reset()
+	assert.Empty(t, w.informers)
+}
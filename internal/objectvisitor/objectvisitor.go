@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/vmware-tanzu/octant/internal/config"
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/queryer"
 )
 
@@ -67,6 +68,13 @@ func SetTypedVisitors(list []TypedVisitor) DefaultVisitorOption {
 	}
 }
 
+// SetPluginHandler sets the visitor for objects related by plugins.
+func SetPluginHandler(dtv DefaultTypedVisitor) DefaultVisitorOption {
+	return func(dv *DefaultVisitor) {
+		dv.pluginHandler = dtv
+	}
+}
+
 // DefaultVisitor is the default implementation of Visitor.
 type DefaultVisitor struct {
 	queryer   queryer.Queryer
@@ -75,6 +83,7 @@ type DefaultVisitor struct {
 
 	typedVisitors  []TypedVisitor
 	defaultHandler DefaultTypedVisitor
+	pluginHandler  DefaultTypedVisitor
 }
 
 var _ Visitor = (*DefaultVisitor)(nil)
@@ -91,10 +100,15 @@ func NewDefaultVisitor(dashConfig config.Dash, q queryer.Queryer, options ...Def
 			NewService(q),
 			NewHorizontalPodAutoscaler(q),
 			NewAPIService(dashConfig.ObjectStore()),
+			NewPersistentVolumeClaim(dashConfig.ObjectStore()),
+			NewPersistentVolume(dashConfig.ObjectStore()),
+			NewVolumeSnapshot(dashConfig.ObjectStore()),
+			NewVolumeSnapshotContent(dashConfig.ObjectStore()),
 			NewMutatingWebhookConfiguration(dashConfig.ObjectStore()),
 			NewValidatingWebhookConfiguration(dashConfig.ObjectStore()),
 		},
 		defaultHandler: NewObject(dashConfig, q),
+		pluginHandler:  NewPluginRelations(dashConfig.PluginManager(), dashConfig.ObjectStore()),
 	}
 
 	for _, option := range options {
@@ -157,7 +171,8 @@ func (dv *DefaultVisitor) Visit(ctx context.Context, object *unstructured.Unstru
 }
 
 // visitObject visits an object. If the object is a service, ingress, or pod, it
-// also runs custom visitor code for them.
+// also runs custom visitor code for them. Objects related to it by plugins
+// are visited as well.
 func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object, handler ObjectHandler, visitDescendants bool) error {
 	ctx, span := trace.StartSpan(ctx, "visitObject")
 	defer span.End()
@@ -190,5 +205,12 @@ func (dv *DefaultVisitor) visitObject(ctx context.Context, object runtime.Object
 		}
 	}
 
+	// A failing plugin shouldn't hide the object's other relations.
+	if dv.pluginHandler != nil {
+		if err := dv.pluginHandler.Visit(ctx, u, handler, dv, visitDescendants); err != nil {
+			log.From(ctx).WithErr(err).Errorf("visit objects related by plugins")
+		}
+	}
+
 	return dv.defaultHandler.Visit(ctx, u, handler, dv, visitDescendants)
 }
@@ -7,6 +7,7 @@ package objectvisitor_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -29,6 +30,7 @@ func TestDefaultVisitor_Visit_use_typed_visitor(t *testing.T) {
 
 	objectStore := objectStoreFake.NewMockStore(controller)
 	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().PluginManager().Return(nil).AnyTimes()
 
 	pod := testutil.CreatePod("pod")
 	unstructuredPod := testutil.ToUnstructured(t, pod)
@@ -47,9 +49,50 @@ func TestDefaultVisitor_Visit_use_typed_visitor(t *testing.T) {
 		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true)
 	tvList := []objectvisitor.TypedVisitor{tv}
 
+	pluginHandler := ovFake.NewMockDefaultTypedVisitor(controller)
+	pluginHandler.EXPECT().
+		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true).Return(nil)
+
+	dv, err := objectvisitor.NewDefaultVisitor(dashConfig, q,
+		objectvisitor.SetDefaultHandler(defaultHandler),
+		objectvisitor.SetTypedVisitors(tvList),
+		objectvisitor.SetPluginHandler(pluginHandler))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = dv.Visit(ctx, testutil.ToUnstructured(t, pod), handler, true)
+	require.NoError(t, err)
+}
+
+func TestDefaultVisitor_Visit_plugin_error(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashConfig := configFake.NewMockDash(controller)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	dashConfig.EXPECT().ObjectStore().Return(objectStore).AnyTimes()
+	dashConfig.EXPECT().PluginManager().Return(nil).AnyTimes()
+
+	pod := testutil.CreatePod("pod")
+	unstructuredPod := testutil.ToUnstructured(t, pod)
+
+	q := queryerFake.NewMockQueryer(controller)
+
+	handler := ovFake.NewMockObjectHandler(controller)
+
+	defaultHandler := ovFake.NewMockDefaultTypedVisitor(controller)
+	defaultHandler.EXPECT().
+		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true).Return(nil)
+
+	pluginHandler := ovFake.NewMockDefaultTypedVisitor(controller)
+	pluginHandler.EXPECT().
+		Visit(gomock.Any(), unstructuredPod, handler, gomock.Any(), true).Return(errors.New("plugin failed"))
+
 	dv, err := objectvisitor.NewDefaultVisitor(dashConfig, q,
 		objectvisitor.SetDefaultHandler(defaultHandler),
-		objectvisitor.SetTypedVisitors(tvList))
+		objectvisitor.SetTypedVisitors(nil),
+		objectvisitor.SetPluginHandler(pluginHandler))
 	require.NoError(t, err)
 
 	ctx := context.Background()
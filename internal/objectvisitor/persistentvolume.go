@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// PersistentVolume is a typed visitor for persistent volumes.
+type PersistentVolume struct {
+	objectStore store.Store
+}
+
+var _ TypedVisitor = (*PersistentVolume)(nil)
+
+// NewPersistentVolume creates an instance of PersistentVolume.
+func NewPersistentVolume(os store.Store) *PersistentVolume {
+	return &PersistentVolume{
+		objectStore: os,
+	}
+}
+
+// Support returns the gvk this typed visitor supports.
+func (p *PersistentVolume) Supports() schema.GroupVersionKind {
+	return gvk.PersistentVolume
+}
+
+// Visit visits a persistent volume. It looks for the storage class and
+// volume attachments.
+func (p *PersistentVolume) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitPersistentVolume")
+	defer span.End()
+
+	if p.objectStore == nil {
+		return errors.New("objectStore is nil")
+	}
+
+	pv := &corev1.PersistentVolume{}
+	if err := kubernetes.FromUnstructured(object, pv); err != nil {
+		return err
+	}
+
+	var g errgroup.Group
+
+	g.Go(func() error {
+		if pv.Spec.StorageClassName == "" {
+			return nil
+		}
+
+		key := store.KeyFromGroupVersionKind(gvk.StorageClass)
+		key.Name = pv.Spec.StorageClassName
+		return visitStoreObject(ctx, p.objectStore, key, object, handler, visitor)
+	})
+
+	g.Go(func() error {
+		key := store.KeyFromGroupVersionKind(gvk.VolumeAttachment)
+		volumeAttachments, _, err := p.objectStore.List(ctx, key)
+		if err != nil {
+			if kerrors.IsForbidden(err) {
+				log.From(ctx).WithErr(err).Infof("%s skipping %s", kubernetes.PrintObject(object), key)
+				return nil
+			}
+			return errors.Wrap(err, "list volume attachments")
+		}
+
+		for i := range volumeAttachments.Items {
+			volumeAttachment := &volumeAttachments.Items[i]
+			name, _, err := unstructured.NestedString(volumeAttachment.Object, "spec", "source", "persistentVolumeName")
+			if err != nil {
+				return errors.Wrap(err, "get volume attachment persistent volume name")
+			}
+
+			if name != pv.Name {
+				continue
+			}
+
+			if err := visitor.Visit(ctx, volumeAttachment, handler, true); err != nil {
+				return errors.Wrapf(err, "persistent volume %s visit volume attachment %s",
+					kubernetes.PrintObject(pv), kubernetes.PrintObject(volumeAttachment))
+			}
+
+			if err := handler.AddEdge(ctx, object, volumeAttachment); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return g.Wait()
+}
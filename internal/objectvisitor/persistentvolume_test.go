@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestPersistentVolume_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	storageClass := testutil.CreateStorageClass("standard")
+	attachment := testutil.CreateVolumeAttachment("attachment", "pv")
+	otherAttachment := testutil.CreateVolumeAttachment("other", "other-pv")
+
+	object := testutil.CreatePersistentVolume("pv")
+	object.Spec.StorageClassName = storageClass.Name
+	u := testutil.ToUnstructured(t, object)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, storageClass)).
+		Return(nil)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, attachment)).
+		Return(nil)
+
+	var visited []unstructured.Unstructured
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), gomock.Any(), handler, true).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool) error {
+			visited = append(visited, *object)
+			return nil
+		}).Times(2)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass", Name: storageClass.Name}).
+		Return(testutil.ToUnstructured(t, storageClass), nil)
+	objectStore.EXPECT().
+		List(gomock.Any(), store.Key{APIVersion: "storage.k8s.io/v1", Kind: "VolumeAttachment"}).
+		Return(testutil.ToUnstructuredList(t, attachment, otherAttachment), false, nil)
+
+	pv := objectvisitor.NewPersistentVolume(objectStore)
+
+	ctx := context.Background()
+	err := pv.Visit(ctx, u, handler, visitor, true)
+
+	sortObjectsByName(t, visited)
+
+	expected := testutil.ToUnstructuredList(t, attachment, storageClass)
+	assert.Equal(t, expected.Items, visited)
+	assert.NoError(t, err)
+}
+
+func TestPersistentVolume_Visit_forbidden(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := testutil.CreatePersistentVolume("pv")
+	object.Spec.StorageClassName = "standard"
+	u := testutil.ToUnstructured(t, object)
+
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+
+	storageClassKey := store.Key{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass", Name: "standard"}
+	volumeAttachmentKey := store.Key{APIVersion: "storage.k8s.io/v1", Kind: "VolumeAttachment"}
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), storageClassKey).
+		Return(nil, kerrors.NewForbidden(schema.GroupResource{Group: "storage.k8s.io", Resource: "storageclasses"}, "standard", errors.New("forbidden")))
+	objectStore.EXPECT().
+		List(gomock.Any(), volumeAttachmentKey).
+		Return(nil, false, kerrors.NewForbidden(schema.GroupResource{Group: "storage.k8s.io", Resource: "volumeattachments"}, "", errors.New("forbidden")))
+
+	pv := objectvisitor.NewPersistentVolume(objectStore)
+
+	ctx := context.Background()
+	err := pv.Visit(ctx, u, handler, visitor, true)
+	assert.NoError(t, err)
+}
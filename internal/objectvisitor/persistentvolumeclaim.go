@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// PersistentVolumeClaim is a typed visitor for persistent volume claims.
+type PersistentVolumeClaim struct {
+	objectStore store.Store
+}
+
+var _ TypedVisitor = (*PersistentVolumeClaim)(nil)
+
+// NewPersistentVolumeClaim creates an instance of PersistentVolumeClaim.
+func NewPersistentVolumeClaim(os store.Store) *PersistentVolumeClaim {
+	return &PersistentVolumeClaim{
+		objectStore: os,
+	}
+}
+
+// Support returns the gvk this typed visitor supports.
+func (p *PersistentVolumeClaim) Supports() schema.GroupVersionKind {
+	return gvk.PersistentVolumeClaim
+}
+
+// Visit visits a persistent volume claim. It looks for the bound persistent volume,
+// the storage class and the volume snapshot it was restored from.
+func (p *PersistentVolumeClaim) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitPersistentVolumeClaim")
+	defer span.End()
+
+	if p.objectStore == nil {
+		return errors.New("objectStore is nil")
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := kubernetes.FromUnstructured(object, pvc); err != nil {
+		return err
+	}
+
+	var g errgroup.Group
+
+	g.Go(func() error {
+		if pvc.Spec.VolumeName == "" {
+			return nil
+		}
+
+		key := store.KeyFromGroupVersionKind(gvk.PersistentVolume)
+		key.Name = pvc.Spec.VolumeName
+		return visitStoreObject(ctx, p.objectStore, key, object, handler, visitor)
+	})
+
+	g.Go(func() error {
+		storageClassName := persistentVolumeClaimStorageClass(pvc)
+		if storageClassName == "" {
+			return nil
+		}
+
+		key := store.KeyFromGroupVersionKind(gvk.StorageClass)
+		key.Name = storageClassName
+		return visitStoreObject(ctx, p.objectStore, key, object, handler, visitor)
+	})
+
+	g.Go(func() error {
+		dataSource := pvc.Spec.DataSource
+		if dataSource == nil || dataSource.APIGroup == nil ||
+			*dataSource.APIGroup != gvk.VolumeSnapshot.Group || dataSource.Kind != gvk.VolumeSnapshot.Kind {
+			return nil
+		}
+
+		key := store.KeyFromGroupVersionKind(gvk.VolumeSnapshot)
+		key.Namespace = pvc.Namespace
+		key.Name = dataSource.Name
+		return visitStoreObject(ctx, p.objectStore, key, object, handler, visitor)
+	})
+
+	return g.Wait()
+}
+
+func persistentVolumeClaimStorageClass(pvc *corev1.PersistentVolumeClaim) string {
+	if class, ok := pvc.Annotations[corev1.BetaStorageClassAnnotation]; ok {
+		return class
+	}
+
+	if pvc.Spec.StorageClassName != nil {
+		return *pvc.Spec.StorageClassName
+	}
+
+	return ""
+}
+
+// visitStoreObject loads the object identified by key from the object store, visits it
+// and adds an edge from object to it. Objects which can't be found, or which the user
+// isn't allowed to get, are skipped.
+func visitStoreObject(ctx context.Context, objectStore store.Store, key store.Key, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor) error {
+	child, err := objectStore.Get(ctx, key)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		if kerrors.IsForbidden(err) {
+			log.From(ctx).WithErr(err).Infof("%s skipping %s", kubernetes.PrintObject(object), key)
+			return nil
+		}
+		return err
+	}
+
+	if child == nil || child.GetName() == "" {
+		return nil
+	}
+
+	if err := visitor.Visit(ctx, child, handler, true); err != nil {
+		return errors.Wrapf(err, "%s visit %s",
+			kubernetes.PrintObject(object), kubernetes.PrintObject(child))
+	}
+
+	return handler.AddEdge(ctx, object, child)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestPersistentVolumeClaim_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pv := testutil.CreatePersistentVolume("task-pv-volume")
+	storageClass := testutil.CreateStorageClass("manual")
+
+	object := testutil.CreatePersistentVolumeClaim("pvc")
+	u := testutil.ToUnstructured(t, object)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, pv)).
+		Return(nil)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, storageClass)).
+		Return(nil)
+
+	var visited []unstructured.Unstructured
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), gomock.Any(), handler, true).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool) error {
+			visited = append(visited, *object)
+			return nil
+		}).Times(2)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "v1", Kind: "PersistentVolume", Name: pv.Name}).
+		Return(testutil.ToUnstructured(t, pv), nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass", Name: storageClass.Name}).
+		Return(testutil.ToUnstructured(t, storageClass), nil)
+
+	pvc := objectvisitor.NewPersistentVolumeClaim(objectStore)
+
+	ctx := context.Background()
+	err := pvc.Visit(ctx, u, handler, visitor, true)
+
+	sortObjectsByName(t, visited)
+
+	expected := testutil.ToUnstructuredList(t, storageClass, pv)
+	assert.Equal(t, expected.Items, visited)
+	assert.NoError(t, err)
+}
+
+func TestPersistentVolumeClaim_Visit_notfound(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	object := testutil.CreatePersistentVolumeClaim("pvc")
+	u := testutil.ToUnstructured(t, object)
+
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "v1", Kind: "PersistentVolume", Name: object.Spec.VolumeName}).
+		Return(nil, kerrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumes"}, object.Spec.VolumeName))
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass", Name: "manual"}).
+		Return(&unstructured.Unstructured{}, nil)
+
+	pvc := objectvisitor.NewPersistentVolumeClaim(objectStore)
+
+	ctx := context.Background()
+	err := pvc.Visit(ctx, u, handler, visitor, true)
+	assert.NoError(t, err)
+}
+
+func TestPersistentVolumeClaim_Visit_volumeSnapshotDataSource(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	snapshot := createVolumeSnapshot("snapshot")
+
+	object := testutil.CreatePersistentVolumeClaim("pvc")
+	object.Spec.VolumeName = ""
+	object.Spec.StorageClassName = nil
+	apiGroup := "snapshot.storage.k8s.io"
+	object.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     "VolumeSnapshot",
+		Name:     "snapshot",
+	}
+	u := testutil.ToUnstructured(t, object)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().AddEdge(gomock.Any(), u, snapshot).Return(nil)
+
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().Visit(gomock.Any(), snapshot, handler, true).Return(nil)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "snapshot.storage.k8s.io/v1beta1", Kind: "VolumeSnapshot", Name: "snapshot"}).
+		Return(snapshot, nil)
+
+	pvc := objectvisitor.NewPersistentVolumeClaim(objectStore)
+
+	ctx := context.Background()
+	assert.NoError(t, pvc.Visit(ctx, u, handler, visitor, true))
+}
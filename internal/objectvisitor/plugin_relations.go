@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/plugin"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// PluginRelations visits objects which plugins have related to an object.
+type PluginRelations struct {
+	pluginManager plugin.ManagerInterface
+	objectStore   store.Store
+}
+
+var _ DefaultTypedVisitor = (*PluginRelations)(nil)
+
+// NewPluginRelations creates an instance of PluginRelations.
+func NewPluginRelations(pluginManager plugin.ManagerInterface, os store.Store) *PluginRelations {
+	return &PluginRelations{
+		pluginManager: pluginManager,
+		objectStore:   os,
+	}
+}
+
+// Visit asks plugins which support object relations for the keys of objects
+// related to an object. It visits each related object and adds an edge to it.
+func (p *PluginRelations) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitPluginRelations")
+	defer span.End()
+
+	if p.pluginManager == nil {
+		return nil
+	}
+
+	if p.objectStore == nil {
+		return errors.New("objectStore is nil")
+	}
+
+	keys, err := p.pluginManager.Relations(ctx, object)
+	if err != nil {
+		return errors.Wrapf(err, "find plugin relations for %s", kubernetes.PrintObject(object))
+	}
+
+	var g errgroup.Group
+
+	for i := range keys {
+		key := keys[i]
+		g.Go(func() error {
+			return visitStoreObject(ctx, p.objectStore, key, object, handler, visitor)
+		})
+	}
+
+	return g.Wait()
+}
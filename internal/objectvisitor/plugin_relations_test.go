@@ -0,0 +1,93 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	pluginFake "github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestPluginRelations_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	configMap := testutil.CreateConfigMap("config")
+	secret := testutil.CreateSecret("secret")
+
+	object := testutil.CreatePod("pod")
+	u := testutil.ToUnstructured(t, object)
+
+	configMapKey, err := store.KeyFromObject(configMap)
+	require.NoError(t, err)
+	secretKey, err := store.KeyFromObject(secret)
+	require.NoError(t, err)
+
+	pluginManager := pluginFake.NewMockManagerInterface(controller)
+	pluginManager.EXPECT().
+		Relations(gomock.Any(), u).
+		Return([]store.Key{configMapKey, secretKey}, nil)
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().
+		AddEdge(gomock.Any(), u, testutil.ToUnstructured(t, configMap)).
+		Return(nil)
+
+	var visited []unstructured.Unstructured
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), gomock.Any(), handler, true).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool) error {
+			visited = append(visited, *object)
+			return nil
+		})
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), configMapKey).
+		Return(testutil.ToUnstructured(t, configMap), nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), secretKey).
+		Return(nil, kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, secret.Name))
+
+	pr := objectvisitor.NewPluginRelations(pluginManager, objectStore)
+
+	ctx := context.Background()
+	err = pr.Visit(ctx, u, handler, visitor, true)
+	require.NoError(t, err)
+
+	expected := testutil.ToUnstructuredList(t, configMap)
+	assert.Equal(t, expected.Items, visited)
+}
+
+func TestPluginRelations_Visit_no_plugin_manager(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	u := testutil.ToUnstructured(t, testutil.CreatePod("pod"))
+
+	handler := fake.NewMockObjectHandler(controller)
+	visitor := fake.NewMockVisitor(controller)
+	objectStore := objectStoreFake.NewMockStore(controller)
+
+	pr := objectvisitor.NewPluginRelations(nil, objectStore)
+
+	ctx := context.Background()
+	require.NoError(t, pr.Visit(ctx, u, handler, visitor, true))
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// VolumeSnapshot is a typed visitor for volume snapshots.
+type VolumeSnapshot struct {
+	objectStore store.Store
+}
+
+var _ TypedVisitor = (*VolumeSnapshot)(nil)
+
+// NewVolumeSnapshot creates an instance of VolumeSnapshot.
+func NewVolumeSnapshot(os store.Store) *VolumeSnapshot {
+	return &VolumeSnapshot{
+		objectStore: os,
+	}
+}
+
+// Support returns the gvk this typed visitor supports.
+func (v *VolumeSnapshot) Supports() schema.GroupVersionKind {
+	return gvk.VolumeSnapshot
+}
+
+// Visit visits a volume snapshot. It looks for the persistent volume claim it
+// was taken from and its volume snapshot content.
+func (v *VolumeSnapshot) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitVolumeSnapshot")
+	defer span.End()
+
+	if v.objectStore == nil {
+		return errors.New("objectStore is nil")
+	}
+
+	var g errgroup.Group
+
+	g.Go(func() error {
+		name, _, err := unstructured.NestedString(object.Object, "spec", "source", "persistentVolumeClaimName")
+		if err != nil {
+			return errors.Wrap(err, "get volume snapshot persistent volume claim name")
+		}
+		if name == "" {
+			return nil
+		}
+
+		key := store.KeyFromGroupVersionKind(gvk.PersistentVolumeClaim)
+		key.Namespace = object.GetNamespace()
+		key.Name = name
+		return visitStoreObject(ctx, v.objectStore, key, object, handler, visitor)
+	})
+
+	g.Go(func() error {
+		name, err := volumeSnapshotContentName(object)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return nil
+		}
+
+		key := store.Key{APIVersion: object.GetAPIVersion(), Kind: gvk.VolumeSnapshotContent.Kind, Name: name}
+		return visitStoreObject(ctx, v.objectStore, key, object, handler, visitor)
+	})
+
+	return g.Wait()
+}
+
+// volumeSnapshotContentName returns the name of the volume snapshot content
+// bound to a volume snapshot. A pre-provisioned snapshot names its content
+// before it is bound.
+func volumeSnapshotContentName(object *unstructured.Unstructured) (string, error) {
+	name, _, err := unstructured.NestedString(object.Object, "status", "boundVolumeSnapshotContentName")
+	if err != nil {
+		return "", errors.Wrap(err, "get volume snapshot bound content name")
+	}
+	if name != "" {
+		return name, nil
+	}
+
+	name, _, err = unstructured.NestedString(object.Object, "spec", "source", "volumeSnapshotContentName")
+	if err != nil {
+		return "", errors.Wrap(err, "get volume snapshot content name")
+	}
+
+	return name, nil
+}
+
+// VolumeSnapshotContent is a typed visitor for volume snapshot contents.
+type VolumeSnapshotContent struct {
+	objectStore store.Store
+}
+
+var _ TypedVisitor = (*VolumeSnapshotContent)(nil)
+
+// NewVolumeSnapshotContent creates an instance of VolumeSnapshotContent.
+func NewVolumeSnapshotContent(os store.Store) *VolumeSnapshotContent {
+	return &VolumeSnapshotContent{
+		objectStore: os,
+	}
+}
+
+// Support returns the gvk this typed visitor supports.
+func (v *VolumeSnapshotContent) Supports() schema.GroupVersionKind {
+	return gvk.VolumeSnapshotContent
+}
+
+// Visit visits a volume snapshot content. It looks for the volume snapshot
+// bound to it.
+func (v *VolumeSnapshotContent) Visit(ctx context.Context, object *unstructured.Unstructured, handler ObjectHandler, visitor Visitor, visitDescendants bool) error {
+	ctx, span := trace.StartSpan(ctx, "visitVolumeSnapshotContent")
+	defer span.End()
+
+	if v.objectStore == nil {
+		return errors.New("objectStore is nil")
+	}
+
+	ref, _, err := unstructured.NestedStringMap(object.Object, "spec", "volumeSnapshotRef")
+	if err != nil {
+		return errors.Wrap(err, "get volume snapshot content snapshot reference")
+	}
+	if ref["name"] == "" {
+		return nil
+	}
+
+	key := store.Key{
+		Namespace:  ref["namespace"],
+		APIVersion: object.GetAPIVersion(),
+		Kind:       gvk.VolumeSnapshot.Kind,
+		Name:       ref["name"],
+	}
+	return visitStoreObject(ctx, v.objectStore, key, object, handler, visitor)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package objectvisitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/objectvisitor"
+	"github.com/vmware-tanzu/octant/internal/objectvisitor/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	objectStoreFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestVolumeSnapshot_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pvc := testutil.ToUnstructured(t, testutil.CreatePersistentVolumeClaim("pvc"))
+	content := createVolumeSnapshotContent("content")
+
+	u := createVolumeSnapshot("snapshot")
+	require.NoError(t, unstructured.SetNestedField(u.Object, "pvc", "spec", "source", "persistentVolumeClaimName"))
+	require.NoError(t, unstructured.SetNestedField(u.Object, "content", "status", "boundVolumeSnapshotContentName"))
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().AddEdge(gomock.Any(), u, pvc).Return(nil)
+	handler.EXPECT().AddEdge(gomock.Any(), u, content).Return(nil)
+
+	var visited []unstructured.Unstructured
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().
+		Visit(gomock.Any(), gomock.Any(), handler, true).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, handler objectvisitor.ObjectHandler, _ bool) error {
+			visited = append(visited, *object)
+			return nil
+		}).Times(2)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "PersistentVolumeClaim", Name: "pvc"}).
+		Return(pvc, nil)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{APIVersion: "snapshot.storage.k8s.io/v1beta1", Kind: "VolumeSnapshotContent", Name: "content"}).
+		Return(content, nil)
+
+	snapshot := objectvisitor.NewVolumeSnapshot(objectStore)
+
+	ctx := context.Background()
+	err := snapshot.Visit(ctx, u, handler, visitor, true)
+	require.NoError(t, err)
+
+	sortObjectsByName(t, visited)
+	assert.Equal(t, []unstructured.Unstructured{*content, *pvc}, visited)
+}
+
+func TestVolumeSnapshotContent_Visit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	snapshot := createVolumeSnapshot("snapshot")
+
+	u := createVolumeSnapshotContent("content")
+	ref := map[string]interface{}{"namespace": testutil.DefaultNamespace, "name": "snapshot"}
+	require.NoError(t, unstructured.SetNestedField(u.Object, ref, "spec", "volumeSnapshotRef"))
+
+	handler := fake.NewMockObjectHandler(controller)
+	handler.EXPECT().AddEdge(gomock.Any(), u, snapshot).Return(nil)
+
+	visitor := fake.NewMockVisitor(controller)
+	visitor.EXPECT().Visit(gomock.Any(), snapshot, handler, true).Return(nil)
+
+	objectStore := objectStoreFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "snapshot.storage.k8s.io/v1beta1", Kind: "VolumeSnapshot", Name: "snapshot"}).
+		Return(snapshot, nil)
+
+	content := objectvisitor.NewVolumeSnapshotContent(objectStore)
+
+	ctx := context.Background()
+	require.NoError(t, content.Visit(ctx, u, handler, visitor, true))
+}
+
+func createVolumeSnapshot(name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("snapshot.storage.k8s.io/v1beta1")
+	u.SetKind("VolumeSnapshot")
+	u.SetNamespace(testutil.DefaultNamespace)
+	u.SetName(name)
+	return u
+}
+
+func createVolumeSnapshotContent(name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("snapshot.storage.k8s.io/v1beta1")
+	u.SetKind("VolumeSnapshotContent")
+	u.SetName(name)
+	return u
+}
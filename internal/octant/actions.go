@@ -1,30 +1,101 @@
 package octant
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
 const (
-	ActionDeleteObject            = "action.octant.dev/deleteObject"
-	ActionOverviewCordon          = "action.octant.dev/cordon"
-	ActionOverviewUncordon        = "action.octant.dev/uncordon"
-	ActionOverviewContainerEditor = "action.octant.dev/containerEditor"
-	ActionOverviewCronjob         = "action.octant.dev/cronJob"
-	ActionOverviewSuspendCronjob  = "action.octant.dev/suspendCronJob"
-	ActionOverviewResumeCronjob   = "action.octant.dev/resumeCronJob"
-	ActionOverviewServiceEditor   = "action.octant.dev/serviceEditor"
-	ActionDeploymentConfiguration = "action.octant.dev/deploymentConfiguration"
-	ActionUpdateObject            = "action.octant.dev/update"
-	ActionApplyYaml               = "action.octant.dev/apply"
+	ActionDeleteObject             = "action.octant.dev/deleteObject"
+	ActionOverviewCordon           = "action.octant.dev/cordon"
+	ActionOverviewUncordon         = "action.octant.dev/uncordon"
+	ActionOverviewContainerEditor  = "action.octant.dev/containerEditor"
+	ActionOverviewCronjob          = "action.octant.dev/cronJob"
+	ActionOverviewSuspendCronjob   = "action.octant.dev/suspendCronJob"
+	ActionOverviewResumeCronjob    = "action.octant.dev/resumeCronJob"
+	ActionOverviewServiceEditor    = "action.octant.dev/serviceEditor"
+	ActionDeploymentConfiguration  = "action.octant.dev/deploymentConfiguration"
+	ActionUpdateObject             = "action.octant.dev/update"
+	ActionApplyYaml                = "action.octant.dev/apply"
+	ActionPreviewYaml              = "action.octant.dev/previewApply"
+	ActionDiscardYamlPreview       = "action.octant.dev/discardApplyPreview"
+	ActionApplyYamlPreview         = "action.octant.dev/applyPreview"
+	ActionRolloutPause             = "action.octant.dev/rolloutPause"
+	ActionRolloutResume            = "action.octant.dev/rolloutResume"
+	ActionRolloutRestart           = "action.octant.dev/rolloutRestart"
+	ActionRolloutUndo              = "action.octant.dev/rolloutUndo"
+	ActionScale                    = "action.octant.dev/scale"
+	ActionDebugContainer           = "action.octant.dev/debugContainer"
+	ActionDebugNode                = "action.octant.dev/debugNode"
+	ActionExecCommand              = "action.octant.dev/execCommand"
+	ActionSetPluginEnabled         = "action.octant.dev/setPluginEnabled"
+	ActionSavePortForwardProfile   = "overview/savePortForwardProfile"
+	ActionStartPortForwardProfile  = "overview/startPortForwardProfile"
+	ActionStopPortForwardProfile   = "overview/stopPortForwardProfile"
+	ActionDeletePortForwardProfile = "overview/deletePortForwardProfile"
 )
 
+// Field managers for actions which apply some of an object's fields. A
+// server-side apply removes fields its manager applied before and leaves out
+// this time, so each action applies with its own manager to keep it from
+// removing fields set by other actions.
+const (
+	deploymentEditorFieldManager = "octant-deployment-editor"
+)
+
+// containerEditorFieldManager returns the field manager for edits to a
+// container. Containers are edited one at a time, so each has its own manager.
+func containerEditorFieldManager(containerName string) string {
+	return "octant-container-editor-" + containerName
+}
+
+// ForceApplyFormField creates a form field which lets an action's server-side
+// apply take ownership of fields owned by other managers.
+func ForceApplyFormField() component.FormField {
+	return component.NewFormFieldCheckBox("Force", "force", []component.InputChoice{
+		{Label: "Take ownership of fields owned by others", Value: "true"},
+	})
+}
+
+// storeApplier returns the server-side apply support of an object store.
+func storeApplier(objectStore store.Store) (store.Applier, error) {
+	applier, ok := objectStore.(store.Applier)
+	if !ok {
+		return nil, errors.New("object store does not support server-side apply")
+	}
+
+	return applier, nil
+}
+
+// storePatcher returns the patch support of an object store.
+func storePatcher(objectStore store.Store) (store.Patcher, error) {
+	patcher, ok := objectStore.(store.Patcher)
+	if !ok {
+		return nil, errors.New("object store does not support patches")
+	}
+
+	return patcher, nil
+}
+
+// applyErrorMessage describes an error from a server-side apply. Conflicts
+// say how to override them.
+func applyErrorMessage(err error) string {
+	if _, ok := store.IsApplyConflict(err); ok {
+		return fmt.Sprintf("%s. Edit again with Force checked to take ownership of these fields", err)
+	}
+
+	return err.Error()
+}
+
 func sendAlert(alerter action.Alerter, alertType action.AlertType, message string, expiration *time.Time) {
 	alert := action.Alert{
 		Type:       alertType,
@@ -35,6 +106,18 @@ func sendAlert(alerter action.Alerter, alertType action.AlertType, message strin
 	alerter.SendAlert(alert)
 }
 
+// newApplyObject creates an object which identifies key for a server-side
+// apply. Only the fields set on the returned object are applied, so fields
+// owned by other managers are left alone.
+func newApplyObject(key store.Key) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(key.APIVersion)
+	object.SetKind(key.Kind)
+	object.SetNamespace(key.Namespace)
+	object.SetName(key.Name)
+	return object
+}
+
 func DeleteObjectConfirmationButton(object runtime.Object) (component.ButtonOption, error) {
 	if object == nil {
 		return nil, fmt.Errorf("object is nil")
@@ -6,11 +6,21 @@
 package octant
 
 import (
+	"context"
+	"sort"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -31,3 +41,111 @@ func Test_DeleteObjectConfirmation(t *testing.T) {
 
 	require.Equal(t, expected, button)
 }
+
+// applierStore is a store which supports server-side apply.
+type applierStore struct {
+	store.Store
+	store.Applier
+}
+
+func Test_partialApplies(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	applier := fake.NewMockApplier(controller)
+	objectStore := applierStore{Store: fake.NewMockStore(controller), Applier: applier}
+	alerter := actionFake.NewMockAlerter(controller)
+
+	applies := fieldManagerApplies{}
+	applier.EXPECT().
+		Apply(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(applies.apply).
+		Times(2)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		Do(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+		}).
+		Times(2)
+
+	ctx := context.Background()
+	keyData := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"namespace":  "default",
+		"name":       "deployment",
+	}
+
+	containerEditor := NewContainerEditor(objectStore)
+	payload := action.CreatePayload(ActionOverviewContainerEditor, keyData)
+	payload["containersPath"] = `["spec", "template", "spec", "containers"]`
+	payload["containerName"] = "nginx"
+	payload["containerImage"] = "nginx:1.19"
+	require.NoError(t, containerEditor.Handle(ctx, alerter, payload))
+
+	deploymentEditor := NewDeploymentConfigurationEditor(log.NopLogger(), objectStore)
+	payload = action.CreatePayload(ActionDeploymentConfiguration, keyData)
+	payload["replicas"] = "3"
+	require.NoError(t, deploymentEditor.Handle(ctx, alerter, payload))
+
+	object := &unstructured.Unstructured{Object: applies.merged()}
+
+	containers, _, err := unstructured.NestedSlice(object.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	expected := []interface{}{
+		map[string]interface{}{"name": "nginx", "image": "nginx:1.19"},
+	}
+	assert.Equal(t, expected, containers)
+
+	replicas, _, err := unstructured.NestedInt64(object.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), replicas)
+}
+
+// fieldManagerApplies records the latest object applied by each field
+// manager. Like the API server, an apply replaces everything its manager
+// applied before, so the applied object is the merge of each manager's
+// latest apply.
+type fieldManagerApplies map[string]map[string]interface{}
+
+func (f fieldManagerApplies) apply(_ context.Context, object *unstructured.Unstructured, options store.ApplyOptions) error {
+	fieldManager := options.FieldManager
+	if fieldManager == "" {
+		fieldManager = store.DefaultFieldManager
+	}
+
+	f[fieldManager] = object.DeepCopy().Object
+	return nil
+}
+
+func (f fieldManagerApplies) merged() map[string]interface{} {
+	var fieldManagers []string
+	for fieldManager := range f {
+		fieldManagers = append(fieldManagers, fieldManager)
+	}
+	sort.Strings(fieldManagers)
+
+	merged := map[string]interface{}{}
+	for _, fieldManager := range fieldManagers {
+		mergeFields(merged, f[fieldManager])
+	}
+
+	return merged
+}
+
+func mergeFields(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, ok := v.(map[string]interface{})
+		if !ok {
+			dst[k] = v
+			continue
+		}
+
+		dstMap, ok := dst[k].(map[string]interface{})
+		if !ok {
+			dstMap = map[string]interface{}{}
+			dst[k] = dstMap
+		}
+		mergeFields(dstMap, srcMap)
+	}
+}
@@ -47,6 +47,14 @@ func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload
 	}
 	p.logger.Debugf("%s", request)
 
+	// errors are sent to the client as alerts
+	_ = p.apply(ctx, alerter, request)
+	return nil
+}
+
+// apply applies the requested yaml and sends the results to the client. It
+// returns the error if the yaml could not be applied.
+func (p *ApplyYaml) apply(ctx context.Context, alerter action.Alerter, request *applyYamlRequest) error {
 	results, err := p.objectStore.CreateOrUpdateFromYAML(ctx, request.Namespace, request.Update)
 	if err != nil {
 		p.logger.Warnf("unable to apply yaml: %s", err)
@@ -66,7 +74,7 @@ func (p *ApplyYaml) Handle(ctx context.Context, alerter action.Alerter, payload
 		message := fmt.Sprintf("Applied %d resources", len(results))
 		alerter.SendAlert(action.CreateAlert(action.AlertTypeInfo, message, action.DefaultAlertExpiration))
 	}
-	return nil
+	return err
 }
 
 type applyYamlRequest struct {
@@ -0,0 +1,269 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// ApplyYamlPreview is the result of a server-side dry-run of YAML.
+type ApplyYamlPreview struct {
+	Namespace string
+	Update    string
+	Results   []store.DryRunResult
+}
+
+// HasErrors returns true if any document in the preview failed its dry-run.
+func (p *ApplyYamlPreview) HasErrors() bool {
+	for _, result := range p.Results {
+		if result.Err != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasConflicts returns true if applying the preview would take over fields
+// owned by other field managers.
+func (p *ApplyYamlPreview) HasConflicts() bool {
+	for _, result := range p.Results {
+		if len(result.Conflicts) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyYamlPreviewTTL is how long a preview is kept. Clients don't say when
+// they go away, so previews expire instead.
+const applyYamlPreviewTTL = time.Hour
+
+type applyYamlPreviewKey struct {
+	clientID  string
+	namespace string
+}
+
+type applyYamlPreviewEntry struct {
+	preview *ApplyYamlPreview
+	expires time.Time
+}
+
+// ApplyYamlPreviews holds the most recent apply yaml preview for each client
+// and namespace. The client is found with the ID in the context. Previews
+// expire after an hour.
+type ApplyYamlPreviews struct {
+	previews map[applyYamlPreviewKey]applyYamlPreviewEntry
+	mu       sync.Mutex
+	now      func() time.Time
+}
+
+// NewApplyYamlPreviews creates an instance of ApplyYamlPreviews.
+func NewApplyYamlPreviews() *ApplyYamlPreviews {
+	return &ApplyYamlPreviews{
+		previews: make(map[applyYamlPreviewKey]applyYamlPreviewEntry),
+		now:      time.Now,
+	}
+}
+
+// Set sets the current preview for the client in its namespace. Expired
+// previews of all clients are removed.
+func (a *ApplyYamlPreviews) Set(ctx context.Context, preview *ApplyYamlPreview) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	for key, entry := range a.previews {
+		if !now.Before(entry.expires) {
+			delete(a.previews, key)
+		}
+	}
+
+	a.previews[previewKey(ctx, preview.Namespace)] = applyYamlPreviewEntry{
+		preview: preview,
+		expires: now.Add(applyYamlPreviewTTL),
+	}
+}
+
+// Get returns the current preview for the client in a namespace. It returns
+// nil if there is no preview or it has expired.
+func (a *ApplyYamlPreviews) Get(ctx context.Context, namespace string) *ApplyYamlPreview {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	entry, ok := a.previews[previewKey(ctx, namespace)]
+	if !ok || !a.now().Before(entry.expires) {
+		return nil
+	}
+
+	return entry.preview
+}
+
+// Clear removes the current preview for the client in a namespace.
+func (a *ApplyYamlPreviews) Clear(ctx context.Context, namespace string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.previews, previewKey(ctx, namespace))
+}
+
+func previewKey(ctx context.Context, namespace string) applyYamlPreviewKey {
+	return applyYamlPreviewKey{
+		clientID:  ocontext.ClientIDFrom(ctx),
+		namespace: namespace,
+	}
+}
+
+// PreviewYaml previews the changes applying yaml would make using a
+// server-side dry-run.
+type PreviewYaml struct {
+	logger      log.Logger
+	objectStore store.Store
+	previews    *ApplyYamlPreviews
+}
+
+var _ action.Dispatcher = (*PreviewYaml)(nil)
+
+// NewPreviewYaml creates an instance of PreviewYaml.
+func NewPreviewYaml(logger log.Logger, objectStore store.Store, previews *ApplyYamlPreviews) *PreviewYaml {
+	return &PreviewYaml{
+		logger:      logger,
+		objectStore: objectStore,
+		previews:    previews,
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *PreviewYaml) ActionName() string {
+	return ActionPreviewYaml
+}
+
+// Handle runs the requested yaml through a dry-run and saves the results so
+// they can be reviewed before the yaml is applied.
+func (p *PreviewYaml) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.With("payload", payload).Debugf("received action payload")
+
+	request, err := applyYamlRequestFromPayload(payload)
+	if err != nil {
+		return errors.Wrap(err, "convert payload to apply yaml request")
+	}
+
+	dryRunner, ok := p.objectStore.(store.DryRunner)
+	if !ok {
+		return errors.New("object store does not support dry-runs")
+	}
+
+	results, err := dryRunner.DryRunFromYAML(ctx, request.Namespace, request.Update)
+	if err != nil {
+		p.logger.Warnf("unable to preview yaml: %s", err)
+		message := fmt.Sprintf("Unable to preview yaml: %s", err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		// do not return to show partial results to the client
+	}
+
+	preview := &ApplyYamlPreview{
+		Namespace: request.Namespace,
+		Update:    request.Update,
+		Results:   results,
+	}
+	p.previews.Set(ctx, preview)
+
+	if preview.HasErrors() {
+		message := "Dry-run failed for one or more resources"
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+	}
+
+	return nil
+}
+
+// ApplyYamlFromPreview applies the yaml in the current apply yaml preview. The
+// preview is cleared once it has been applied.
+type ApplyYamlFromPreview struct {
+	applyYaml *ApplyYaml
+	previews  *ApplyYamlPreviews
+}
+
+var _ action.Dispatcher = (*ApplyYamlFromPreview)(nil)
+
+// NewApplyYamlFromPreview creates an instance of ApplyYamlFromPreview.
+func NewApplyYamlFromPreview(logger log.Logger, objectStore store.Store, previews *ApplyYamlPreviews) *ApplyYamlFromPreview {
+	return &ApplyYamlFromPreview{
+		applyYaml: NewApplyYaml(logger, objectStore),
+		previews:  previews,
+	}
+}
+
+// ActionName returns the name of this action.
+func (a *ApplyYamlFromPreview) ActionName() string {
+	return ActionApplyYamlPreview
+}
+
+// Handle applies the current preview.
+func (a *ApplyYamlFromPreview) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return errors.Wrap(err, "get namespace from payload")
+	}
+
+	preview := a.previews.Get(ctx, namespace)
+	if preview == nil {
+		message := "There is no preview to apply"
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeWarning, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	request := &applyYamlRequest{
+		Namespace: preview.Namespace,
+		Update:    preview.Update,
+	}
+	if err := a.applyYaml.apply(ctx, alerter, request); err != nil {
+		return nil
+	}
+
+	a.previews.Clear(ctx, namespace)
+	return nil
+}
+
+// DiscardYamlPreview discards the current apply yaml preview.
+type DiscardYamlPreview struct {
+	previews *ApplyYamlPreviews
+}
+
+var _ action.Dispatcher = (*DiscardYamlPreview)(nil)
+
+// NewDiscardYamlPreview creates an instance of DiscardYamlPreview.
+func NewDiscardYamlPreview(previews *ApplyYamlPreviews) *DiscardYamlPreview {
+	return &DiscardYamlPreview{
+		previews: previews,
+	}
+}
+
+// ActionName returns the name of this action.
+func (d *DiscardYamlPreview) ActionName() string {
+	return ActionDiscardYamlPreview
+}
+
+// Handle discards the current preview.
+func (d *DiscardYamlPreview) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return errors.Wrap(err, "get namespace from payload")
+	}
+
+	d.previews.Clear(ctx, namespace)
+	return nil
+}
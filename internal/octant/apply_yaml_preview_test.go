@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocontext "github.com/vmware-tanzu/octant/internal/context"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+// dryRunStore is a store which supports dry-runs.
+type dryRunStore struct {
+	store.Store
+	store.DryRunner
+}
+
+func TestPreviewYaml(t *testing.T) {
+	update := `
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: greeting
+data:
+  hello: world
+`
+	key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "greeting"}
+
+	tests := []struct {
+		name     string
+		results  []store.DryRunResult
+		err      error
+		alerts   []action.AlertType
+		hasError bool
+	}{
+		{
+			name:    "in general",
+			results: []store.DryRunResult{{Key: key}},
+		},
+		{
+			name:     "document failed dry-run",
+			results:  []store.DryRunResult{{Key: key, Err: fmt.Errorf("invalid")}},
+			alerts:   []action.AlertType{action.AlertTypeWarning},
+			hasError: true,
+		},
+		{
+			name:   "unable to parse yaml",
+			err:    fmt.Errorf("unable to parse yaml"),
+			alerts: []action.AlertType{action.AlertTypeError},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dryRunner := fake.NewMockDryRunner(controller)
+			dryRunner.EXPECT().
+				DryRunFromYAML(gomock.Any(), "default", update).
+				Return(test.results, test.err)
+			objectStore := dryRunStore{Store: fake.NewMockStore(controller), DryRunner: dryRunner}
+
+			alerter := actionFake.NewMockAlerter(controller)
+			var alerts []action.AlertType
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					alerts = append(alerts, alert.Type)
+				}).
+				AnyTimes()
+
+			previews := NewApplyYamlPreviews()
+			previewYaml := NewPreviewYaml(log.NopLogger(), objectStore, previews)
+
+			payload := action.CreatePayload(ActionPreviewYaml, map[string]interface{}{
+				"update":    update,
+				"namespace": "default",
+			})
+
+			ctx := ocontext.WithClientID(context.Background(), "client")
+			require.NoError(t, previewYaml.Handle(ctx, alerter, payload))
+			assert.Equal(t, test.alerts, alerts)
+
+			preview := previews.Get(ctx, "default")
+			require.NotNil(t, preview)
+			assert.Equal(t, update, preview.Update)
+			assert.Equal(t, test.results, preview.Results)
+			assert.Equal(t, test.hasError, preview.HasErrors())
+
+			otherClient := ocontext.WithClientID(context.Background(), "other")
+			assert.Nil(t, previews.Get(otherClient, "default"))
+			assert.Nil(t, previews.Get(ctx, "other"))
+
+			discard := NewDiscardYamlPreview(previews)
+			require.NoError(t, discard.Handle(ctx, alerter, action.Payload{"namespace": "default"}))
+			assert.Nil(t, previews.Get(ctx, "default"))
+		})
+	}
+}
+
+func TestPreviewYaml_dryRunUnsupported(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	previews := NewApplyYamlPreviews()
+	previewYaml := NewPreviewYaml(log.NopLogger(), objectStore, previews)
+
+	payload := action.CreatePayload(ActionPreviewYaml, map[string]interface{}{
+		"update":    "update",
+		"namespace": "default",
+	})
+
+	ctx := ocontext.WithClientID(context.Background(), "client")
+	require.Error(t, previewYaml.Handle(ctx, alerter, payload))
+	assert.Nil(t, previews.Get(ctx, "default"))
+}
+
+func TestApplyYamlFromPreview(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		cleared bool
+	}{
+		{
+			name:    "in general",
+			cleared: true,
+		},
+		{
+			name: "apply failed",
+			err:  fmt.Errorf("apply failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := fake.NewMockStore(controller)
+			objectStore.EXPECT().
+				CreateOrUpdateFromYAML(gomock.Any(), "default", "update").
+				Return([]string{"Created ConfigMap (v1) greeting in default"}, test.err)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().SendAlert(gomock.Any()).AnyTimes()
+
+			ctx := ocontext.WithClientID(context.Background(), "client")
+
+			previews := NewApplyYamlPreviews()
+			preview := &ApplyYamlPreview{Namespace: "default", Update: "update"}
+			previews.Set(ctx, preview)
+
+			applyYamlPreview := NewApplyYamlFromPreview(log.NopLogger(), objectStore, previews)
+			payload := action.CreatePayload(ActionApplyYamlPreview, map[string]interface{}{
+				"namespace": "default",
+			})
+			require.NoError(t, applyYamlPreview.Handle(ctx, alerter, payload))
+
+			if test.cleared {
+				assert.Nil(t, previews.Get(ctx, "default"))
+			} else {
+				assert.Equal(t, preview, previews.Get(ctx, "default"))
+			}
+		})
+	}
+}
+
+func TestApplyYamlPreviews_expire(t *testing.T) {
+	now := time.Unix(0, 0)
+	previews := NewApplyYamlPreviews()
+	previews.now = func() time.Time {
+		return now
+	}
+
+	client := ocontext.WithClientID(context.Background(), "client")
+	otherClient := ocontext.WithClientID(context.Background(), "other")
+
+	preview := &ApplyYamlPreview{Namespace: "default", Update: "update"}
+	previews.Set(client, preview)
+	assert.Equal(t, preview, previews.Get(client, "default"))
+
+	now = now.Add(applyYamlPreviewTTL)
+	assert.Nil(t, previews.Get(client, "default"))
+
+	previews.Set(otherClient, &ApplyYamlPreview{Namespace: "default"})
+	assert.Len(t, previews.previews, 1, "expected expired previews to be removed")
+}
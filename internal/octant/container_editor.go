@@ -15,7 +15,6 @@ import (
 
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/pkg/action"
-	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
@@ -40,7 +39,7 @@ func (e *ContainerEditor) ActionName() string {
 	return "action.octant.dev/containerEditor"
 }
 
-// Handle edits a container. Supported edits:
+// Handle edits a container with a server-side apply. Supported edits:
 //   * image
 func (e *ContainerEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
 	logger := internalLog.From(ctx).With("actionName", e.ActionName())
@@ -71,14 +70,27 @@ func (e *ContainerEditor) Handle(ctx context.Context, alerter action.Alerter, pa
 		return err
 	}
 
-	fn := updateContainer(containersPath, logger, containerName, containerImage)
+	force, err := payload.OptionalBool("force")
+	if err != nil {
+		return err
+	}
 
+	object, err := containerApplyObject(key, containersPath, containerName, containerImage)
+	if err != nil {
+		return err
+	}
+
+	applier, err := storeApplier(e.store)
+	if err != nil {
+		return err
+	}
+
+	options := store.ApplyOptions{FieldManager: containerEditorFieldManager(containerName), Force: force}
 	message := fmt.Sprintf("Container %q was updated", containerName)
 	alertType := action.AlertTypeInfo
-	if err := e.store.Update(ctx, key, fn); err != nil {
-		message = fmt.Sprintf("Unable to update container %q: %s", containerName, err)
+	if err := applier.Apply(ctx, object, options); err != nil {
+		message = fmt.Sprintf("Unable to update container %q: %s", containerName, applyErrorMessage(err))
 		alertType = action.AlertTypeWarning
-		logger := internalLog.From(ctx)
 		logger.WithErr(err).Errorf("update container")
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
@@ -87,37 +99,26 @@ func (e *ContainerEditor) Handle(ctx context.Context, alerter action.Alerter, pa
 	return nil
 }
 
-func updateContainer(containersPath []string, logger log.Logger, containerName string, containerImage string) func(object *unstructured.Unstructured) error {
-	return func(object *unstructured.Unstructured) error {
-		containersRaw, found, err := unstructured.NestedSlice(object.Object, containersPath...)
-		if err != nil {
-			return err
-		}
-
-		if !found {
-			logger.Warnf("unable to find containers within object")
-			return nil
-		}
-
-		var updatedContainers []interface{}
-
-		for _, containerRaw := range containersRaw {
-			container, ok := containerRaw.(map[string]interface{})
-			if !ok {
-				return errors.New("unable to parse containers format")
-			}
-			name, found, err := unstructured.NestedString(container, "name")
-			if err != nil {
-				return errors.Wrap(err, "looking for container name")
-			}
-
-			if found && name == containerName {
-				container["image"] = containerImage
-			}
-
-			updatedContainers = append(updatedContainers, container)
-		}
-
-		return unstructured.SetNestedSlice(object.Object, updatedContainers, containersPath...)
+// containerApplyObject creates an object for a server-side apply which sets the
+// image of a container. Containers are merged by name, so other containers and
+// fields of the container are not changed.
+func containerApplyObject(key store.Key, containersPath []string, containerName string, containerImage string) (*unstructured.Unstructured, error) {
+	if len(containersPath) == 0 {
+		return nil, errors.New("containers path is blank")
+	}
+
+	object := newApplyObject(key)
+
+	containers := []interface{}{
+		map[string]interface{}{
+			"name":  containerName,
+			"image": containerImage,
+		},
+	}
+
+	if err := unstructured.SetNestedSlice(object.Object, containers, containersPath...); err != nil {
+		return nil, errors.Wrap(err, "set containers")
 	}
+
+	return object, nil
 }
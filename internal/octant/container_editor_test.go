@@ -14,8 +14,6 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
-	"github.com/vmware-tanzu/octant/internal/log"
-	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -26,19 +24,30 @@ func TestNewContainerEditor(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
-	objectStore := fake.NewMockStore(controller)
+	applier := fake.NewMockApplier(controller)
+	objectStore := applierStore{Store: fake.NewMockStore(controller), Applier: applier}
 
 	alerter := actionFake.NewMockAlerter(controller)
 
-	key := store.Key{
-		Namespace:  "default",
-		APIVersion: "apps/v1",
-		Kind:       "Deployment",
-		Name:       "deployment",
-	}
+	expectedObject := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+			"name":      "deployment",
+		},
+		"foo": map[string]interface{}{
+			"bar": []interface{}{
+				map[string]interface{}{
+					"name":  "nginx",
+					"image": "nginx:stable",
+				},
+			},
+		},
+	}}
 
-	objectStore.EXPECT().
-		Update(gomock.Any(), key, gomock.Any()).
+	applier.EXPECT().
+		Apply(gomock.Any(), expectedObject, store.ApplyOptions{FieldManager: "octant-container-editor-nginx"}).
 		Return(nil)
 
 	alerter.EXPECT().
@@ -66,31 +75,36 @@ func TestNewContainerEditor(t *testing.T) {
 	require.NoError(t, editor.Handle(ctx, alerter, payload))
 }
 
-func Test_updateContainer(t *testing.T) {
-	deployment := testutil.ToUnstructured(
-		t,
-		testutil.CreateDeployment("deployment", testutil.WithGenericDeployment()))
+func Test_containerApplyObject(t *testing.T) {
+	key := store.Key{
+		Namespace:  "default",
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "deployment",
+	}
 
 	containersPath := []string{"spec", "template", "spec", "containers"}
-	containerName := "container-name"
-	containerImage := "new-image"
 
-	fn := updateContainer(containersPath, log.NopLogger(), containerName, containerImage)
-
-	require.NoError(t, fn(deployment))
+	got, err := containerApplyObject(key, containersPath, "container-name", "new-image")
+	require.NoError(t, err)
 
-	got, found, err := unstructured.NestedSlice(deployment.Object, containersPath...)
+	assert.Equal(t, "apps/v1", got.GetAPIVersion())
+	assert.Equal(t, "Deployment", got.GetKind())
+	assert.Equal(t, "default", got.GetNamespace())
+	assert.Equal(t, "deployment", got.GetName())
 
+	containers, found, err := unstructured.NestedSlice(got.Object, containersPath...)
 	require.NoError(t, err)
 	require.True(t, found)
 
 	expected := []interface{}{
 		map[string]interface{}{
-			"image":     "new-image",
-			"name":      "container-name",
-			"resources": map[string]interface{}{},
+			"image": "new-image",
+			"name":  "container-name",
 		},
 	}
-	require.Equal(t, expected, got)
+	require.Equal(t, expected, containers)
 
+	_, err = containerApplyObject(key, nil, "container-name", "new-image")
+	require.Error(t, err)
 }
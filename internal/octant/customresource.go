@@ -26,6 +26,8 @@ type CustomResourceDefinitionPrinterColumn struct {
 type CustomResourceDefinitionVersion struct {
 	Version        string
 	PrinterColumns []CustomResourceDefinitionPrinterColumn
+	// Scalable is true if the version enables the scale subresource.
+	Scalable bool
 }
 
 type CustomResourceDefinition struct {
@@ -97,9 +99,15 @@ func (crd *CustomResourceDefinition) v1Version(version string) (CustomResourceDe
 			return CustomResourceDefinitionVersion{}, fmt.Errorf("collect CRD printer columns: %w", err)
 		}
 
+		_, scalable, err := unstructured.NestedMap(versions[i], "subresources", "scale")
+		if err != nil {
+			return CustomResourceDefinitionVersion{}, fmt.Errorf("unable to read crd version subresources: %w", err)
+		}
+
 		customResourceDefinitionVersion := CustomResourceDefinitionVersion{
 			Version:        name,
 			PrinterColumns: columns,
+			Scalable:       scalable,
 		}
 		return customResourceDefinitionVersion, nil
 	}
@@ -118,9 +126,15 @@ func (crd *CustomResourceDefinition) v1beta1Version(version string) (CustomResou
 		return CustomResourceDefinitionVersion{}, fmt.Errorf("collect CRD printer columns: %w", err)
 	}
 
+	_, scalable, err := unstructured.NestedMap(crd.object.Object, "spec", "subresources", "scale")
+	if err != nil {
+		return CustomResourceDefinitionVersion{}, fmt.Errorf("unable to read crd .spec.subresources: %w", err)
+	}
+
 	customResourceDefinitionVersion := CustomResourceDefinitionVersion{
 		Version:        version,
 		PrinterColumns: columns,
+		Scalable:       scalable,
 	}
 	return customResourceDefinitionVersion, nil
 
@@ -110,6 +110,16 @@ func TestCustomResourceDefinition_Version(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "v1 with scale subresource",
+			object:  testutil.LoadUnstructuredFromFile(t, "crd-v1-scale.yaml"),
+			version: "v1",
+			want: octant.CustomResourceDefinitionVersion{
+				Version:        "v1",
+				PrinterColumns: []octant.CustomResourceDefinitionPrinterColumn{},
+				Scalable:       true,
+			},
+		},
 		{
 			name:   "v1beta1",
 			object: testutil.LoadUnstructuredFromFile(t, "crd-v1beta1.yaml"),
@@ -0,0 +1,526 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/watch"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// DefaultDebugImage is the image used for debug containers when one isn't chosen.
+	DefaultDebugImage = "busybox"
+
+	// NodeDebuggerLabel is the label set on node debugger pods. Its value is
+	// always "true" since node names can be longer than label values.
+	NodeDebuggerLabel = "octant.dev/node-debugger"
+
+	// NodeDebuggerNodeAnnotation is the annotation set on node debugger pods.
+	// Its value is the node name.
+	NodeDebuggerNodeAnnotation = "octant.dev/node-debugger-node"
+
+	// NodeDebuggerContainer is the name of the container in a node debugger pod.
+	NodeDebuggerContainer = "debugger"
+
+	// NodeDebuggerTimeout is how long a node debugger pod runs for. The pod
+	// is deleted a few minutes after its last terminal session ends, or after
+	// the timeout if a session is never opened.
+	NodeDebuggerTimeout = time.Hour
+
+	// debuggerStartTimeout is how long to wait for a debug container or node
+	// debugger pod to start before showing its terminal.
+	debuggerStartTimeout = time.Minute
+
+	debugContainerPrefix = "debugger-"
+	nodeDebuggerHostPath = "/host"
+	nodeDebuggerPrefix   = "node-debugger-"
+
+	// maxPodNameLength is the longest name a pod can have.
+	maxPodNameLength = 253
+)
+
+// DebugContainer adds an ephemeral debug container to a pod.
+type DebugContainer struct {
+	clusterClient cluster.ClientInterface
+	pathLookup    PathLookupFunc
+}
+
+var _ action.Dispatcher = (*DebugContainer)(nil)
+
+// NewDebugContainer creates an instance of DebugContainer. The path lookup is
+// used to show the terminal of the debug container.
+func NewDebugContainer(clusterClient cluster.ClientInterface, pathLookup PathLookupFunc) *DebugContainer {
+	return &DebugContainer{
+		clusterClient: clusterClient,
+		pathLookup:    pathLookup,
+	}
+}
+
+// ActionName returns the name of this action.
+func (d *DebugContainer) ActionName() string {
+	return ActionDebugContainer
+}
+
+// Handle adds an ephemeral container to the pod in the payload, and shows its
+// terminal once it starts. The payload contains the image and optionally a
+// container whose process namespace is shared.
+func (d *DebugContainer) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	image, err := debugImage(payload)
+	if err != nil {
+		return err
+	}
+
+	targetContainer, err := payload.OptionalString("targetContainer")
+	if err != nil {
+		return err
+	}
+
+	message := ""
+	alertType := action.AlertTypeInfo
+	name, err := d.Debug(ctx, key.Namespace, key.Name, image, targetContainer)
+	if err != nil {
+		message = fmt.Sprintf("Unable to debug pod %q: %s", key.Name, err)
+		alertType = action.AlertTypeWarning
+		logger.WithErr(err).Errorf("debug pod")
+	} else if sender, ok := alerter.(EventSender); ok && d.pathLookup != nil {
+		message = fmt.Sprintf("Added debug container %q to pod %q. Its terminal opens once it starts.", name, key.Name)
+		go d.showTerminal(sender, key.Namespace, key.Name, name)
+	} else {
+		message = fmt.Sprintf("Added debug container %q to pod %q. Open the Terminal tab to use it.", name, key.Name)
+	}
+
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+	return nil
+}
+
+// Debug adds an ephemeral container running image to a pod, and returns the
+// container's name.
+func (d *DebugContainer) Debug(ctx context.Context, namespace, podName, image, targetContainer string) (string, error) {
+	client, err := d.clusterClient.KubernetesClient()
+	if err != nil {
+		return "", err
+	}
+
+	pods := client.CoreV1().Pods(namespace)
+
+	pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to find pod %q", podName)
+	}
+
+	if targetContainer != "" && !hasContainer(pod, targetContainer) {
+		return "", errors.Errorf("pod %q does not have container %q", podName, targetContainer)
+	}
+
+	name := debugContainerName(pod)
+
+	ephemeralContainers := &corev1.EphemeralContainers{
+		ObjectMeta:          metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+		EphemeralContainers: append([]corev1.EphemeralContainer{}, pod.Spec.EphemeralContainers...),
+	}
+	ephemeralContainers.EphemeralContainers = append(ephemeralContainers.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    image,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		},
+		TargetContainerName: targetContainer,
+	})
+
+	_, err = pods.UpdateEphemeralContainers(ctx, pod.Name, ephemeralContainers, metav1.UpdateOptions{})
+	if kerrors.IsBadRequest(err) {
+		// Kubernetes 1.22 and later reject the EphemeralContainers kind, and
+		// take a patch of the pod instead.
+		err = patchEphemeralContainers(ctx, pods, pod.Name, ephemeralContainers.EphemeralContainers)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "add ephemeral container")
+	}
+
+	return name, nil
+}
+
+// showTerminal navigates the client to the terminal of a pod once its debug
+// container is running. The terminal selects the newest running debug
+// container.
+func (d *DebugContainer) showTerminal(sender EventSender, namespace, podName, containerName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), debuggerStartTimeout)
+	defer cancel()
+
+	logger := log.From(ctx).With("pod", podName, "debugContainer", containerName)
+
+	err := waitForPod(ctx, d.clusterClient, namespace, podName, func(pod *corev1.Pod) bool {
+		for _, status := range pod.Status.EphemeralContainerStatuses {
+			if status.Name == containerName && status.State.Running != nil {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		logger.WithErr(err).Errorf("debug container did not start")
+		return
+	}
+
+	if err := sendTerminalEvent(sender, d.pathLookup, namespace, podName); err != nil {
+		logger.WithErr(err).Errorf("find debug container pod path")
+	}
+}
+
+// patchEphemeralContainers sets a pod's ephemeral containers with a patch of
+// the ephemeralcontainers subresource.
+func patchEphemeralContainers(ctx context.Context, pods corev1client.PodInterface, podName string, ephemeralContainers []corev1.EphemeralContainer) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"ephemeralContainers": ephemeralContainers,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = pods.Patch(ctx, podName, types.StrategicMergePatchType, patch, metav1.PatchOptions{}, "ephemeralcontainers")
+	return err
+}
+
+// DebugNode creates a privileged pod on a node for debugging the node.
+type DebugNode struct {
+	clusterClient cluster.ClientInterface
+	pathLookup    PathLookupFunc
+	timeout       time.Duration
+}
+
+var _ action.Dispatcher = (*DebugNode)(nil)
+
+// DebugNodeOption is an option for DebugNode.
+type DebugNodeOption func(d *DebugNode)
+
+// WithNodeDebuggerTimeout sets how long node debugger pods run for.
+func WithNodeDebuggerTimeout(timeout time.Duration) DebugNodeOption {
+	return func(d *DebugNode) {
+		d.timeout = timeout
+	}
+}
+
+// NewDebugNode creates an instance of DebugNode. The path lookup is used to
+// show the terminal of the node debugger pod.
+func NewDebugNode(clusterClient cluster.ClientInterface, pathLookup PathLookupFunc, options ...DebugNodeOption) *DebugNode {
+	d := &DebugNode{
+		clusterClient: clusterClient,
+		pathLookup:    pathLookup,
+		timeout:       NodeDebuggerTimeout,
+	}
+
+	for _, option := range options {
+		option(d)
+	}
+
+	return d
+}
+
+// ActionName returns the name of this action.
+func (d *DebugNode) ActionName() string {
+	return ActionDebugNode
+}
+
+// Handle creates a node debugger pod for the node in the payload, and shows
+// its terminal once it starts. The payload contains the image and optionally
+// the namespace for the pod.
+func (d *DebugNode) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", d.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	image, err := debugImage(payload)
+	if err != nil {
+		return err
+	}
+
+	namespace, err := payload.OptionalString("debugNamespace")
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	message := ""
+	alertType := action.AlertTypeInfo
+	pod, err := d.Debug(ctx, key.Name, namespace, image)
+	if err != nil {
+		message = fmt.Sprintf("Unable to debug node %q: %s", key.Name, err)
+		alertType = action.AlertTypeWarning
+		logger.WithErr(err).Errorf("debug node")
+	} else if sender, ok := alerter.(EventSender); ok && d.pathLookup != nil {
+		message = fmt.Sprintf("Created debug pod %q in namespace %q. Its terminal opens once it starts; the pod is deleted a few minutes after the session ends, or after %s.",
+			pod.Name, pod.Namespace, d.timeout)
+		go d.showTerminal(sender, pod)
+	} else {
+		message = fmt.Sprintf("Created debug pod %q in namespace %q. Open its Terminal tab for a shell; the pod is deleted a few minutes after the session ends, or after %s.",
+			pod.Name, pod.Namespace, d.timeout)
+	}
+
+	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
+	alerter.SendAlert(alert)
+	return nil
+}
+
+// Debug creates a node debugger pod in namespace which runs image on the node.
+// The pod is stopped by its active deadline and deleted after the timeout.
+func (d *DebugNode) Debug(ctx context.Context, nodeName, namespace, image string) (*corev1.Pod, error) {
+	client, err := d.clusterClient.KubernetesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{}); err != nil {
+		return nil, errors.Wrapf(err, "unable to find node %q", nodeName)
+	}
+
+	pod := NodeDebuggerPod(nodeName, namespace, image, d.timeout)
+
+	pods := client.CoreV1().Pods(namespace)
+	created, err := pods.Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "create node debugger pod")
+	}
+
+	time.AfterFunc(d.timeout, func() {
+		err := pods.Delete(context.Background(), created.Name, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			log.From(ctx).WithErr(err).Errorf("unable to delete node debugger pod %q", created.Name)
+		}
+	})
+
+	return created, nil
+}
+
+// showTerminal navigates the client to the terminal of a node debugger pod
+// once the pod is running.
+func (d *DebugNode) showTerminal(sender EventSender, pod *corev1.Pod) {
+	ctx, cancel := context.WithTimeout(context.Background(), debuggerStartTimeout)
+	defer cancel()
+
+	logger := log.From(ctx).With("nodeDebugger", pod.Name)
+
+	err := waitForPod(ctx, d.clusterClient, pod.Namespace, pod.Name, func(pod *corev1.Pod) bool {
+		return pod.Status.Phase == corev1.PodRunning
+	})
+	if err != nil {
+		logger.WithErr(err).Errorf("node debugger pod did not start")
+		return
+	}
+
+	if err := sendTerminalEvent(sender, d.pathLookup, pod.Namespace, pod.Name); err != nil {
+		logger.WithErr(err).Errorf("find node debugger pod path")
+	}
+}
+
+// sendTerminalEvent navigates the client to the terminal tab of a pod.
+func sendTerminalEvent(sender EventSender, pathLookup PathLookupFunc, namespace, podName string) error {
+	contentPath, err := pathLookup(namespace, "v1", "Pod", podName)
+	if err != nil {
+		return err
+	}
+
+	sender.SendEvent(Event{
+		Type: EventTypeContentPath,
+		Data: action.Payload{
+			"contentPath": contentPath,
+			"fragment":    "terminal",
+		},
+	})
+
+	return nil
+}
+
+// waitForPod waits until ready returns true for a pod.
+func waitForPod(ctx context.Context, clusterClient cluster.ClientInterface, namespace, name string, ready func(pod *corev1.Pod) bool) error {
+	client, err := clusterClient.KubernetesClient()
+	if err != nil {
+		return err
+	}
+
+	w, err := client.CoreV1().Pods(namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String(),
+	})
+	if err != nil {
+		return err
+	}
+	defer w.Stop()
+
+	current, err := client.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ready(current) {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return errors.New("watch closed")
+			}
+			current, ok := event.Object.(*corev1.Pod)
+			if !ok || current.Name != name {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				return errors.New("pod was deleted")
+			}
+			if ready(current) {
+				return nil
+			}
+		}
+	}
+}
+
+// NodeDebuggerPod returns a privileged pod pinned to a node. It shares the
+// node's process, network and IPC namespaces, and mounts the node's root
+// filesystem at /host. The pod is stopped once it has run for the timeout.
+func NodeDebuggerPod(nodeName, namespace, image string, timeout time.Duration) *corev1.Pod {
+	privileged := true
+	activeDeadlineSeconds := int64(timeout.Seconds())
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeDebuggerPodName(nodeName),
+			Namespace: namespace,
+			Labels: map[string]string{
+				NodeDebuggerLabel: "true",
+			},
+			Annotations: map[string]string{
+				NodeDebuggerNodeAnnotation: nodeName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:              nodeName,
+			HostPID:               true,
+			HostNetwork:           true,
+			HostIPC:               true,
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			Containers: []corev1.Container{
+				{
+					Name:            NodeDebuggerContainer,
+					Image:           image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Stdin:           true,
+					TTY:             true,
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: &privileged,
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "host-root", MountPath: nodeDebuggerHostPath},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "host-root",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+					},
+				},
+			},
+			Tolerations: []corev1.Toleration{
+				{Operator: corev1.TolerationOpExists},
+			},
+		},
+	}
+}
+
+// nodeDebuggerPodName returns a name for a node debugger pod. The node name
+// is shortened if the pod name would be too long.
+func nodeDebuggerPodName(nodeName string) string {
+	suffix := "-" + rand.String(5)
+	if max := maxPodNameLength - len(nodeDebuggerPrefix) - len(suffix); len(nodeName) > max {
+		nodeName = strings.TrimRight(nodeName[:max], ".-")
+	}
+
+	return nodeDebuggerPrefix + nodeName + suffix
+}
+
+func debugImage(payload action.Payload) (string, error) {
+	image, err := payload.OptionalString("image")
+	if err != nil {
+		return "", err
+	}
+	if image == "" {
+		image = DefaultDebugImage
+	}
+
+	return image, nil
+}
+
+func hasContainer(pod *corev1.Pod, name string) bool {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// debugContainerName returns a name for a debug container which isn't used in the pod.
+func debugContainerName(pod *corev1.Pod) string {
+	used := map[string]bool{}
+	for _, c := range pod.Spec.Containers {
+		used[c.Name] = true
+	}
+	for _, c := range pod.Spec.InitContainers {
+		used[c.Name] = true
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		used[c.Name] = true
+	}
+
+	for {
+		name := debugContainerPrefix + rand.String(5)
+		if !used[name] {
+			return name
+		}
+	}
+}
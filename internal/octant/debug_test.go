@@ -0,0 +1,403 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testClient "k8s.io/client-go/kubernetes/fake"
+	clientTesting "k8s.io/client-go/testing"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+func Test_DebugContainer(t *testing.T) {
+	cases := []struct {
+		name            string
+		targetContainer string
+		image           string
+		expectedImage   string
+		alertType       action.AlertType
+		message         string
+	}{
+		{
+			name:            "with target container",
+			targetContainer: "app",
+			image:           "alpine",
+			expectedImage:   "alpine",
+			alertType:       action.AlertTypeInfo,
+			message:         `Added debug container "debugger-`,
+		},
+		{
+			name:          "default image",
+			expectedImage: octant.DefaultDebugImage,
+			alertType:     action.AlertTypeInfo,
+			message:       `Added debug container "debugger-`,
+		},
+		{
+			name:            "unknown target container",
+			targetContainer: "missing",
+			alertType:       action.AlertTypeWarning,
+			message:         `Unable to debug pod "pod": pod "pod" does not have container "missing"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+
+			pod := testutil.CreatePod("pod")
+			pod.Spec.Containers = []corev1.Container{{Name: "app"}}
+
+			fakeClientset := testClient.NewSimpleClientset(pod)
+
+			var updated *corev1.EphemeralContainers
+			fakeClientset.PrependReactor("update", "pods", func(a clientTesting.Action) (bool, runtime.Object, error) {
+				update, ok := a.(clientTesting.UpdateAction)
+				if !ok || update.GetSubresource() != "ephemeralcontainers" {
+					return false, nil, nil
+				}
+				updated = update.GetObject().(*corev1.EphemeralContainers)
+				return true, updated, nil
+			})
+
+			kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+			kubernetesClient.EXPECT().CoreV1().AnyTimes().Return(fakeClientset.CoreV1())
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, tc.alertType, alert.Type)
+					assert.True(t, strings.HasPrefix(alert.Message, tc.message), alert.Message)
+				})
+
+			debugContainer := octant.NewDebugContainer(clusterClient, nil)
+			assert.Equal(t, octant.ActionDebugContainer, debugContainer.ActionName())
+
+			payload := action.CreatePayload(octant.ActionDebugContainer, map[string]interface{}{
+				"apiVersion":      "v1",
+				"kind":            "Pod",
+				"namespace":       pod.Namespace,
+				"name":            pod.Name,
+				"image":           tc.image,
+				"targetContainer": tc.targetContainer,
+			})
+
+			require.NoError(t, debugContainer.Handle(ctx, alerter, payload))
+
+			if tc.alertType == action.AlertTypeWarning {
+				assert.Nil(t, updated)
+				return
+			}
+
+			require.NotNil(t, updated)
+			require.Len(t, updated.EphemeralContainers, 1)
+
+			ec := updated.EphemeralContainers[0]
+			assert.True(t, strings.HasPrefix(ec.Name, "debugger-"))
+			assert.Equal(t, tc.expectedImage, ec.Image)
+			assert.Equal(t, tc.targetContainer, ec.TargetContainerName)
+			assert.True(t, ec.Stdin)
+			assert.True(t, ec.TTY)
+		})
+	}
+}
+
+func Test_DebugContainer_patch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{{Name: "app"}}
+
+	fakeClientset := testClient.NewSimpleClientset(pod)
+	fakeClientset.PrependReactor("update", "pods", func(a clientTesting.Action) (bool, runtime.Object, error) {
+		if a.GetSubresource() != "ephemeralcontainers" {
+			return false, nil, nil
+		}
+		return true, nil, kerrors.NewBadRequest("the EphemeralContainers kind is not supported")
+	})
+
+	var patch []byte
+	fakeClientset.PrependReactor("patch", "pods", func(a clientTesting.Action) (bool, runtime.Object, error) {
+		patchAction, ok := a.(clientTesting.PatchAction)
+		if !ok || patchAction.GetSubresource() != "ephemeralcontainers" {
+			return false, nil, nil
+		}
+		patch = patchAction.GetPatch()
+		return true, pod, nil
+	})
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	kubernetesClient.EXPECT().CoreV1().AnyTimes().Return(fakeClientset.CoreV1())
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
+
+	debugContainer := octant.NewDebugContainer(clusterClient, nil)
+	name, err := debugContainer.Debug(ctx, pod.Namespace, pod.Name, "alpine", "app")
+	require.NoError(t, err)
+
+	assert.Contains(t, string(patch), `"ephemeralContainers":[{"name":"`+name+`"`)
+	assert.Contains(t, string(patch), `"targetContainerName":"app"`)
+}
+
+func Test_DebugContainer_showTerminal(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{{Name: "app"}}
+
+	fakeClientset := testClient.NewSimpleClientset(pod)
+
+	names := make(chan string, 1)
+	fakeClientset.PrependReactor("update", "pods", func(a clientTesting.Action) (bool, runtime.Object, error) {
+		update, ok := a.(clientTesting.UpdateAction)
+		if !ok || update.GetSubresource() != "ephemeralcontainers" {
+			return false, nil, nil
+		}
+		updated := update.GetObject().(*corev1.EphemeralContainers)
+		names <- updated.EphemeralContainers[len(updated.EphemeralContainers)-1].Name
+		return true, updated, nil
+	})
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	kubernetesClient.EXPECT().CoreV1().AnyTimes().Return(fakeClientset.CoreV1())
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
+
+	alerter := &eventAlerter{
+		MockAlerter: actionFake.NewMockAlerter(controller),
+		events:      make(chan octant.Event, 1),
+	}
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Contains(t, alert.Message, "Its terminal opens once it starts")
+		})
+
+	pathLookup := func(namespace, apiVersion, kind, name string) (string, error) {
+		return "/overview/namespace/" + namespace + "/workloads/pods/" + name, nil
+	}
+
+	debugContainer := octant.NewDebugContainer(clusterClient, pathLookup)
+
+	payload := action.CreatePayload(octant.ActionDebugContainer, map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"namespace":  pod.Namespace,
+		"name":       pod.Name,
+	})
+	require.NoError(t, debugContainer.Handle(ctx, alerter, payload))
+
+	setRunning := func(name string) {
+		current := pod.DeepCopy()
+		current.Status.EphemeralContainerStatuses = []corev1.ContainerStatus{
+			{Name: name, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		}
+		_, err := fakeClientset.CoreV1().Pods(pod.Namespace).UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	setRunning("other")
+	select {
+	case <-alerter.events:
+		t.Fatal("terminal was shown before the debug container started")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	setRunning(<-names)
+	select {
+	case event := <-alerter.events:
+		assert.Equal(t, octant.EventTypeContentPath, event.Type)
+		assert.Equal(t, action.Payload{
+			"contentPath": "/overview/namespace/" + pod.Namespace + "/workloads/pods/pod",
+			"fragment":    "terminal",
+		}, event.Data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("terminal was not shown")
+	}
+}
+
+func Test_NodeDebuggerPod_longNodeName(t *testing.T) {
+	nodeName := strings.Repeat("a", 253)
+	pod := octant.NodeDebuggerPod(nodeName, "default", "busybox", time.Minute)
+
+	assert.Len(t, pod.Name, 253)
+	assert.True(t, strings.HasPrefix(pod.Name, "node-debugger-aaa"))
+	assert.Equal(t, "true", pod.Labels[octant.NodeDebuggerLabel])
+	assert.Equal(t, nodeName, pod.Annotations[octant.NodeDebuggerNodeAnnotation])
+	assert.Equal(t, nodeName, pod.Spec.NodeName)
+}
+
+func Test_DebugNode(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	node := testutil.CreateNode("node")
+	fakeClientset := testClient.NewSimpleClientset(node)
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	kubernetesClient.EXPECT().CoreV1().AnyTimes().Return(fakeClientset.CoreV1())
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Contains(t, alert.Message, `in namespace "debug"`)
+		})
+
+	timeout := 100 * time.Millisecond
+	debugNode := octant.NewDebugNode(clusterClient, nil, octant.WithNodeDebuggerTimeout(timeout))
+	assert.Equal(t, octant.ActionDebugNode, debugNode.ActionName())
+
+	payload := action.CreatePayload(octant.ActionDebugNode, map[string]interface{}{
+		"apiVersion":     "v1",
+		"kind":           "Node",
+		"name":           "node",
+		"image":          "alpine",
+		"debugNamespace": "debug",
+	})
+
+	require.NoError(t, debugNode.Handle(ctx, alerter, payload))
+
+	pods, err := fakeClientset.CoreV1().Pods("debug").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+
+	pod := pods.Items[0]
+	assert.True(t, strings.HasPrefix(pod.Name, "node-debugger-node-"))
+	assert.Equal(t, "true", pod.Labels[octant.NodeDebuggerLabel])
+	assert.Equal(t, "node", pod.Annotations[octant.NodeDebuggerNodeAnnotation])
+	assert.Equal(t, "node", pod.Spec.NodeName)
+	assert.True(t, pod.Spec.HostPID)
+	require.Len(t, pod.Spec.Containers, 1)
+	assert.Equal(t, "alpine", pod.Spec.Containers[0].Image)
+	assert.True(t, *pod.Spec.Containers[0].SecurityContext.Privileged)
+	require.NotNil(t, pod.Spec.ActiveDeadlineSeconds)
+	assert.Equal(t, int64(timeout.Seconds()), *pod.Spec.ActiveDeadlineSeconds)
+
+	assert.Eventually(t, func() bool {
+		pods, err := fakeClientset.CoreV1().Pods("debug").List(ctx, metav1.ListOptions{})
+		return err == nil && len(pods.Items) == 0
+	}, 5*time.Second, 10*time.Millisecond, "node debugger pod was not deleted after the timeout")
+}
+
+type eventAlerter struct {
+	*actionFake.MockAlerter
+	events chan octant.Event
+}
+
+func (a *eventAlerter) SendEvent(event octant.Event) {
+	a.events <- event
+}
+
+func Test_DebugNode_showTerminal(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	ctx := context.Background()
+
+	node := testutil.CreateNode("node")
+	fakeClientset := testClient.NewSimpleClientset(node)
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	kubernetesClient.EXPECT().CoreV1().AnyTimes().Return(fakeClientset.CoreV1())
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
+
+	alerter := &eventAlerter{
+		MockAlerter: actionFake.NewMockAlerter(controller),
+		events:      make(chan octant.Event, 1),
+	}
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Contains(t, alert.Message, "Its terminal opens once it starts")
+		})
+
+	pathLookup := func(namespace, apiVersion, kind, name string) (string, error) {
+		return "/overview/namespace/" + namespace + "/workloads/pods/" + name, nil
+	}
+
+	debugNode := octant.NewDebugNode(clusterClient, pathLookup)
+
+	payload := action.CreatePayload(octant.ActionDebugNode, map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Node",
+		"name":       "node",
+		"image":      "alpine",
+	})
+
+	require.NoError(t, debugNode.Handle(ctx, alerter, payload))
+
+	pods, err := fakeClientset.CoreV1().Pods("default").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+
+	pod := pods.Items[0]
+	pod.Status.Phase = corev1.PodRunning
+	_, err = fakeClientset.CoreV1().Pods("default").UpdateStatus(ctx, &pod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case event := <-alerter.events:
+		assert.Equal(t, octant.EventTypeContentPath, event.Type)
+		assert.Equal(t, action.Payload{
+			"contentPath": "/overview/namespace/default/workloads/pods/" + pod.Name,
+			"fragment":    "terminal",
+		}, event.Data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("terminal was not shown")
+	}
+}
+
+func Test_DebugNode_missingNode(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	fakeClientset := testClient.NewSimpleClientset()
+
+	kubernetesClient := clusterFake.NewMockKubernetesInterface(controller)
+	kubernetesClient.EXPECT().CoreV1().AnyTimes().Return(fakeClientset.CoreV1())
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	clusterClient.EXPECT().KubernetesClient().AnyTimes().Return(kubernetesClient, nil)
+
+	debugNode := octant.NewDebugNode(clusterClient, nil)
+	_, err := debugNode.Debug(context.Background(), "missing", "default", "busybox")
+	require.Error(t, err)
+}
@@ -37,8 +37,10 @@ func (e *DeploymentConfigurationEditor) ActionName() string {
 	return "action.octant.dev/deploymentConfiguration"
 }
 
-// Handle edits a deployment. Supported edits:
+// Handle edits a deployment with a server-side apply. Supported edits:
 //   * replicas
+// Fields owned by other managers, e.g. replicas managed by a
+// HorizontalPodAutoscaler, are reported as conflicts unless force is set.
 func (e *DeploymentConfigurationEditor) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
 	e.logger.
 		With("payload", payload, "actionName", e.ActionName()).
@@ -60,15 +62,27 @@ func (e *DeploymentConfigurationEditor) Handle(ctx context.Context, alerter acti
 		return err
 	}
 
-	fn := func(object *unstructured.Unstructured) error {
-		return unstructured.SetNestedField(object.Object, replicaCount, "spec", "replicas")
+	force, err := payload.OptionalBool("force")
+	if err != nil {
+		return err
+	}
+
+	object := newApplyObject(key)
+	if err := unstructured.SetNestedField(object.Object, replicaCount, "spec", "replicas"); err != nil {
+		return err
+	}
+
+	applier, err := storeApplier(e.store)
+	if err != nil {
+		return err
 	}
 
+	options := store.ApplyOptions{FieldManager: deploymentEditorFieldManager, Force: force}
 	alertType := action.AlertTypeInfo
 	message := fmt.Sprintf("Updated Deployment %q", name)
-	if err := e.store.Update(ctx, key, fn); err != nil {
+	if err := applier.Apply(ctx, object, options); err != nil {
 		alertType = action.AlertTypeWarning
-		message = fmt.Sprintf("Unable to update Deployment %q: %s", name, err)
+		message = fmt.Sprintf("Unable to update Deployment %q: %s", name, applyErrorMessage(err))
 	}
 	alert := action.CreateAlert(alertType, message, action.DefaultAlertExpiration)
 	alerter.SendAlert(alert)
@@ -32,7 +32,8 @@ func TestDeploymentConfigurationEditor(t *testing.T) {
 	deployment := testutil.CreateDeployment("deployment")
 	deployment.Namespace = "default"
 
-	objectStore := fake.NewMockStore(controller)
+	applier := fake.NewMockApplier(controller)
+	objectStore := applierStore{Store: fake.NewMockStore(controller), Applier: applier}
 	alerter := actionFake.NewMockAlerter(controller)
 
 	key, err := store.KeyFromObject(deployment)
@@ -41,9 +42,16 @@ func TestDeploymentConfigurationEditor(t *testing.T) {
 	updatedDeployment := deployment.DeepCopy()
 	updatedDeployment.Spec.Replicas = pointer.Int32Ptr(5)
 
-	objectStore.EXPECT().
-		Update(gomock.Any(), key, gomock.Any()).
-		DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+	applier.EXPECT().
+		Apply(gomock.Any(), gomock.Any(), store.ApplyOptions{FieldManager: deploymentEditorFieldManager}).
+		DoAndReturn(func(ctx context.Context, object *unstructured.Unstructured, options store.ApplyOptions) error {
+			objectKey, err := store.KeyFromObject(object)
+			require.NoError(t, err)
+			assert.Equal(t, key, objectKey)
+
+			replicas, _, err := unstructured.NestedInt64(object.Object, "spec", "replicas")
+			require.NoError(t, err)
+			assert.Equal(t, int64(5), replicas)
 			return nil
 		})
 
@@ -71,3 +79,65 @@ func TestDeploymentConfigurationEditor(t *testing.T) {
 	require.NoError(t, configurationEditor.Handle(ctx, alerter, payload))
 
 }
+
+func TestDeploymentConfigurationEditor_conflict(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	applier := fake.NewMockApplier(controller)
+	objectStore := applierStore{Store: fake.NewMockStore(controller), Applier: applier}
+	alerter := actionFake.NewMockAlerter(controller)
+
+	key := store.Key{Namespace: "default", APIVersion: "apps/v1", Kind: "Deployment", Name: "deployment"}
+
+	conflictErr := &store.ApplyConflictError{
+		Key: key,
+		Conflicts: []store.FieldConflict{
+			{Field: ".spec.replicas", Manager: "kube-controller-manager"},
+		},
+	}
+
+	applier.EXPECT().
+		Apply(gomock.Any(), gomock.Any(), store.ApplyOptions{FieldManager: deploymentEditorFieldManager}).
+		Return(conflictErr)
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, `Unable to update Deployment "deployment": apply Deployment deployment: field .spec.replicas is owned by kube-controller-manager. Edit again with Force checked to take ownership of these fields`, alert.Message)
+		})
+
+	configurationEditor := NewDeploymentConfigurationEditor(log.NopLogger(), objectStore)
+
+	payload := action.Payload{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"namespace":  "default",
+		"name":       "deployment",
+		"replicas":   "5",
+	}
+
+	require.NoError(t, configurationEditor.Handle(context.Background(), alerter, payload))
+}
+
+func TestDeploymentConfigurationEditor_applyUnsupported(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	configurationEditor := NewDeploymentConfigurationEditor(log.NopLogger(), objectStore)
+
+	payload := action.Payload{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"namespace":  "default",
+		"name":       "deployment",
+		"replicas":   "5",
+	}
+
+	err := configurationEditor.Handle(context.Background(), alerter, payload)
+	require.EqualError(t, err, "object store does not support server-side apply")
+}
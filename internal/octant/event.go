@@ -5,7 +5,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package octant
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type EventType string
 
@@ -55,6 +58,9 @@ const (
 	// EventTypeLoading is a loading event.
 	EventTypeLoading EventType = "event.octant.dev/loading"
 
+	// EventTypeExecCommandResult is an event with the result of a command run in a container.
+	EventTypeExecCommandResult EventType = "event.octant.dev/execCommandResult"
+
 	// EventTypeTerminalFormat is a string with format specifiers to assist in generating
 	// a terminal event type.
 	EventTypeTerminalFormat string = "event.octant.dev/terminals/namespace/%s/pod/%s/container/%s"
@@ -62,6 +68,14 @@ const (
 	// EventTypeLoggingFormat is a string with format specifiers to assist in generating
 	// a logging event type.
 	EventTypeLoggingFormat string = "event.octant.dev/logging/namespace/%s/pod/%s"
+
+	// EventTypeWorkloadLoggingFormat is a string with format specifiers to assist in generating
+	// a logging event type for all the pods of a workload.
+	EventTypeWorkloadLoggingFormat string = "event.octant.dev/logging/namespace/%s/%s/%s"
+
+	// EventTypeSelectorLoggingFormat is a string with format specifiers to assist in generating
+	// a logging event type for all the pods matching a label selector.
+	EventTypeSelectorLoggingFormat string = "event.octant.dev/logging/namespace/%s/selector/%s"
 )
 
 // NewTerminalEventType returns an event type for a specific terminal instance.
@@ -76,6 +90,16 @@ func NewLoggingEventType(namespace, pod string) EventType {
 	return EventType(fmt.Sprintf(EventTypeLoggingFormat, namespace, pod))
 }
 
+// NewWorkloadLoggingEventType returns an event type for the logs of all the pods of a workload.
+func NewWorkloadLoggingEventType(namespace, kind, name string) EventType {
+	return EventType(fmt.Sprintf(EventTypeWorkloadLoggingFormat, namespace, strings.ToLower(kind), name))
+}
+
+// NewSelectorLoggingEventType returns an event type for the logs of all the pods matching a label selector.
+func NewSelectorLoggingEventType(namespace, selector string) EventType {
+	return EventType(fmt.Sprintf(EventTypeSelectorLoggingFormat, namespace, selector))
+}
+
 // Event is an event for the dash frontend.
 type Event struct {
 	Type EventType   `json:"type"`
@@ -0,0 +1,355 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/exec"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/terminal"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+//go:generate mockgen -destination=./fake/mock_command_executor.go -package=fake github.com/vmware-tanzu/octant/internal/octant CommandExecutor
+
+const (
+	// DefaultExecCommandTimeout is how long a command runs when a timeout isn't set.
+	DefaultExecCommandTimeout = 30 * time.Second
+
+	// MaxExecCommandTimeout is the longest a command is allowed to run.
+	MaxExecCommandTimeout = 10 * time.Minute
+
+	// maxExecCommandOutput is the number of bytes of stdout and stderr which are kept.
+	maxExecCommandOutput = 1 << 20
+)
+
+// ExecCommandRequest is a command to run in a container.
+type ExecCommandRequest struct {
+	Namespace     string
+	PodName       string
+	ContainerName string
+	Command       []string
+	Timeout       time.Duration
+}
+
+// ExecCommandResult is the captured output of a command run in a container.
+type ExecCommandResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	TimedOut bool
+	// Truncated is true if stdout or stderr was longer than the captured output.
+	Truncated bool
+}
+
+// CommandExecutor runs non-interactive commands in containers.
+type CommandExecutor interface {
+	ExecCommand(ctx context.Context, req ExecCommandRequest) (ExecCommandResult, error)
+}
+
+// CommandRunner runs a command in a pod's container and writes its output
+// to stdout and stderr. It returns when the command exits or when ctx is done.
+type CommandRunner func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error
+
+// EventSender sends events to a client. Alerters which are also event senders
+// receive results from actions which have more to show than an alert.
+type EventSender interface {
+	SendEvent(event Event)
+}
+
+// ExecCommand runs a command in a container and captures its output and
+// exit code.
+type ExecCommand struct {
+	run CommandRunner
+}
+
+var _ action.Dispatcher = (*ExecCommand)(nil)
+var _ CommandExecutor = (*ExecCommand)(nil)
+
+// NewExecCommand creates an instance of ExecCommand.
+func NewExecCommand(clusterClient cluster.ClientInterface) *ExecCommand {
+	return NewExecCommandWithRunner(func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+		return terminal.Exec(ctx, clusterClient, key, container, command, nil, stdout, stderr)
+	})
+}
+
+// NewExecCommandWithRunner creates an instance of ExecCommand which runs
+// commands with run.
+func NewExecCommandWithRunner(run CommandRunner) *ExecCommand {
+	return &ExecCommand{
+		run: run,
+	}
+}
+
+// ActionName returns the name of this action.
+func (e *ExecCommand) ActionName() string {
+	return ActionExecCommand
+}
+
+// Handle runs the command in the payload. The result is sent to the client
+// as a code component if the alerter can send events, and as an alert if it
+// can't.
+func (e *ExecCommand) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	logger := log.From(ctx).With("actionName", e.ActionName())
+	logger.With("payload", payload).Infof("received action payload")
+
+	req, err := execCommandRequestFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	result, err := e.ExecCommand(ctx, req)
+	if err != nil {
+		logger.WithErr(err).Errorf("exec command")
+		message := fmt.Sprintf("Unable to run command in container %q: %s", req.ContainerName, err)
+		alerter.SendAlert(action.CreateAlert(action.AlertTypeError, message, action.DefaultAlertExpiration))
+		return nil
+	}
+
+	sender, ok := alerter.(EventSender)
+	if !ok {
+		alertType := action.AlertTypeInfo
+		if result.ExitCode != 0 || result.TimedOut {
+			alertType = action.AlertTypeWarning
+		}
+		alerter.SendAlert(action.CreateAlert(alertType, execCommandStatus(req, result), action.DefaultAlertExpiration))
+		return nil
+	}
+
+	sender.SendEvent(Event{
+		Type: EventTypeExecCommandResult,
+		Data: action.Payload{
+			"namespace":     req.Namespace,
+			"podName":       req.PodName,
+			"containerName": req.ContainerName,
+			"command":       req.Command,
+			"exitCode":      result.ExitCode,
+			"timedOut":      result.TimedOut,
+			"view":          ExecCommandResultComponent(req, result),
+		},
+	})
+
+	return nil
+}
+
+// ExecCommand runs a command and waits for it to exit or for its timeout.
+// A non-zero exit code is not an error. If the command times out, its stream
+// is closed and the output captured so far is returned. The command is left
+// to finish in the container.
+func (e *ExecCommand) ExecCommand(ctx context.Context, req ExecCommandRequest) (ExecCommandResult, error) {
+	if req.Namespace == "" || req.PodName == "" {
+		return ExecCommandResult{}, errors.New("namespace and pod name are required")
+	}
+	if len(req.Command) == 0 {
+		return ExecCommandResult{}, errors.New("command is required")
+	}
+
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = DefaultExecCommandTimeout
+	}
+	if timeout > MaxExecCommandTimeout {
+		timeout = MaxExecCommandTimeout
+	}
+
+	key := store.Key{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  req.Namespace,
+		Name:       req.PodName,
+	}
+
+	// The runner is stopped once its output is closed.
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	stdout := &limitedBuffer{limit: maxExecCommandOutput}
+	stderr := &limitedBuffer{limit: maxExecCommandOutput}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- e.run(runCtx, key, req.ContainerName, req.Command, stdout, stderr)
+	}()
+
+	var result ExecCommandResult
+
+	select {
+	case err := <-done:
+		if err != nil {
+			exitErr, ok := err.(exec.ExitError)
+			if !ok {
+				return ExecCommandResult{}, err
+			}
+			result.ExitCode = exitErr.ExitStatus()
+		}
+	case <-ctx.Done():
+		result.TimedOut = true
+		result.ExitCode = -1
+	}
+
+	// Output written after the command stops isn't kept, and fails the
+	// runner's copy from the stream if it is still running.
+	stdout.close()
+	stderr.close()
+	stop()
+
+	result.Stdout = stdout.String()
+	result.Stderr = stderr.String()
+	result.Truncated = stdout.truncated() || stderr.truncated()
+
+	return result, nil
+}
+
+// ExecCommandResultComponent creates a code component with a command's
+// output. Its title is the command's exit status.
+func ExecCommandResultComponent(req ExecCommandRequest, result ExecCommandResult) *component.Code {
+	var sb strings.Builder
+	sb.WriteString(result.Stdout)
+	if result.Stderr != "" {
+		if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "\n") {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(result.Stderr)
+	}
+	if result.Truncated {
+		sb.WriteString("\n(output truncated)")
+	}
+
+	code := component.NewCodeBlock(sb.String())
+	code.Metadata.SetTitleText(execCommandStatus(req, result))
+	return code
+}
+
+func execCommandStatus(req ExecCommandRequest, result ExecCommandResult) string {
+	command := strings.Join(req.Command, " ")
+	if result.TimedOut {
+		return fmt.Sprintf("%s timed out", command)
+	}
+
+	return fmt.Sprintf("%s exited with status %d", command, result.ExitCode)
+}
+
+// execCommandRequestFromPayload creates a request from a payload. The command
+// is either a list of arguments or a string which is run with /bin/sh. The
+// timeout is in seconds.
+func execCommandRequestFromPayload(payload action.Payload) (ExecCommandRequest, error) {
+	namespace, err := payload.String("namespace")
+	if err != nil {
+		return ExecCommandRequest{}, err
+	}
+
+	podName, err := payload.String("podName")
+	if err != nil {
+		return ExecCommandRequest{}, err
+	}
+
+	containerName, err := payload.OptionalString("containerName")
+	if err != nil {
+		return ExecCommandRequest{}, err
+	}
+
+	var command []string
+	switch payload["command"].(type) {
+	case string:
+		s, err := payload.String("command")
+		if err != nil {
+			return ExecCommandRequest{}, err
+		}
+		command = []string{"/bin/sh", "-c", s}
+	default:
+		command, err = payload.StringSlice("command")
+		if err != nil {
+			return ExecCommandRequest{}, err
+		}
+	}
+
+	req := ExecCommandRequest{
+		Namespace:     namespace,
+		PodName:       podName,
+		ContainerName: containerName,
+		Command:       command,
+	}
+
+	if _, ok := payload["timeout"]; ok {
+		seconds, err := payload.Float64("timeout")
+		if err != nil {
+			return ExecCommandRequest{}, err
+		}
+		req.Timeout = time.Duration(seconds * float64(time.Second))
+	}
+
+	return req, nil
+}
+
+// errExecCommandStopped is returned by writes to a command's output after the
+// command stopped.
+var errExecCommandStopped = errors.New("exec command stopped")
+
+// limitedBuffer keeps the first limit bytes written to it and discards the rest.
+// It is safe to read while it is being written. Writes fail once it is closed.
+type limitedBuffer struct {
+	limit     int
+	buf       bytes.Buffer
+	discarded bool
+	closed    bool
+	mu        sync.Mutex
+}
+
+var _ io.Writer = (*limitedBuffer)(nil)
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return 0, errExecCommandStopped
+	}
+
+	n := len(p)
+	if remaining := b.limit - b.buf.Len(); n > remaining {
+		p = p[:remaining]
+		b.discarded = true
+	}
+	b.buf.Write(p)
+
+	return n, nil
+}
+
+func (b *limitedBuffer) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+}
+
+func (b *limitedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func (b *limitedBuffer) truncated() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.discarded
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/exec"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+type execCommandEventSender struct {
+	*actionFake.MockAlerter
+	events []octant.Event
+}
+
+func (s *execCommandEventSender) SendEvent(event octant.Event) {
+	s.events = append(s.events, event)
+}
+
+func Test_ExecCommand(t *testing.T) {
+	cases := []struct {
+		name     string
+		run      octant.CommandRunner
+		timeout  time.Duration
+		expected octant.ExecCommandResult
+		isErr    bool
+	}{
+		{
+			name: "success",
+			run: func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+				_, err := io.WriteString(stdout, "ok\n")
+				return err
+			},
+			expected: octant.ExecCommandResult{Stdout: "ok\n"},
+		},
+		{
+			name: "non-zero exit code",
+			run: func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+				_, _ = io.WriteString(stderr, "failed\n")
+				return exec.CodeExitError{Err: assert.AnError, Code: 3}
+			},
+			expected: octant.ExecCommandResult{Stderr: "failed\n", ExitCode: 3},
+		},
+		{
+			name: "timeout",
+			run: func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+				_, _ = io.WriteString(stdout, "partial")
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			timeout:  10 * time.Millisecond,
+			expected: octant.ExecCommandResult{Stdout: "partial", ExitCode: -1, TimedOut: true},
+		},
+		{
+			name: "exec error",
+			run: func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+				return assert.AnError
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotKey store.Key
+			var gotContainer string
+			var gotCommand []string
+
+			e := octant.NewExecCommandWithRunner(func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+				gotKey, gotContainer, gotCommand = key, container, command
+				return tc.run(ctx, key, container, command, stdout, stderr)
+			})
+
+			req := octant.ExecCommandRequest{
+				Namespace:     "default",
+				PodName:       "pod",
+				ContainerName: "app",
+				Command:       []string{"echo", "ok"},
+				Timeout:       tc.timeout,
+			}
+
+			got, err := e.ExecCommand(context.Background(), req)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expected.TimedOut {
+				// The command is still running, so only the captured output is checked.
+				assert.True(t, got.TimedOut)
+				assert.Equal(t, -1, got.ExitCode)
+				return
+			}
+
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, store.Key{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "pod"}, gotKey)
+			assert.Equal(t, "app", gotContainer)
+			assert.Equal(t, []string{"echo", "ok"}, gotCommand)
+		})
+	}
+}
+
+func Test_ExecCommand_timeoutStopsRunner(t *testing.T) {
+	exited := make(chan error, 1)
+
+	e := octant.NewExecCommandWithRunner(func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+		<-ctx.Done()
+
+		// The stream is closed, so output written after the timeout is rejected.
+		_, err := io.WriteString(stdout, "late")
+		exited <- err
+		return ctx.Err()
+	})
+
+	req := octant.ExecCommandRequest{
+		Namespace: "default",
+		PodName:   "pod",
+		Command:   []string{"sleep", "60"},
+		Timeout:   10 * time.Millisecond,
+	}
+
+	got, err := e.ExecCommand(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, got.TimedOut)
+
+	select {
+	case err := <-exited:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("runner did not exit after the timeout")
+	}
+
+	assert.Empty(t, got.Stdout)
+}
+
+func Test_ExecCommand_Handle(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var gotCommand []string
+	e := octant.NewExecCommandWithRunner(func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+		gotCommand = command
+		_, err := io.WriteString(stdout, "flushed")
+		return err
+	})
+
+	sender := &execCommandEventSender{MockAlerter: actionFake.NewMockAlerter(controller)}
+
+	payload := action.Payload{
+		"namespace":     "default",
+		"podName":       "pod",
+		"containerName": "app",
+		"command":       "redis-cli flushall",
+		"timeout":       float64(5),
+	}
+
+	require.NoError(t, e.Handle(context.Background(), sender, payload))
+
+	assert.Equal(t, []string{"/bin/sh", "-c", "redis-cli flushall"}, gotCommand)
+	require.Len(t, sender.events, 1)
+
+	event := sender.events[0]
+	assert.Equal(t, octant.EventTypeExecCommandResult, event.Type)
+
+	data, ok := event.Data.(action.Payload)
+	require.True(t, ok)
+	assert.Equal(t, 0, data["exitCode"])
+
+	expected := component.NewCodeBlock("flushed")
+	expected.Metadata.SetTitleText("/bin/sh -c redis-cli flushall exited with status 0")
+	assert.Equal(t, expected, data["view"])
+}
+
+func Test_ExecCommand_Handle_alert(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	e := octant.NewExecCommandWithRunner(func(ctx context.Context, key store.Key, container string, command []string, stdout, stderr io.Writer) error {
+		return exec.CodeExitError{Err: assert.AnError, Code: 1}
+	})
+
+	alerter := actionFake.NewMockAlerter(controller)
+	alerter.EXPECT().SendAlert(gomock.Any()).Do(func(alert action.Alert) {
+		assert.Equal(t, action.AlertTypeWarning, alert.Type)
+		assert.Equal(t, "migrate status exited with status 1", alert.Message)
+	})
+
+	payload := action.Payload{
+		"namespace": "default",
+		"podName":   "pod",
+		"command":   []interface{}{"migrate", "status"},
+	}
+
+	require.NoError(t, e.Handle(context.Background(), alerter, payload))
+}
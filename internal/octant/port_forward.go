@@ -7,9 +7,9 @@ package octant
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -49,7 +49,7 @@ func (p *PortForward) Handle(ctx context.Context, alerter action.Alerter, payloa
 	}
 	p.logger.Debugf("%s", request)
 
-	_, err = p.portForwarder.Create(ctx, alerter, request.gvk(), request.Name, request.Namespace, request.Port)
+	_, err = p.portForwarder.CreateFromRequest(ctx, alerter, request.createRequest())
 	if err != nil {
 		return errors.Wrap(err, "create port forwarder")
 	}
@@ -97,30 +97,41 @@ type portForwardCreateRequest struct {
 	Name       string `json:"name,omitempty"`
 	Namespace  string `json:"namespace,omitempty"`
 	Port       uint16 `json:"port,omitempty"`
+	PortName   string `json:"portName,omitempty"`
+	Pod        string `json:"pod,omitempty"`
 }
 
 func (req *portForwardCreateRequest) Validate() error {
-	if req.APIVersion != "v1" && req.Kind == "Pod" {
-		return errors.New("only supports forwards for v1 Pods")
+	if req.APIVersion == "" || req.Kind == "" {
+		return errors.New("apiVersion and kind are required")
 	}
 
 	if req.Name == "" {
-		return errors.New("pod name is blank")
+		return errors.New("name is blank")
 	}
 
 	if req.Namespace == "" {
-		return errors.New("pod namespace is blank")
+		return errors.New("namespace is blank")
 	}
 
-	if req.Port < 1 {
+	if req.Port < 1 && req.PortName == "" {
 		return errors.New("port must be greater than 0")
 	}
 
 	return nil
 }
 
-func (req *portForwardCreateRequest) gvk() schema.GroupVersionKind {
-	return schema.FromAPIVersionAndKind(req.APIVersion, req.Kind)
+func (req *portForwardCreateRequest) createRequest() portforward.CreateRequest {
+	return portforward.CreateRequest{
+		Namespace:  req.Namespace,
+		APIVersion: req.APIVersion,
+		Kind:       req.Kind,
+		Name:       req.Name,
+		Ports: []portforward.PortForwardPortSpec{
+			{Remote: req.Port, RemoteName: req.PortName},
+		},
+		Pod: req.Pod,
+	}
 }
 
 func portForwardRequestFromPayload(payload action.Payload) (*portForwardCreateRequest, error) {
@@ -144,7 +155,7 @@ func portForwardRequestFromPayload(payload action.Payload) (*portForwardCreateRe
 		return nil, err
 	}
 
-	port, err := payload.Uint16("port")
+	pod, err := payload.OptionalString("pod")
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +165,22 @@ func portForwardRequestFromPayload(payload action.Payload) (*portForwardCreateRe
 		Kind:       kind,
 		Name:       name,
 		Namespace:  namespace,
-		Port:       port,
+		Pod:        pod,
+	}
+
+	// The port can be a number or the name of a port, e.g. "http".
+	if portName, ok := payload["port"].(string); ok {
+		if port, err := strconv.ParseUint(portName, 10, 16); err == nil {
+			req.Port = uint16(port)
+		} else {
+			req.PortName = portName
+		}
+	} else {
+		port, err := payload.Uint16("port")
+		if err != nil {
+			return nil, err
+		}
+		req.Port = port
 	}
 
 	if err := req.Validate(); err != nil {
@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware-tanzu/octant/internal/portforward"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+// PortForwardProfileSave saves a running port forward as a profile.
+type PortForwardProfileSave struct {
+	logger        log.Logger
+	portForwarder portforward.PortForwarder
+	contextName   func() string
+}
+
+var _ action.Dispatcher = (*PortForwardProfileSave)(nil)
+
+// NewPortForwardProfileSave creates an instance of PortForwardProfileSave.
+func NewPortForwardProfileSave(logger log.Logger, portForwarder portforward.PortForwarder, contextName func() string) *PortForwardProfileSave {
+	return &PortForwardProfileSave{
+		logger:        logger,
+		portForwarder: portForwarder,
+		contextName:   contextName,
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *PortForwardProfileSave) ActionName() string {
+	return ActionSavePortForwardProfile
+}
+
+// Handle saves the port forward in the payload as a profile. The profile uses
+// the port forward's current local port and the current kube context. The
+// profile name is optional.
+func (p *PortForwardProfileSave) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.With("payload", payload).Debugf("received action payload")
+
+	id, err := payload.String("id")
+	if err != nil {
+		return errors.Wrap(err, "convert payload to save port forward profile request")
+	}
+
+	name, err := payload.OptionalString("profileName")
+	if err != nil {
+		return err
+	}
+
+	state, ok := p.portForwarder.Get(id)
+	if !ok {
+		return errors.Errorf("port forward %q not found", id)
+	}
+
+	profile, err := profileForState(state, name)
+	if err != nil {
+		return err
+	}
+	profile.Context = p.contextName()
+
+	message := fmt.Sprintf("Saved port forward profile %q", profile.Name)
+	alertType := action.AlertTypeInfo
+	if err := p.portForwarder.SaveProfile(profile); err != nil {
+		message = fmt.Sprintf("Unable to save port forward profile %q: %s", profile.Name, err)
+		alertType = action.AlertTypeWarning
+		p.logger.WithErr(err).Errorf("save port forward profile")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+func profileForState(state portforward.State, name string) (portforward.Profile, error) {
+	if len(state.Ports) == 0 {
+		return portforward.Profile{}, errors.New("port forward has no ports")
+	}
+
+	port := state.Ports[0]
+	if name == "" {
+		name = fmt.Sprintf("%s-%d", state.Target.Name, port.Remote)
+	}
+
+	apiVersion, kind := state.Target.GVK.ToAPIVersionAndKind()
+
+	return portforward.Profile{
+		Name:       name,
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Namespace:  state.Target.Namespace,
+		Target:     state.Target.Name,
+		RemotePort: port.Remote,
+		LocalPort:  port.Local,
+	}, nil
+}
+
+// PortForwardProfileStart starts a port forward profile.
+type PortForwardProfileStart struct {
+	logger        log.Logger
+	portForwarder portforward.PortForwarder
+	contextName   func() string
+}
+
+var _ action.Dispatcher = (*PortForwardProfileStart)(nil)
+
+// NewPortForwardProfileStart creates an instance of PortForwardProfileStart.
+func NewPortForwardProfileStart(logger log.Logger, portForwarder portforward.PortForwarder, contextName func() string) *PortForwardProfileStart {
+	return &PortForwardProfileStart{
+		logger:        logger,
+		portForwarder: portForwarder,
+		contextName:   contextName,
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *PortForwardProfileStart) ActionName() string {
+	return ActionStartPortForwardProfile
+}
+
+// Handle starts the port forward profile in the payload in the current kube
+// context.
+func (p *PortForwardProfileStart) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.With("payload", payload).Debugf("received action payload")
+
+	name, err := payload.String("profileName")
+	if err != nil {
+		return errors.Wrap(err, "convert payload to start port forward profile request")
+	}
+
+	message := fmt.Sprintf("Started port forward profile %q", name)
+	alertType := action.AlertTypeInfo
+	if _, err := p.portForwarder.StartProfile(ctx, alerter, name, p.contextName()); err != nil {
+		message = fmt.Sprintf("Unable to start port forward profile %q: %s", name, err)
+		alertType = action.AlertTypeWarning
+		p.logger.WithErr(err).Errorf("start port forward profile")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
+
+// PortForwardProfileStop stops a port forward profile.
+type PortForwardProfileStop struct {
+	logger        log.Logger
+	portForwarder portforward.PortForwarder
+}
+
+var _ action.Dispatcher = (*PortForwardProfileStop)(nil)
+
+// NewPortForwardProfileStop creates an instance of PortForwardProfileStop.
+func NewPortForwardProfileStop(logger log.Logger, portForwarder portforward.PortForwarder) *PortForwardProfileStop {
+	return &PortForwardProfileStop{
+		logger:        logger,
+		portForwarder: portForwarder,
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *PortForwardProfileStop) ActionName() string {
+	return ActionStopPortForwardProfile
+}
+
+// Handle stops the port forward profile in the payload.
+func (p *PortForwardProfileStop) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.With("payload", payload).Debugf("received action payload")
+
+	name, err := payload.String("profileName")
+	if err != nil {
+		return errors.Wrap(err, "convert payload to stop port forward profile request")
+	}
+
+	p.portForwarder.StopProfile(name)
+	return nil
+}
+
+// PortForwardProfileDelete deletes a port forward profile.
+type PortForwardProfileDelete struct {
+	logger        log.Logger
+	portForwarder portforward.PortForwarder
+}
+
+var _ action.Dispatcher = (*PortForwardProfileDelete)(nil)
+
+// NewPortForwardProfileDelete creates an instance of PortForwardProfileDelete.
+func NewPortForwardProfileDelete(logger log.Logger, portForwarder portforward.PortForwarder) *PortForwardProfileDelete {
+	return &PortForwardProfileDelete{
+		logger:        logger,
+		portForwarder: portForwarder,
+	}
+}
+
+// ActionName returns the name of this action.
+func (p *PortForwardProfileDelete) ActionName() string {
+	return ActionDeletePortForwardProfile
+}
+
+// Handle deletes the port forward profile in the payload.
+func (p *PortForwardProfileDelete) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	p.logger.With("payload", payload).Debugf("received action payload")
+
+	name, err := payload.String("profileName")
+	if err != nil {
+		return errors.Wrap(err, "convert payload to delete port forward profile request")
+	}
+
+	message := fmt.Sprintf("Deleted port forward profile %q", name)
+	alertType := action.AlertTypeInfo
+	if err := p.portForwarder.DeleteProfile(name); err != nil {
+		message = fmt.Sprintf("Unable to delete port forward profile %q: %s", name, err)
+		alertType = action.AlertTypeWarning
+		p.logger.WithErr(err).Errorf("delete port forward profile")
+	}
+
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+	return nil
+}
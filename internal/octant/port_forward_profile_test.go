@@ -0,0 +1,184 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package octant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/portforward"
+	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+func expectAlert(t *testing.T, alerter *actionFake.MockAlerter, alertType action.AlertType, message string) {
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, alertType, alert.Type)
+			assert.Equal(t, message, alert.Message)
+		})
+}
+
+func contextName(name string) func() string {
+	return func() string {
+		return name
+	}
+}
+
+func TestPortForwardProfileSave(t *testing.T) {
+	state := portforward.State{
+		ID: "id",
+		Ports: []portforward.ForwardedPort{
+			{Local: 8080, Remote: 80},
+		},
+		Target: portforward.Target{
+			GVK:       schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+			Namespace: "default",
+			Name:      "web",
+		},
+	}
+
+	cases := []struct {
+		name         string
+		profileName  string
+		expectedName string
+	}{
+		{
+			name:         "default name",
+			expectedName: "web-80",
+		},
+		{
+			name:         "with name",
+			profileName:  "frontend",
+			expectedName: "frontend",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			portForwarder := portForwardFake.NewMockPortForwarder(controller)
+			portForwarder.EXPECT().Get("id").Return(state, true)
+			portForwarder.EXPECT().SaveProfile(portforward.Profile{
+				Name:       tc.expectedName,
+				Context:    "cluster",
+				APIVersion: "v1",
+				Kind:       "Service",
+				Namespace:  "default",
+				Target:     "web",
+				RemotePort: 80,
+				LocalPort:  8080,
+			}).Return(nil)
+
+			alerter := actionFake.NewMockAlerter(controller)
+			expectAlert(t, alerter, action.AlertTypeInfo, `Saved port forward profile "`+tc.expectedName+`"`)
+
+			save := octant.NewPortForwardProfileSave(log.NopLogger(), portForwarder, contextName("cluster"))
+			assert.Equal(t, octant.ActionSavePortForwardProfile, save.ActionName())
+
+			payload := action.Payload{"id": "id", "profileName": tc.profileName}
+			require.NoError(t, save.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
+
+func TestPortForwardProfileSave_notFound(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+	portForwarder.EXPECT().Get("id").Return(portforward.State{}, false)
+
+	alerter := actionFake.NewMockAlerter(controller)
+
+	save := octant.NewPortForwardProfileSave(log.NopLogger(), portForwarder, contextName("cluster"))
+	require.Error(t, save.Handle(context.Background(), alerter, action.Payload{"id": "id"}))
+}
+
+func TestPortForwardProfileStart(t *testing.T) {
+	cases := []struct {
+		name      string
+		err       error
+		alertType action.AlertType
+		message   string
+	}{
+		{
+			name:      "started",
+			alertType: action.AlertTypeInfo,
+			message:   `Started port forward profile "web"`,
+		},
+		{
+			name:      "error",
+			err:       errors.New("port in use"),
+			alertType: action.AlertTypeWarning,
+			message:   `Unable to start port forward profile "web": port in use`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			ctx := context.Background()
+			alerter := actionFake.NewMockAlerter(controller)
+			expectAlert(t, alerter, tc.alertType, tc.message)
+
+			portForwarder := portForwardFake.NewMockPortForwarder(controller)
+			portForwarder.EXPECT().
+				StartProfile(ctx, alerter, "web", "cluster").
+				Return(portforward.CreateResponse{}, tc.err)
+
+			start := octant.NewPortForwardProfileStart(log.NopLogger(), portForwarder, contextName("cluster"))
+			assert.Equal(t, octant.ActionStartPortForwardProfile, start.ActionName())
+
+			require.NoError(t, start.Handle(ctx, alerter, action.Payload{"profileName": "web"}))
+		})
+	}
+}
+
+func TestPortForwardProfileStop(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+	portForwarder.EXPECT().StopProfile("web")
+
+	alerter := actionFake.NewMockAlerter(controller)
+
+	stop := octant.NewPortForwardProfileStop(log.NopLogger(), portForwarder)
+	assert.Equal(t, octant.ActionStopPortForwardProfile, stop.ActionName())
+
+	require.NoError(t, stop.Handle(context.Background(), alerter, action.Payload{"profileName": "web"}))
+}
+
+func TestPortForwardProfileDelete(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	portForwarder := portForwardFake.NewMockPortForwarder(controller)
+	portForwarder.EXPECT().DeleteProfile("web").Return(nil)
+
+	alerter := actionFake.NewMockAlerter(controller)
+	expectAlert(t, alerter, action.AlertTypeInfo, `Deleted port forward profile "web"`)
+
+	deleteProfile := octant.NewPortForwardProfileDelete(log.NopLogger(), portForwarder)
+	assert.Equal(t, octant.ActionDeletePortForwardProfile, deleteProfile.ActionName())
+
+	require.NoError(t, deleteProfile.Handle(context.Background(), alerter, action.Payload{"profileName": "web"}))
+}
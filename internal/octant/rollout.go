@@ -0,0 +1,525 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const (
+	// DeploymentRevisionAnnotation is the annotation a deployment controller
+	// uses to record the revision of a deployment and its replica sets.
+	DeploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+	// ChangeCauseAnnotation is the annotation which records why a revision was created.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+	// RestartedAtAnnotation is the pod template annotation used to restart a rollout.
+	RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+// RolloutRevision is a revision of a workload's pod template.
+type RolloutRevision struct {
+	// Revision is the revision number.
+	Revision int64
+	// Name is the name of the replica set or controller revision which
+	// stores the revision.
+	Name string
+	// ChangeCause is the reason the revision was created.
+	ChangeCause string
+	// CreationTimestamp is when the revision was created.
+	CreationTimestamp metav1.Time
+	// Template is the pod template for the revision.
+	Template corev1.PodTemplateSpec
+}
+
+// Images returns the container images in the revision's pod template.
+func (r RolloutRevision) Images() []string {
+	var images []string
+	for _, c := range r.Template.Spec.InitContainers {
+		images = append(images, c.Image)
+	}
+	for _, c := range r.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+	return images
+}
+
+// RolloutHistory is the revision history of a Deployment, DaemonSet, or StatefulSet.
+type RolloutHistory struct {
+	// Revisions are the revisions of the workload, newest first.
+	Revisions []RolloutRevision
+	// Current is the revision number of the current revision.
+	Current int64
+}
+
+// Revision returns a revision by number.
+func (h *RolloutHistory) Revision(revision int64) (RolloutRevision, bool) {
+	for _, r := range h.Revisions {
+		if r.Revision == revision {
+			return r, true
+		}
+	}
+
+	return RolloutRevision{}, false
+}
+
+// CurrentRevision returns the current revision.
+func (h *RolloutHistory) CurrentRevision() (RolloutRevision, bool) {
+	return h.Revision(h.Current)
+}
+
+// IsRolloutKind returns true if rollouts are supported for a kind.
+func IsRolloutKind(apiVersion, kind string) bool {
+	if apiVersion != "apps/v1" {
+		return false
+	}
+
+	switch kind {
+	case "Deployment", "DaemonSet", "StatefulSet":
+		return true
+	default:
+		return false
+	}
+}
+
+// LoadRolloutHistory loads the rollout history for a workload. Deployment
+// revisions are stored in replica sets, and DaemonSet and StatefulSet revisions
+// are stored in controller revisions.
+func LoadRolloutHistory(ctx context.Context, objectStore store.Store, object *unstructured.Unstructured) (*RolloutHistory, error) {
+	if object == nil {
+		return nil, errors.New("object is nil")
+	}
+
+	if !IsRolloutKind(object.GetAPIVersion(), object.GetKind()) {
+		return nil, errors.Errorf("rollout history is not supported for %s", object.GetKind())
+	}
+
+	var history *RolloutHistory
+	var err error
+	if object.GetKind() == "Deployment" {
+		history, err = deploymentRolloutHistory(ctx, objectStore, object)
+	} else {
+		history, err = controllerRevisionRolloutHistory(ctx, objectStore, object)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(history.Revisions, func(i, j int) bool {
+		return history.Revisions[i].Revision > history.Revisions[j].Revision
+	})
+
+	return history, nil
+}
+
+func deploymentRolloutHistory(ctx context.Context, objectStore store.Store, deployment *unstructured.Unstructured) (*RolloutHistory, error) {
+	key := store.Key{
+		Namespace:  deployment.GetNamespace(),
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list replica sets for deployment %s", deployment.GetName())
+	}
+
+	history := &RolloutHistory{}
+	if history.Current, err = revisionFromAnnotations(deployment.GetAnnotations()); err != nil {
+		return nil, errors.Wrapf(err, "deployment %s", deployment.GetName())
+	}
+
+	for i := range list.Items {
+		if !metav1.IsControlledBy(&list.Items[i], deployment) {
+			continue
+		}
+
+		replicaSet := &appsv1.ReplicaSet{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], replicaSet); err != nil {
+			return nil, err
+		}
+
+		revision, err := revisionFromAnnotations(replicaSet.Annotations)
+		if err != nil {
+			return nil, errors.Wrapf(err, "replica set %s", replicaSet.Name)
+		}
+
+		template := *replicaSet.Spec.Template.DeepCopy()
+		delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+
+		history.Revisions = append(history.Revisions, RolloutRevision{
+			Revision:          revision,
+			Name:              replicaSet.Name,
+			ChangeCause:       replicaSet.Annotations[ChangeCauseAnnotation],
+			CreationTimestamp: replicaSet.CreationTimestamp,
+			Template:          template,
+		})
+	}
+
+	return history, nil
+}
+
+func controllerRevisionRolloutHistory(ctx context.Context, objectStore store.Store, object *unstructured.Unstructured) (*RolloutHistory, error) {
+	key := store.Key{
+		Namespace:  object.GetNamespace(),
+		APIVersion: "apps/v1",
+		Kind:       "ControllerRevision",
+	}
+
+	list, _, err := objectStore.List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list controller revisions for %s %s", object.GetKind(), object.GetName())
+	}
+
+	updateRevision, _, err := unstructured.NestedString(object.Object, "status", "updateRevision")
+	if err != nil {
+		return nil, err
+	}
+
+	history := &RolloutHistory{}
+
+	for i := range list.Items {
+		if !metav1.IsControlledBy(&list.Items[i], object) {
+			continue
+		}
+
+		controllerRevision := &appsv1.ControllerRevision{}
+		if err := kubernetes.FromUnstructured(&list.Items[i], controllerRevision); err != nil {
+			return nil, err
+		}
+
+		template, err := controllerRevisionTemplate(controllerRevision)
+		if err != nil {
+			return nil, err
+		}
+
+		history.Revisions = append(history.Revisions, RolloutRevision{
+			Revision:          controllerRevision.Revision,
+			Name:              controllerRevision.Name,
+			ChangeCause:       controllerRevision.Annotations[ChangeCauseAnnotation],
+			CreationTimestamp: controllerRevision.CreationTimestamp,
+			Template:          template,
+		})
+
+		// StatefulSets report their current revision. DaemonSets don't, so
+		// the newest revision is used.
+		switch {
+		case updateRevision != "":
+			if controllerRevision.Name == updateRevision {
+				history.Current = controllerRevision.Revision
+			}
+		case controllerRevision.Revision > history.Current:
+			history.Current = controllerRevision.Revision
+		}
+	}
+
+	return history, nil
+}
+
+// controllerRevisionTemplate extracts the pod template from a controller
+// revision. DaemonSet and StatefulSet controller revisions store a patch which
+// replaces the pod template.
+func controllerRevisionTemplate(controllerRevision *appsv1.ControllerRevision) (corev1.PodTemplateSpec, error) {
+	var data struct {
+		Spec struct {
+			Template corev1.PodTemplateSpec `json:"template"`
+		} `json:"spec"`
+	}
+
+	if len(controllerRevision.Data.Raw) == 0 {
+		return corev1.PodTemplateSpec{}, nil
+	}
+
+	if err := json.Unmarshal(controllerRevision.Data.Raw, &data); err != nil {
+		return corev1.PodTemplateSpec{}, errors.Wrapf(err, "decode controller revision %s", controllerRevision.Name)
+	}
+
+	return data.Spec.Template, nil
+}
+
+func revisionFromAnnotations(annotations map[string]string) (int64, error) {
+	value, ok := annotations[DeploymentRevisionAnnotation]
+	if !ok {
+		return 0, nil
+	}
+
+	revision, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse revision %q", value)
+	}
+
+	return revision, nil
+}
+
+// RolloutPause pauses a deployment rollout.
+type RolloutPause struct {
+	logger log.Logger
+	store  store.Store
+}
+
+var _ action.Dispatcher = (*RolloutPause)(nil)
+
+// NewRolloutPause creates an instance of RolloutPause.
+func NewRolloutPause(logger log.Logger, objectStore store.Store) *RolloutPause {
+	return &RolloutPause{
+		logger: logger,
+		store:  objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *RolloutPause) ActionName() string {
+	return ActionRolloutPause
+}
+
+// Handle pauses a deployment.
+func (r *RolloutPause) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	r.logger.With("payload", payload, "actionName", r.ActionName()).Debugf("received action payload")
+	return setDeploymentPaused(ctx, r.store, alerter, payload, true)
+}
+
+// RolloutResume resumes a paused deployment rollout.
+type RolloutResume struct {
+	logger log.Logger
+	store  store.Store
+}
+
+var _ action.Dispatcher = (*RolloutResume)(nil)
+
+// NewRolloutResume creates an instance of RolloutResume.
+func NewRolloutResume(logger log.Logger, objectStore store.Store) *RolloutResume {
+	return &RolloutResume{
+		logger: logger,
+		store:  objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *RolloutResume) ActionName() string {
+	return ActionRolloutResume
+}
+
+// Handle resumes a deployment.
+func (r *RolloutResume) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	r.logger.With("payload", payload, "actionName", r.ActionName()).Debugf("received action payload")
+	return setDeploymentPaused(ctx, r.store, alerter, payload, false)
+}
+
+// setDeploymentPaused sets spec.paused with a merge patch, like kubectl
+// rollout pause, so it isn't removed by a later server-side apply.
+func setDeploymentPaused(ctx context.Context, objectStore store.Store, alerter action.Alerter, payload action.Payload, paused bool) error {
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	if key.APIVersion != "apps/v1" || key.Kind != "Deployment" {
+		return errors.Errorf("%s %s can't be paused or resumed", key.APIVersion, key.Kind)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"paused": paused,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	verb := "Paused"
+	if !paused {
+		verb = "Resumed"
+	}
+
+	patcher, err := storePatcher(objectStore)
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("%s Deployment %q", verb, key.Name)
+	if err := patcher.Patch(ctx, key, types.MergePatchType, patch); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to update Deployment %q: %s", key.Name, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// RolloutRestart restarts a workload by updating an annotation on its pod
+// template. Any object with a pod template can be restarted. Like kubectl
+// rollout restart, the annotation is set with a merge patch, so it isn't
+// removed by a later server-side apply.
+type RolloutRestart struct {
+	logger log.Logger
+	store  store.Store
+	now    func() time.Time
+}
+
+var _ action.Dispatcher = (*RolloutRestart)(nil)
+
+// NewRolloutRestart creates an instance of RolloutRestart.
+func NewRolloutRestart(logger log.Logger, objectStore store.Store) *RolloutRestart {
+	return &RolloutRestart{
+		logger: logger,
+		store:  objectStore,
+		now:    time.Now,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *RolloutRestart) ActionName() string {
+	return ActionRolloutRestart
+}
+
+// Handle restarts a workload.
+func (r *RolloutRestart) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	r.logger.With("payload", payload, "actionName", r.ActionName()).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	current, err := r.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !HasPodTemplate(current) {
+		return errors.Errorf("%s %s does not have a pod template", key.Kind, key.Name)
+	}
+
+	restartedAt := r.now().Format(time.RFC3339)
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{
+						RestartedAtAnnotation: restartedAt,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	patcher, err := storePatcher(r.store)
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Restarted %s %q", key.Kind, key.Name)
+	if err := patcher.Patch(ctx, key, types.MergePatchType, patch); err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to restart %s %q: %s", key.Kind, key.Name, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+// RolloutUndo rolls a workload back to a previous revision.
+type RolloutUndo struct {
+	logger log.Logger
+	store  store.Store
+}
+
+var _ action.Dispatcher = (*RolloutUndo)(nil)
+
+// NewRolloutUndo creates an instance of RolloutUndo.
+func NewRolloutUndo(logger log.Logger, objectStore store.Store) *RolloutUndo {
+	return &RolloutUndo{
+		logger: logger,
+		store:  objectStore,
+	}
+}
+
+// ActionName returns the name of this action.
+func (r *RolloutUndo) ActionName() string {
+	return ActionRolloutUndo
+}
+
+// Handle replaces a workload's pod template with the pod template from the
+// requested revision.
+func (r *RolloutUndo) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	r.logger.With("payload", payload, "actionName", r.ActionName()).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	revisionFloat, err := payload.Float64("revision")
+	if err != nil {
+		return err
+	}
+	revision := roundToInt(revisionFloat)
+
+	object, err := r.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if object == nil {
+		return errors.Errorf("%s %s was not found", key.Kind, key.Name)
+	}
+
+	history, err := LoadRolloutHistory(ctx, r.store, object)
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	message := fmt.Sprintf("Rolled back %s %q to revision %d", key.Kind, key.Name, revision)
+
+	target, ok := history.Revision(revision)
+	switch {
+	case !ok:
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to roll back %s %q: revision %d was not found", key.Kind, key.Name, revision)
+	case revision == history.Current:
+		message = fmt.Sprintf("%s %q is already at revision %d", key.Kind, key.Name, revision)
+	default:
+		if err := r.rollback(ctx, key, target); err != nil {
+			alertType = action.AlertTypeWarning
+			message = fmt.Sprintf("Unable to roll back %s %q: %s", key.Kind, key.Name, err)
+		}
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+func (r *RolloutUndo) rollback(ctx context.Context, key store.Key, target RolloutRevision) error {
+	template, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&target.Template)
+	if err != nil {
+		return err
+	}
+
+	return r.store.Update(ctx, key, func(u *unstructured.Unstructured) error {
+		return unstructured.SetNestedMap(u.Object, template, "spec", "template")
+	})
+}
@@ -0,0 +1,376 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func rolloutPodTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "web"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "web", Image: image}},
+		},
+	}
+}
+
+func rolloutOwnerReference(owner metav1.Object, kind string) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       kind,
+		Name:       owner.GetName(),
+		UID:        owner.GetUID(),
+		Controller: pointer.BoolPtr(true),
+	}
+}
+
+func createRolloutReplicaSet(t *testing.T, deployment *appsv1.Deployment, revision int64, image string) *unstructured.Unstructured {
+	replicaSet := testutil.CreateAppReplicaSet(fmt.Sprintf("%s-%d", deployment.Name, revision))
+	replicaSet.OwnerReferences = []metav1.OwnerReference{rolloutOwnerReference(deployment, "Deployment")}
+	replicaSet.Annotations = map[string]string{
+		DeploymentRevisionAnnotation: fmt.Sprintf("%d", revision),
+		ChangeCauseAnnotation:        fmt.Sprintf("set image %s", image),
+	}
+	replicaSet.Spec.Template = rolloutPodTemplate(image)
+	replicaSet.Spec.Template.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = fmt.Sprintf("hash-%d", revision)
+
+	return testutil.ToUnstructured(t, replicaSet)
+}
+
+func createRolloutControllerRevision(t *testing.T, owner metav1.Object, kind string, revision int64, image string) *unstructured.Unstructured {
+	data := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": rolloutPodTemplate(image),
+		},
+	}
+	raw, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	controllerRevision := &appsv1.ControllerRevision{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ControllerRevision"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%d", owner.GetName(), revision),
+			Namespace:       owner.GetNamespace(),
+			OwnerReferences: []metav1.OwnerReference{rolloutOwnerReference(owner, kind)},
+		},
+		Data:     runtime.RawExtension{Raw: raw},
+		Revision: revision,
+	}
+
+	return testutil.ToUnstructured(t, controllerRevision)
+}
+
+func createRolloutDeployment() *appsv1.Deployment {
+	deployment := testutil.CreateDeployment("web")
+	deployment.Annotations = map[string]string{DeploymentRevisionAnnotation: "2"}
+	deployment.Spec.Template = rolloutPodTemplate("nginx:1.19")
+	return deployment
+}
+
+func expectReplicaSets(t *testing.T, objectStore *fake.MockStore, deployment *appsv1.Deployment) {
+	list := &unstructured.UnstructuredList{}
+	list.Items = append(list.Items,
+		*createRolloutReplicaSet(t, deployment, 1, "nginx:1.18"),
+		*createRolloutReplicaSet(t, deployment, 2, "nginx:1.19"),
+		*testutil.ToUnstructured(t, testutil.CreateAppReplicaSet("other")),
+	)
+
+	key := store.Key{Namespace: deployment.Namespace, APIVersion: "apps/v1", Kind: "ReplicaSet"}
+	objectStore.EXPECT().List(gomock.Any(), key).Return(list, false, nil)
+}
+
+func TestLoadRolloutHistory_Deployment(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+
+	deployment := createRolloutDeployment()
+	expectReplicaSets(t, objectStore, deployment)
+
+	history, err := LoadRolloutHistory(context.Background(), objectStore, testutil.ToUnstructured(t, deployment))
+	require.NoError(t, err)
+
+	require.Len(t, history.Revisions, 2)
+	assert.Equal(t, int64(2), history.Current)
+	assert.Equal(t, int64(2), history.Revisions[0].Revision)
+	assert.Equal(t, int64(1), history.Revisions[1].Revision)
+	assert.Equal(t, "set image nginx:1.18", history.Revisions[1].ChangeCause)
+	assert.Equal(t, []string{"nginx:1.18"}, history.Revisions[1].Images())
+	assert.Equal(t, map[string]string{"app": "web"}, history.Revisions[1].Template.Labels)
+
+	current, ok := history.CurrentRevision()
+	require.True(t, ok)
+	assert.Equal(t, "web-2", current.Name)
+}
+
+func TestLoadRolloutHistory_StatefulSet(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+
+	statefulSet := testutil.CreateStatefulSet("db")
+	statefulSet.Status.UpdateRevision = "db-1"
+
+	list := &unstructured.UnstructuredList{}
+	list.Items = append(list.Items,
+		*createRolloutControllerRevision(t, statefulSet, "StatefulSet", 1, "postgres:11"),
+		*createRolloutControllerRevision(t, statefulSet, "StatefulSet", 2, "postgres:12"),
+	)
+
+	key := store.Key{Namespace: statefulSet.Namespace, APIVersion: "apps/v1", Kind: "ControllerRevision"}
+	objectStore.EXPECT().List(gomock.Any(), key).Return(list, false, nil)
+
+	history, err := LoadRolloutHistory(context.Background(), objectStore, testutil.ToUnstructured(t, statefulSet))
+	require.NoError(t, err)
+
+	require.Len(t, history.Revisions, 2)
+	assert.Equal(t, int64(1), history.Current)
+	assert.Equal(t, []string{"postgres:12"}, history.Revisions[0].Images())
+	assert.Equal(t, []string{"postgres:11"}, history.Revisions[1].Images())
+}
+
+func TestLoadRolloutHistory_unsupported(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+
+	_, err := LoadRolloutHistory(context.Background(), objectStore, testutil.ToUnstructured(t, testutil.CreatePod("pod")))
+	require.Error(t, err)
+}
+
+func TestRolloutUndo(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	deployment := createRolloutDeployment()
+	object := testutil.ToUnstructured(t, deployment)
+
+	key, err := store.KeyFromObject(deployment)
+	require.NoError(t, err)
+
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(object, nil)
+	expectReplicaSets(t, objectStore, deployment)
+	objectStore.EXPECT().
+		Update(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, fn func(*unstructured.Unstructured) error) error {
+			updated := object.DeepCopy()
+			require.NoError(t, fn(updated))
+
+			actual := &appsv1.Deployment{}
+			require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(updated.Object, actual))
+			assert.Equal(t, rolloutPodTemplate("nginx:1.18"), actual.Spec.Template)
+			return nil
+		})
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeInfo, alert.Type)
+			assert.Equal(t, `Rolled back Deployment "web" to revision 1`, alert.Message)
+		})
+
+	undo := NewRolloutUndo(log.NopLogger(), objectStore)
+	assert.Equal(t, ActionRolloutUndo, undo.ActionName())
+
+	payload := key.ToActionPayload()
+	payload["revision"] = float64(1)
+
+	require.NoError(t, undo.Handle(context.Background(), alerter, payload))
+}
+
+func TestRolloutUndo_missingRevision(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	deployment := createRolloutDeployment()
+
+	key, err := store.KeyFromObject(deployment)
+	require.NoError(t, err)
+
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, deployment), nil)
+	expectReplicaSets(t, objectStore, deployment)
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+		})
+
+	payload := key.ToActionPayload()
+	payload["revision"] = float64(5)
+
+	undo := NewRolloutUndo(log.NopLogger(), objectStore)
+	require.NoError(t, undo.Handle(context.Background(), alerter, payload))
+}
+
+// patcherStore is a store which supports patches.
+type patcherStore struct {
+	store.Store
+	store.Patcher
+}
+
+func TestRolloutRestart(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	daemonSet := testutil.CreateDaemonSet("agent")
+	key, err := store.KeyFromObject(daemonSet)
+	require.NoError(t, err)
+
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, daemonSet), nil)
+	patcher := fake.NewMockPatcher(controller)
+	patcher.EXPECT().
+		Patch(gomock.Any(), key, types.MergePatchType, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, patchType types.PatchType, data []byte) error {
+			expected := `{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"2020-07-01T12:00:00Z"}}}}}`
+			assert.JSONEq(t, expected, string(data))
+			return nil
+		})
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, `Restarted DaemonSet "agent"`, alert.Message)
+		})
+
+	restart := NewRolloutRestart(log.NopLogger(), patcherStore{Store: objectStore, Patcher: patcher})
+	restart.now = func() time.Time {
+		return time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	require.NoError(t, restart.Handle(context.Background(), alerter, key.ToActionPayload()))
+}
+
+func TestRolloutPause(t *testing.T) {
+	tests := []struct {
+		name       string
+		dispatcher action.Dispatcher
+		paused     bool
+		message    string
+	}{
+		{
+			name:       "pause",
+			dispatcher: NewRolloutPause(log.NopLogger(), nil),
+			paused:     true,
+			message:    `Paused Deployment "web"`,
+		},
+		{
+			name:       "resume",
+			dispatcher: NewRolloutResume(log.NopLogger(), nil),
+			paused:     false,
+			message:    `Resumed Deployment "web"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			patcher := fake.NewMockPatcher(controller)
+			objectStore := patcherStore{Store: fake.NewMockStore(controller), Patcher: patcher}
+			alerter := actionFake.NewMockAlerter(controller)
+
+			switch d := test.dispatcher.(type) {
+			case *RolloutPause:
+				d.store = objectStore
+			case *RolloutResume:
+				d.store = objectStore
+			}
+
+			key, err := store.KeyFromObject(createRolloutDeployment())
+			require.NoError(t, err)
+
+			patcher.EXPECT().
+				Patch(gomock.Any(), key, types.MergePatchType, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key store.Key, patchType types.PatchType, data []byte) error {
+					expected := fmt.Sprintf(`{"spec":{"paused":%t}}`, test.paused)
+					assert.JSONEq(t, expected, string(data))
+					return nil
+				})
+
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, test.message, alert.Message)
+				})
+
+			require.NoError(t, test.dispatcher.Handle(context.Background(), alerter, key.ToActionPayload()))
+		})
+	}
+}
+
+func TestRolloutRestart_noPodTemplate(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	pod := testutil.CreatePod("pod")
+	key, err := store.KeyFromObject(pod)
+	require.NoError(t, err)
+
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, pod), nil)
+
+	restart := NewRolloutRestart(log.NopLogger(), objectStore)
+	require.Error(t, restart.Handle(context.Background(), alerter, key.ToActionPayload()))
+}
+
+func TestRolloutRestart_patchUnsupported(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	objectStore := fake.NewMockStore(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	daemonSet := testutil.CreateDaemonSet("agent")
+	key, err := store.KeyFromObject(daemonSet)
+	require.NoError(t, err)
+
+	objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, daemonSet), nil)
+
+	restart := NewRolloutRestart(log.NopLogger(), objectStore)
+	err = restart.Handle(context.Background(), alerter, key.ToActionPayload())
+	require.EqualError(t, err, "object store does not support patches")
+}
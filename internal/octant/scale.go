@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/log"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// ScaleResource returns the resource for a kind and true if the resource
+// exposes the scale subresource. The discovery client is used, so this works
+// for custom resources with scaling enabled.
+func ScaleResource(clusterClient cluster.ClientInterface, gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+	if clusterClient == nil {
+		return schema.GroupVersionResource{}, false, errors.New("cluster client is nil")
+	}
+
+	mapped, _, err := clusterClient.Resource(gvk.GroupKind())
+	if err != nil {
+		return schema.GroupVersionResource{}, false, errors.Wrapf(err, "find resource for %s", gvk)
+	}
+
+	gvr := gvk.GroupVersion().WithResource(mapped.Resource)
+
+	discoveryClient, err := clusterClient.DiscoveryClient()
+	if err != nil {
+		return gvr, false, errors.Wrap(err, "get discovery client")
+	}
+
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		return gvr, false, errors.Wrapf(err, "discover resources for %s", gvk.GroupVersion())
+	}
+
+	for _, resource := range resources.APIResources {
+		if resource.Name == gvr.Resource+"/scale" {
+			return gvr, true, nil
+		}
+	}
+
+	return gvr, false, nil
+}
+
+// HasPodTemplate returns true if an object has a pod template.
+func HasPodTemplate(object *unstructured.Unstructured) bool {
+	if object == nil {
+		return false
+	}
+
+	_, found, err := unstructured.NestedMap(object.Object, "spec", "template")
+	return err == nil && found
+}
+
+// Scale scales an object with the scale subresource.
+type Scale struct {
+	logger        log.Logger
+	clusterClient cluster.ClientInterface
+}
+
+var _ action.Dispatcher = (*Scale)(nil)
+
+// NewScale creates an instance of Scale.
+func NewScale(logger log.Logger, clusterClient cluster.ClientInterface) *Scale {
+	return &Scale{
+		logger:        logger,
+		clusterClient: clusterClient,
+	}
+}
+
+// ActionName returns the name of this action.
+func (s *Scale) ActionName() string {
+	return ActionScale
+}
+
+// Handle scales an object. The payload sets either the number of replicas
+// with `replicas`, or changes the current number of replicas by `delta`.
+func (s *Scale) Handle(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
+	s.logger.With("payload", payload, "actionName", s.ActionName()).Debugf("received action payload")
+
+	key, err := store.KeyFromPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	alertType := action.AlertTypeInfo
+	replicas, err := s.scale(ctx, key, payload)
+	message := fmt.Sprintf("Scaled %s %q to %d replicas", key.Kind, key.Name, replicas)
+	if err != nil {
+		alertType = action.AlertTypeWarning
+		message = fmt.Sprintf("Unable to scale %s %q: %s", key.Kind, key.Name, err)
+	}
+	alerter.SendAlert(action.CreateAlert(alertType, message, action.DefaultAlertExpiration))
+
+	return nil
+}
+
+func (s *Scale) scale(ctx context.Context, key store.Key, payload action.Payload) (int64, error) {
+	gvr, ok, err := ScaleResource(s.clusterClient, key.GroupVersionKind())
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.Errorf("%s does not support scaling", key.Kind)
+	}
+
+	dynamicClient, err := s.clusterClient.DynamicClient()
+	if err != nil {
+		return 0, err
+	}
+	resourceClient := dynamicClient.Resource(gvr).Namespace(key.Namespace)
+
+	var replicas int64
+	if _, ok := payload["replicas"]; ok {
+		value, err := payload.Float64("replicas")
+		if err != nil {
+			return 0, err
+		}
+		replicas = roundToInt(value)
+	} else {
+		delta, err := payload.Float64("delta")
+		if err != nil {
+			return 0, err
+		}
+
+		scale, err := resourceClient.Get(ctx, key.Name, metav1.GetOptions{}, "scale")
+		if err != nil {
+			return 0, err
+		}
+
+		current, _, err := unstructured.NestedInt64(scale.Object, "spec", "replicas")
+		if err != nil {
+			return 0, err
+		}
+		replicas = current + roundToInt(delta)
+	}
+
+	if replicas < 0 {
+		replicas = 0
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))
+	if _, err := resourceClient.Patch(ctx, key.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "scale"); err != nil {
+		return 0, err
+	}
+
+	return replicas, nil
+}
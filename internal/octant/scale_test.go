@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package octant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterFake "github.com/vmware-tanzu/octant/internal/cluster/fake"
+	"github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	actionFake "github.com/vmware-tanzu/octant/pkg/action/fake"
+)
+
+func expectScaleDiscovery(controller *gomock.Controller, clusterClient *clusterFake.MockClientInterface, gvr schema.GroupVersionResource, scalable bool) {
+	discoveryClient := clusterFake.NewMockDiscoveryInterface(controller)
+
+	resources := &metav1.APIResourceList{
+		GroupVersion: gvr.GroupVersion().String(),
+		APIResources: []metav1.APIResource{{Name: gvr.Resource}},
+	}
+	if scalable {
+		resources.APIResources = append(resources.APIResources, metav1.APIResource{Name: gvr.Resource + "/scale"})
+	}
+
+	clusterClient.EXPECT().Resource(gomock.Any()).Return(gvr, true, nil)
+	clusterClient.EXPECT().DiscoveryClient().Return(discoveryClient, nil)
+	discoveryClient.EXPECT().ServerResourcesForGroupVersion(gvr.GroupVersion().String()).Return(resources, nil)
+}
+
+func TestScaleResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		scalable bool
+	}{
+		{name: "scalable", scalable: true},
+		{name: "not scalable", scalable: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+
+			gvr := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+			expectScaleDiscovery(controller, clusterClient, gvr, test.scalable)
+
+			gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+			actual, ok, err := ScaleResource(clusterClient, gvk)
+			require.NoError(t, err)
+			assert.Equal(t, test.scalable, ok)
+			assert.Equal(t, gvr, actual)
+		})
+	}
+}
+
+func TestScale(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}
+
+	tests := []struct {
+		name     string
+		payload  action.Payload
+		current  *int64
+		expected string
+		message  string
+	}{
+		{
+			name:     "replicas",
+			payload:  action.Payload{"replicas": float64(3)},
+			expected: `{"spec":{"replicas":3}}`,
+			message:  `Scaled StatefulSet "db" to 3 replicas`,
+		},
+		{
+			name:     "delta",
+			payload:  action.Payload{"delta": float64(1)},
+			current:  int64Ptr(2),
+			expected: `{"spec":{"replicas":3}}`,
+			message:  `Scaled StatefulSet "db" to 3 replicas`,
+		},
+		{
+			name:     "delta below zero",
+			payload:  action.Payload{"delta": float64(-1)},
+			current:  int64Ptr(0),
+			expected: `{"spec":{"replicas":0}}`,
+			message:  `Scaled StatefulSet "db" to 0 replicas`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			clusterClient := clusterFake.NewMockClientInterface(controller)
+			dynamicClient := clusterFake.NewMockDynamicInterface(controller)
+			resourceClient := clusterFake.NewMockNamespaceableResourceInterface(controller)
+			alerter := actionFake.NewMockAlerter(controller)
+
+			expectScaleDiscovery(controller, clusterClient, gvr, true)
+			clusterClient.EXPECT().DynamicClient().Return(dynamicClient, nil)
+			dynamicClient.EXPECT().Resource(gvr).Return(resourceClient)
+			resourceClient.EXPECT().Namespace("default").Return(resourceClient)
+
+			if test.current != nil {
+				scale := &unstructured.Unstructured{Object: map[string]interface{}{
+					"spec": map[string]interface{}{"replicas": *test.current},
+				}}
+				resourceClient.EXPECT().Get(gomock.Any(), "db", metav1.GetOptions{}, "scale").Return(scale, nil)
+			}
+
+			resourceClient.EXPECT().
+				Patch(gomock.Any(), "db", types.MergePatchType, []byte(test.expected), metav1.PatchOptions{}, "scale").
+				Return(&unstructured.Unstructured{}, nil)
+
+			alerter.EXPECT().
+				SendAlert(gomock.Any()).
+				DoAndReturn(func(alert action.Alert) {
+					assert.Equal(t, action.AlertTypeInfo, alert.Type)
+					assert.Equal(t, test.message, alert.Message)
+				})
+
+			payload := action.Payload{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"namespace":  "default",
+				"name":       "db",
+			}
+			for k, v := range test.payload {
+				payload[k] = v
+			}
+
+			scale := NewScale(log.NopLogger(), clusterClient)
+			assert.Equal(t, ActionScale, scale.ActionName())
+			require.NoError(t, scale.Handle(context.Background(), alerter, payload))
+		})
+	}
+}
+
+func TestScale_notScalable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	clusterClient := clusterFake.NewMockClientInterface(controller)
+	alerter := actionFake.NewMockAlerter(controller)
+
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}
+	expectScaleDiscovery(controller, clusterClient, gvr, false)
+
+	alerter.EXPECT().
+		SendAlert(gomock.Any()).
+		DoAndReturn(func(alert action.Alert) {
+			assert.Equal(t, action.AlertTypeWarning, alert.Type)
+			assert.Equal(t, `Unable to scale DaemonSet "agent": DaemonSet does not support scaling`, alert.Message)
+		})
+
+	payload := action.Payload{
+		"apiVersion": "apps/v1",
+		"kind":       "DaemonSet",
+		"namespace":  "default",
+		"name":       "agent",
+		"delta":      float64(1),
+	}
+
+	scale := NewScale(log.NopLogger(), clusterClient)
+	require.NoError(t, scale.Handle(context.Background(), alerter, payload))
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
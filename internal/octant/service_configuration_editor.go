@@ -7,11 +7,12 @@ package octant
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/vmware-tanzu/octant/internal/log"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -65,13 +66,19 @@ func (s *ServiceConfigurationEditor) Handle(ctx context.Context, alerter action.
 		selector[parts[0]] = parts[1]
 	}
 
-	fn := func(object *unstructured.Unstructured) error {
-		return unstructured.SetNestedStringMap(object.Object, selector, "spec", "selector")
+	patch, err := serviceSelectorPatch(selector)
+	if err != nil {
+		return err
+	}
+
+	patcher, err := storePatcher(s.store)
+	if err != nil {
+		return err
 	}
 
 	alertType := action.AlertTypeInfo
 	message := fmt.Sprintf("Updated Service %q", name)
-	if err := s.store.Update(ctx, key, fn); err != nil {
+	if err := patcher.Patch(ctx, key, types.StrategicMergePatchType, patch); err != nil {
 		alertType = action.AlertTypeWarning
 		message = fmt.Sprintf("Unable to update Service %q: %s", name, err)
 	}
@@ -80,3 +87,21 @@ func (s *ServiceConfigurationEditor) Handle(ctx context.Context, alerter action.
 
 	return nil
 }
+
+// serviceSelectorPatch creates a strategic merge patch which replaces a
+// service's selector. The selector is a granular map, so applying it would
+// leave keys owned by other managers in place after they were removed.
+func serviceSelectorPatch(selector map[string]string) ([]byte, error) {
+	patchSelector := map[string]interface{}{
+		"$patch": "replace",
+	}
+	for k, v := range selector {
+		patchSelector[k] = v
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"selector": patchSelector,
+		},
+	})
+}
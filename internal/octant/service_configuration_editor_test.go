@@ -7,12 +7,15 @@ package octant
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/action"
@@ -28,20 +31,29 @@ func TestServiceConfigurationEditor(t *testing.T) {
 	service := testutil.CreateService("service")
 	service.Namespace = "default"
 
-	objectStore := fake.NewMockStore(controller)
+	patcher := fake.NewMockPatcher(controller)
+	objectStore := patcherStore{Store: fake.NewMockStore(controller), Patcher: patcher}
 	alerter := actionFake.NewMockAlerter(controller)
 
 	key, err := store.KeyFromObject(service)
 	require.NoError(t, err)
 
-	updatedService := service.DeepCopy()
-	updatedService.Spec.Selector = map[string]string{
-		"foo": "bar",
+	service.Spec.Selector = map[string]string{
+		"app":  "service",
+		"tier": "web",
 	}
+	original, err := json.Marshal(service)
+	require.NoError(t, err)
+
+	patcher.EXPECT().
+		Patch(gomock.Any(), key, types.StrategicMergePatchType, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, patchType types.PatchType, data []byte) error {
+			patched, err := strategicpatch.StrategicMergePatch(original, data, corev1.Service{})
+			require.NoError(t, err)
 
-	objectStore.EXPECT().
-		Update(gomock.Any(), key, gomock.Any()).
-		DoAndReturn(func(ctx context.Context, key store.Key, fn func(object *unstructured.Unstructured) error) error {
+			var got corev1.Service
+			require.NoError(t, json.Unmarshal(patched, &got))
+			assert.Equal(t, map[string]string{"app": "service", "foo": "bar"}, got.Spec.Selector)
 			return nil
 		})
 
@@ -64,6 +76,7 @@ func TestServiceConfigurationEditor(t *testing.T) {
 		"namespace":  "default",
 		"name":       "service",
 		"selectors": []interface{}{
+			"app:service",
 			"foo:bar",
 		},
 	}
@@ -10,18 +10,25 @@ import (
 	"os"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/internal/queryer"
 	"github.com/vmware-tanzu/octant/pkg/store"
 
 	"github.com/pkg/errors"
 )
 
-// Default create a port forward instance.
-func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store) (PortForwarder, error) {
+// Default create a port forward instance. Port forward profiles are stored at
+// profilePath. Profiles are disabled if profilePath is blank.
+func Default(ctx context.Context, client cluster.ClientInterface, objectStore store.Store, profilePath string) (PortForwarder, error) {
 	restClient, err := client.RESTClient()
 	if err != nil {
 		return nil, errors.Wrap(err, "fetching RESTClient")
 	}
 
+	discoveryClient, err := client.DiscoveryClient()
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching DiscoveryClient")
+	}
+
 	pfOpts := ServiceOptions{
 		RESTClient:  restClient,
 		Config:      client.RESTConfig(),
@@ -33,6 +40,13 @@ func Default(ctx context.Context, client cluster.ClientInterface, objectStore st
 				ErrOut: os.Stderr,
 			},
 		},
+		NewQueryer: func() queryer.Queryer {
+			return queryer.New(objectStore, discoveryClient)
+		},
+	}
+
+	if profilePath != "" {
+		pfOpts.Profiles = NewProfileStore(profilePath)
 	}
 
 	svc := New(ctx, pfOpts)
@@ -82,6 +82,21 @@ func (mr *MockPortForwarderMockRecorder) Create(ctx, alerter, gvk, name, namespa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPortForwarder)(nil).Create), ctx, alerter, gvk, name, namespace, remotePort)
 }
 
+// CreateFromRequest mocks base method
+func (m *MockPortForwarder) CreateFromRequest(ctx context.Context, alerter action.Alerter, req portforward.CreateRequest) (portforward.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFromRequest", ctx, alerter, req)
+	ret0, _ := ret[0].(portforward.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFromRequest indicates an expected call of CreateFromRequest
+func (mr *MockPortForwarderMockRecorder) CreateFromRequest(ctx, alerter, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFromRequest", reflect.TypeOf((*MockPortForwarder)(nil).CreateFromRequest), ctx, alerter, req)
+}
+
 // FindTarget mocks base method
 func (m *MockPortForwarder) FindTarget(namespace string, gvk schema.GroupVersionKind, name string) ([]portforward.State, error) {
 	m.ctrl.T.Helper()
@@ -135,3 +150,87 @@ func (mr *MockPortForwarderMockRecorder) StopForwarder(id interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopForwarder", reflect.TypeOf((*MockPortForwarder)(nil).StopForwarder), id)
 }
+
+// Profiles mocks base method
+func (m *MockPortForwarder) Profiles() ([]portforward.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Profiles")
+	ret0, _ := ret[0].([]portforward.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Profiles indicates an expected call of Profiles
+func (mr *MockPortForwarderMockRecorder) Profiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Profiles", reflect.TypeOf((*MockPortForwarder)(nil).Profiles))
+}
+
+// ProfileRunning mocks base method
+func (m *MockPortForwarder) ProfileRunning(name string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProfileRunning", name)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ProfileRunning indicates an expected call of ProfileRunning
+func (mr *MockPortForwarderMockRecorder) ProfileRunning(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProfileRunning", reflect.TypeOf((*MockPortForwarder)(nil).ProfileRunning), name)
+}
+
+// SaveProfile mocks base method
+func (m *MockPortForwarder) SaveProfile(profile portforward.Profile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveProfile", profile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveProfile indicates an expected call of SaveProfile
+func (mr *MockPortForwarderMockRecorder) SaveProfile(profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProfile", reflect.TypeOf((*MockPortForwarder)(nil).SaveProfile), profile)
+}
+
+// DeleteProfile mocks base method
+func (m *MockPortForwarder) DeleteProfile(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProfile", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProfile indicates an expected call of DeleteProfile
+func (mr *MockPortForwarderMockRecorder) DeleteProfile(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProfile", reflect.TypeOf((*MockPortForwarder)(nil).DeleteProfile), name)
+}
+
+// StartProfile mocks base method
+func (m *MockPortForwarder) StartProfile(ctx context.Context, alerter action.Alerter, name, contextName string) (portforward.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartProfile", ctx, alerter, name, contextName)
+	ret0, _ := ret[0].(portforward.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartProfile indicates an expected call of StartProfile
+func (mr *MockPortForwarderMockRecorder) StartProfile(ctx, alerter, name, contextName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartProfile", reflect.TypeOf((*MockPortForwarder)(nil).StartProfile), ctx, alerter, name, contextName)
+}
+
+// StopProfile mocks base method
+func (m *MockPortForwarder) StopProfile(name string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StopProfile", name)
+}
+
+// StopProfile indicates an expected call of StopProfile
+func (mr *MockPortForwarderMockRecorder) StopProfile(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopProfile", reflect.TypeOf((*MockPortForwarder)(nil).StopProfile), name)
+}
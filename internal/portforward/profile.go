@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ProfileFile is the name of the file port forward profiles are stored in.
+const ProfileFile = "port-forwards.json"
+
+// Profile is a named port forward which can be started again later. A profile
+// can only be started in the kube context it was saved in.
+type Profile struct {
+	Name       string `json:"name"`
+	Context    string `json:"context,omitempty"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Target     string `json:"target"`
+	RemotePort uint16 `json:"remotePort"`
+	LocalPort  uint16 `json:"localPort"`
+}
+
+// GVK returns the group version kind of the profile's target.
+func (p Profile) GVK() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(p.APIVersion, p.Kind)
+}
+
+// InContext returns true if the profile can be started in a kube context.
+// Profiles saved without a context can be started in any context.
+func (p Profile) InContext(contextName string) bool {
+	return p.Context == "" || p.Context == contextName
+}
+
+func (p Profile) request() CreateRequest {
+	return CreateRequest{
+		Namespace:  p.Namespace,
+		APIVersion: p.APIVersion,
+		Kind:       p.Kind,
+		Name:       p.Target,
+		Ports: []PortForwardPortSpec{
+			{
+				Remote: p.RemotePort,
+				Local:  p.LocalPort,
+			},
+		},
+	}
+}
+
+// Validate validates a profile.
+func (p Profile) Validate() error {
+	if p.Name == "" {
+		return errors.New("profile name is blank")
+	}
+	if p.Target == "" {
+		return errors.New("profile target is blank")
+	}
+	if p.RemotePort < 1 {
+		return errors.New("profile remote port must be greater than 0")
+	}
+
+	return nil
+}
+
+// ProfileStore stores port forward profiles in a file.
+type ProfileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewProfileStore creates an instance of ProfileStore which stores profiles at path.
+func NewProfileStore(path string) *ProfileStore {
+	return &ProfileStore{
+		path: path,
+	}
+}
+
+// List lists profiles sorted by name. A missing file has no profiles.
+func (ps *ProfileStore) List() ([]Profile, error) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	return ps.read()
+}
+
+// Get gets a profile by name.
+func (ps *ProfileStore) Get(name string) (Profile, bool, error) {
+	profiles, err := ps.List()
+	if err != nil {
+		return Profile{}, false, err
+	}
+
+	for _, profile := range profiles {
+		if profile.Name == name {
+			return profile, true, nil
+		}
+	}
+
+	return Profile{}, false, nil
+}
+
+// Save saves a profile, replacing a profile with the same name.
+func (ps *ProfileStore) Save(profile Profile) error {
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	profiles, err := ps.read()
+	if err != nil {
+		return err
+	}
+
+	var updated []Profile
+	for _, p := range profiles {
+		if p.Name != profile.Name {
+			updated = append(updated, p)
+		}
+	}
+
+	return ps.write(append(updated, profile))
+}
+
+// Delete deletes a profile by name.
+func (ps *ProfileStore) Delete(name string) error {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	profiles, err := ps.read()
+	if err != nil {
+		return err
+	}
+
+	var updated []Profile
+	for _, p := range profiles {
+		if p.Name != name {
+			updated = append(updated, p)
+		}
+	}
+
+	if len(updated) == len(profiles) {
+		return errors.Errorf("port forward profile %q not found", name)
+	}
+
+	return ps.write(updated)
+}
+
+func (ps *ProfileStore) read() ([]Profile, error) {
+	data, err := ioutil.ReadFile(ps.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "read port forward profiles")
+	}
+
+	var profiles []Profile
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, errors.Wrap(err, "parse port forward profiles")
+	}
+
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	return profiles, nil
+}
+
+func (ps *ProfileStore) write(profiles []Profile) error {
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+
+	if profiles == nil {
+		profiles = []Profile{}
+	}
+
+	data, err := json.MarshalIndent(profiles, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal port forward profiles")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(ps.path), 0700); err != nil {
+		return errors.Wrap(err, "create config directory")
+	}
+
+	if err := ioutil.WriteFile(ps.path, data, 0600); err != nil {
+		return errors.Wrap(err, "write port forward profiles")
+	}
+
+	return nil
+}
@@ -0,0 +1,292 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	restfake "k8s.io/client-go/rest/fake"
+	kcache "k8s.io/client-go/tools/cache"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func TestProfileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ps := NewProfileStore(filepath.Join(dir, "octant", ProfileFile))
+
+	profiles, err := ps.List()
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	web := Profile{
+		Name:       "web",
+		APIVersion: "v1",
+		Kind:       "Service",
+		Namespace:  "default",
+		Target:     "web",
+		RemotePort: 80,
+		LocalPort:  8080,
+	}
+	db := Profile{
+		Name:       "db",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  "default",
+		Target:     "db-0",
+		RemotePort: 5432,
+		LocalPort:  5432,
+	}
+
+	require.NoError(t, ps.Save(web))
+	require.NoError(t, ps.Save(db))
+
+	profiles, err = ps.List()
+	require.NoError(t, err)
+	assert.Equal(t, []Profile{db, web}, profiles)
+
+	web.LocalPort = 8081
+	require.NoError(t, ps.Save(web))
+
+	got, found, err := ps.Get("web")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, web, got)
+
+	// Profiles are read from the file, so they persist between stores.
+	reopened := NewProfileStore(filepath.Join(dir, "octant", ProfileFile))
+	profiles, err = reopened.List()
+	require.NoError(t, err)
+	assert.Equal(t, []Profile{db, web}, profiles)
+
+	require.NoError(t, ps.Delete("db"))
+	assert.Error(t, ps.Delete("db"))
+
+	_, found, err = ps.Get("db")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	assert.Error(t, ps.Save(Profile{Name: "invalid"}))
+}
+
+func TestProfile_request(t *testing.T) {
+	profile := Profile{
+		Name:       "web",
+		APIVersion: "v1",
+		Kind:       "Service",
+		Namespace:  "default",
+		Target:     "web",
+		RemotePort: 80,
+		LocalPort:  8080,
+	}
+
+	expected := CreateRequest{
+		Namespace:  "default",
+		APIVersion: "v1",
+		Kind:       "Service",
+		Name:       "web",
+		Ports:      []PortForwardPortSpec{{Remote: 80, Local: 8080}},
+	}
+	assert.Equal(t, expected, profile.request())
+	assert.Equal(t, "Service", profile.GVK().Kind)
+}
+
+func TestProfile_InContext(t *testing.T) {
+	assert.True(t, Profile{Context: "cluster"}.InContext("cluster"))
+	assert.False(t, Profile{Context: "other"}.InContext("cluster"))
+	assert.True(t, Profile{}.InContext("cluster"))
+}
+
+func TestService_profilesNotConfigured(t *testing.T) {
+	svc := New(context.Background(), ServiceOptions{})
+	defer svc.Stop()
+
+	_, err := svc.Profiles()
+	assert.Error(t, err)
+
+	_, err = svc.StartProfile(context.Background(), nil, "web", "cluster")
+	assert.Error(t, err)
+	assert.False(t, svc.ProfileRunning("web"))
+}
+
+func TestService_StartProfile_notFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	svc := New(context.Background(), ServiceOptions{
+		Profiles: NewProfileStore(filepath.Join(dir, ProfileFile)),
+	})
+	defer svc.Stop()
+
+	_, err = svc.StartProfile(context.Background(), nil, "missing", "cluster")
+	assert.Error(t, err)
+	assert.False(t, svc.ProfileRunning("missing"))
+}
+
+func TestService_StartProfile_otherContext(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	profiles := NewProfileStore(filepath.Join(dir, ProfileFile))
+	require.NoError(t, profiles.Save(Profile{
+		Name:       "web",
+		Context:    "other",
+		APIVersion: "v1",
+		Kind:       "Service",
+		Namespace:  "default",
+		Target:     "web",
+		RemotePort: 80,
+	}))
+
+	svc := New(context.Background(), ServiceOptions{
+		Profiles: profiles,
+	})
+	defer svc.Stop()
+
+	_, err = svc.StartProfile(context.Background(), nil, "web", "cluster")
+	assert.EqualError(t, err, `port forward profile "web" was saved in context "other"`)
+	assert.False(t, svc.ProfileRunning("web"))
+}
+
+// blockingPortForwarder forwards ports until the port forward is stopped.
+type blockingPortForwarder struct{}
+
+func (blockingPortForwarder) ForwardPorts(_ action.Alerter, _ string, _ *url.URL, opts Options) error {
+	opts.PortsChannel <- []ForwardedPort{{Local: 8080, Remote: 80}}
+	<-opts.StopChannel
+	return nil
+}
+
+func TestService_superviseProfile(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	defer func(retry, grace time.Duration) {
+		profileRetryInterval, profileReadyGracePeriod = retry, grace
+	}(profileRetryInterval, profileReadyGracePeriod)
+	profileRetryInterval = 10 * time.Millisecond
+	profileReadyGracePeriod = 200 * time.Millisecond
+
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	profiles := NewProfileStore(filepath.Join(dir, ProfileFile))
+	require.NoError(t, profiles.Save(Profile{
+		Name:       "web",
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Namespace:  testutil.DefaultNamespace,
+		Target:     "pod",
+		RemotePort: 80,
+		LocalPort:  8080,
+	}))
+
+	var mu sync.Mutex
+	pod := readyPod("pod", 0)
+	setPod := func(p *corev1.Pod) {
+		mu.Lock()
+		defer mu.Unlock()
+		pod = p
+	}
+
+	podKey := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Pod", Name: "pod"}
+	handlers := make(chan kcache.ResourceEventHandler, 1)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		Get(gomock.Any(), podKey).
+		DoAndReturn(func(context.Context, store.Key) (*unstructured.Unstructured, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if pod == nil {
+				return nil, nil
+			}
+			return testutil.ToUnstructured(t, pod), nil
+		}).AnyTimes()
+	objectStore.EXPECT().
+		Watch(gomock.Any(), store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "v1", Kind: "Pod"}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ store.Key, handler kcache.ResourceEventHandler) error {
+			handlers <- handler
+			return nil
+		})
+
+	svc := New(context.Background(), ServiceOptions{
+		RESTClient:    &restfake.RESTClient{},
+		ObjectStore:   objectStore,
+		PortForwarder: blockingPortForwarder{},
+		Profiles:      profiles,
+	})
+	defer svc.Stop()
+
+	response, err := svc.StartProfile(context.Background(), nil, "web", "cluster")
+	require.NoError(t, err)
+
+	var handler kcache.ResourceEventHandler
+	select {
+	case handler = <-handlers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("profile did not watch its pod")
+	}
+
+	forwardIDs := func() []string {
+		var ids []string
+		for _, state := range svc.List(context.Background()) {
+			ids = append(ids, state.ID)
+		}
+		return ids
+	}
+
+	notReady := readyPod("pod", 0)
+	notReady.Status.ContainerStatuses[0].Ready = false
+	setPod(notReady)
+	handler.OnUpdate(nil, notReady)
+
+	time.Sleep(profileReadyGracePeriod / 2)
+	assert.Equal(t, []string{response.ID}, forwardIDs(), "port forward reconnected during the grace period")
+
+	ready := readyPod("pod", 0)
+	setPod(ready)
+	handler.OnUpdate(notReady, ready)
+
+	time.Sleep(profileReadyGracePeriod)
+	assert.Equal(t, []string{response.ID}, forwardIDs(), "port forward reconnected after the pod was ready again")
+
+	setPod(nil)
+	handler.OnDelete(notReady)
+
+	assert.Eventually(t, func() bool {
+		_, ok := svc.Get(response.ID)
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond, "port forward was not stopped after the pod was deleted")
+
+	setPod(readyPod("pod", 0))
+
+	assert.Eventually(t, func() bool {
+		ids := forwardIDs()
+		return len(ids) == 1 && ids[0] != response.ID
+	}, 5*time.Second, 10*time.Millisecond, "port forward did not reconnect")
+}
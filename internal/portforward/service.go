@@ -16,13 +16,17 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
 	restclient "k8s.io/client-go/rest"
+	kcache "k8s.io/client-go/tools/cache"
 
 	internalLog "github.com/vmware-tanzu/octant/internal/log"
+	"github.com/vmware-tanzu/octant/internal/queryer"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -32,6 +36,24 @@ import (
 
 var (
 	emptyPortForwardResponse = CreateResponse{}
+
+	// forwardableKinds are the kinds which can be port forwarded, and their API versions.
+	forwardableKinds = map[string]string{
+		"Pod":         "v1",
+		"Service":     "v1",
+		"Deployment":  "apps/v1",
+		"StatefulSet": "apps/v1",
+		"ReplicaSet":  "apps/v1",
+		"DaemonSet":   "apps/v1",
+	}
+
+	// profileRetryInterval is how long a running profile waits before
+	// retrying a port forward which could not be reconnected.
+	profileRetryInterval = 2 * time.Second
+
+	// profileReadyGracePeriod is how long the pod of a running profile can be
+	// not ready before its port forward is reconnected.
+	profileReadyGracePeriod = 30 * time.Second
 )
 
 // PortForwarder allows querying active port-forwards
@@ -39,16 +61,26 @@ type PortForwarder interface {
 	List(ctx context.Context) []State
 	Get(id string) (State, bool)
 	Create(ctx context.Context, alerter action.Alerter, gvk schema.GroupVersionKind, name string, namespace string, remotePort uint16) (CreateResponse, error)
+	CreateFromRequest(ctx context.Context, alerter action.Alerter, req CreateRequest) (CreateResponse, error)
 	FindTarget(namespace string, gvk schema.GroupVersionKind, name string) ([]State, error)
 	FindPod(namespace string, gvk schema.GroupVersionKind, name string) ([]State, error)
 	Stop()
 	StopForwarder(id string)
+	Profiles() ([]Profile, error)
+	ProfileRunning(name string) bool
+	SaveProfile(profile Profile) error
+	DeleteProfile(name string) error
+	StartProfile(ctx context.Context, alerter action.Alerter, name, contextName string) (CreateResponse, error)
+	StopProfile(name string)
 }
 
 // PortForwardPortSpec describes a forwarded port.
 type PortForwardPortSpec struct {
 	Remote uint16 `json:"remote"`
 	Local  uint16 `json:"local,omitempty"`
+	// RemoteName is the name of the remote port. If it is set, it is resolved
+	// against the pod's container ports, or the service's ports, instead of Remote.
+	RemoteName string `json:"remoteName,omitempty"`
 }
 
 // CreateResponse describes a port forward.
@@ -64,6 +96,8 @@ type CreateRequest struct {
 	Kind       string                `json:"kind"`
 	Name       string                `json:"name"`
 	Ports      []PortForwardPortSpec `json:"ports"`
+	// Pod is the name of the pod to forward to for a service or workload. It is optional.
+	Pod string `json:"pod,omitempty"`
 }
 
 // Target references a kubernetes object
@@ -80,6 +114,8 @@ type State struct {
 	Ports     []ForwardedPort
 	Target    Target
 	Pod       Target
+	// Profile is the name of the profile which started the port forward.
+	Profile string
 
 	cancel context.CancelFunc
 	ctx    context.Context
@@ -93,6 +129,7 @@ func (pf *State) Clone() State {
 		Ports:     make([]ForwardedPort, len(pf.Ports)),
 		Target:    pf.Target,
 		Pod:       pf.Pod,
+		Profile:   pf.Profile,
 		cancel:    pf.cancel,
 		ctx:       pf.ctx,
 	}
@@ -112,6 +149,10 @@ type ServiceOptions struct {
 	Config        *restclient.Config
 	ObjectStore   store.Store
 	PortForwarder portForwarder
+	// NewQueryer creates a queryer for finding the pods of services and workloads.
+	NewQueryer func() queryer.Queryer
+	// Profiles stores port forward profiles. Profiles are disabled if it is nil.
+	Profiles *ProfileStore
 }
 
 type forwarderEvent struct {
@@ -127,6 +168,10 @@ type Service struct {
 	cancel   context.CancelFunc
 	notifyCh chan forwarderEvent
 	state    States
+
+	// runningProfiles contains a cancel func for each running profile.
+	runningProfiles map[string]context.CancelFunc
+	profilesMu      sync.Mutex
 }
 
 // Check that struct satisfies interface
@@ -144,6 +189,7 @@ func New(ctx context.Context, opts ServiceOptions) *Service {
 		state: States{
 			portForwards: make(map[string]State),
 		},
+		runningProfiles: make(map[string]context.CancelFunc),
 	}
 }
 
@@ -163,11 +209,14 @@ func (s *Service) validateCreateRequest(r CreateRequest) error {
 		return errors.New("name field required")
 	}
 
-	if r.APIVersion != "v1" || (r.Kind != "Pod" && r.Kind != "Service") {
-		return errors.Errorf("port forwards only work with pods & services")
+	if apiVersion, ok := forwardableKinds[r.Kind]; !ok || r.APIVersion != apiVersion {
+		return errors.Errorf("port forwards only work with pods, services, deployments, stateful sets, replica sets & daemon sets")
 	}
 
 	for _, p := range r.Ports {
+		if p.RemoteName != "" {
+			continue
+		}
 		if p.Remote < 1 || p.Remote > 65535 {
 			return errors.Errorf("remote port out of range: %v", p.Remote)
 		}
@@ -177,88 +226,219 @@ func (s *Service) validateCreateRequest(r CreateRequest) error {
 }
 
 // resolvePod attempts to resolve a port forward request into an active pod we can
-// forward to. Service/deployments selectors will be resolved into pods and a random
-// one will be chosen. A pod has to be active.
-// Returns: pod name or error.
-func (s *Service) resolvePod(ctx context.Context, r CreateRequest) (string, error) {
-	o := s.opts.ObjectStore
-	if o == nil {
-		return "", errors.New("nil objectstore")
+// forward to. Services and workloads are resolved into their pods with the queryer,
+// and the ready pod with the fewest port forwards is chosen, unless the request
+// chooses a pod. A pod has to be ready.
+func (s *Service) resolvePod(ctx context.Context, r CreateRequest) (*corev1.Pod, error) {
+	if r.Kind == "Pod" {
+		pod, err := s.getPod(ctx, r.Namespace, r.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "verifying pod %q", r.Name)
+		}
+		if err := checkPodReady(pod); err != nil {
+			return nil, errors.Wrapf(err, "verifying pod %q", r.Name)
+		}
+		return pod, nil
 	}
 
-	switch {
-	case r.APIVersion == "v1" && r.Kind == "Pod":
-		// Verify pod exists and status is running
-		if ok, err := s.verifyPod(ctx, r.Namespace, r.Name); !ok || err != nil {
-			return "", errors.Errorf("verifying pod %q: %v", r.Name, err)
-		}
-		return r.Name, nil
-	case r.APIVersion == "v1" && r.Kind == "Service":
-		pod, err := s.findPodForService(ctx, r.APIVersion, r.Kind, r.Namespace, r.Name)
-		if err != nil {
-			return "", err
+	pods, err := s.podsForTarget(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Pod != "" {
+		for _, pod := range pods {
+			if pod.Name != r.Pod {
+				continue
+			}
+			if err := checkPodReady(pod); err != nil {
+				return nil, errors.Wrapf(err, "verifying pod %q", pod.Name)
+			}
+			return pod, nil
 		}
 
-		return pod.Name, nil
-	default:
-		return "", errors.New("not implemented")
+		return nil, errors.Errorf("pod %q does not belong to %s %q", r.Pod, r.Kind, r.Name)
 	}
 
+	pod := s.selectPod(pods)
+	if pod == nil {
+		return nil, errors.Errorf("no ready pods found for %s %q", r.Kind, r.Name)
+	}
+
+	return pod, nil
 }
 
-func (s *Service) findPodForService(ctx context.Context, apiVersion, kind, namespace, name string) (*corev1.Pod, error) {
+// podsForTarget returns the pods of the service or workload in a request.
+func (s *Service) podsForTarget(ctx context.Context, r CreateRequest) ([]*corev1.Pod, error) {
 	o := s.opts.ObjectStore
 	if o == nil {
 		return nil, errors.New("nil objectstore")
 	}
+	if s.opts.NewQueryer == nil {
+		return nil, errors.New("nil queryer")
+	}
 
 	key := store.Key{
-		APIVersion: apiVersion,
-		Kind:       kind,
-		Namespace:  namespace,
-		Name:       name,
+		APIVersion: r.APIVersion,
+		Kind:       r.Kind,
+		Namespace:  r.Namespace,
+		Name:       r.Name,
 	}
-	var service corev1.Service
-	found, err := store.GetAs(ctx, o, key, &service)
-
+	object, err := o.Get(ctx, key)
 	if err != nil {
 		return nil, err
 	}
-	if !found {
-		return nil, nil
+	if object == nil {
+		return nil, errors.Errorf("%s %q not found", r.Kind, r.Name)
 	}
 
-	lbls := labels.Set(service.Spec.Selector)
-	key = store.Key{
-		APIVersion: apiVersion,
-		Kind:       "Pod",
-		Namespace:  namespace,
-		Selector:   &lbls,
+	q := s.opts.NewQueryer()
+
+	if r.Kind == "Service" {
+		service := &corev1.Service{}
+		if err := kubernetes.FromUnstructured(object, service); err != nil {
+			return nil, err
+		}
+		return q.PodsForService(ctx, service)
 	}
-	list, _, err := o.List(ctx, key)
-	if err != nil {
+
+	var workload runtime.Object
+	switch r.Kind {
+	case "Deployment":
+		workload = &appsv1.Deployment{}
+	case "StatefulSet":
+		workload = &appsv1.StatefulSet{}
+	case "ReplicaSet":
+		workload = &appsv1.ReplicaSet{}
+	case "DaemonSet":
+		workload = &appsv1.DaemonSet{}
+	default:
+		return nil, errors.Errorf("unable to find pods for %s", r.Kind)
+	}
+
+	if err := kubernetes.FromUnstructured(object, workload); err != nil {
 		return nil, err
 	}
 
-	for i := range list.Items {
-		pod := &corev1.Pod{}
+	return q.PodsForWorkload(ctx, workload)
+}
+
+// selectPod selects the ready pod with the fewest port forwards. Ties are
+// broken by the fewest container restarts, and then by name. Returns nil if
+// no pods are ready.
+func (s *Service) selectPod(pods []*corev1.Pod) *corev1.Pod {
+	var ready []*corev1.Pod
+	for _, pod := range pods {
+		if checkPodReady(pod) == nil {
+			ready = append(ready, pod)
+		}
+	}
+
+	if len(ready) == 0 {
+		return nil
+	}
+
+	forwards := map[string]int{}
+	s.state.Lock()
+	for _, state := range s.state.portForwards {
+		forwards[state.Pod.Namespace+"/"+state.Pod.Name]++
+	}
+	s.state.Unlock()
+
+	sort.SliceStable(ready, func(i, j int) bool {
+		fi := forwards[ready[i].Namespace+"/"+ready[i].Name]
+		fj := forwards[ready[j].Namespace+"/"+ready[j].Name]
+		if fi != fj {
+			return fi < fj
+		}
+
+		ri, rj := podRestarts(ready[i]), podRestarts(ready[j])
+		if ri != rj {
+			return ri < rj
+		}
+
+		return ready[i].Name < ready[j].Name
+	})
+
+	return ready[0]
+}
 
-		if err := kubernetes.FromUnstructured(&list.Items[i], pod); err != nil {
+// resolvePorts resolves named ports in a request against the pod's container
+// ports. Named service ports resolve to their target port first.
+func (s *Service) resolvePorts(ctx context.Context, r CreateRequest, pod *corev1.Pod) ([]PortForwardPortSpec, error) {
+	ports := make([]PortForwardPortSpec, len(r.Ports))
+
+	for i, p := range r.Ports {
+		ports[i] = PortForwardPortSpec{Remote: p.Remote, Local: p.Local}
+		if p.RemoteName == "" {
+			continue
+		}
+
+		name := p.RemoteName
+
+		if r.Kind == "Service" {
+			servicePort, err := s.findServicePort(ctx, r, name)
+			if err != nil {
+				return nil, err
+			}
+
+			if servicePort != nil {
+				switch {
+				case servicePort.TargetPort.Type == intstr.String && servicePort.TargetPort.StrVal != "":
+					name = servicePort.TargetPort.StrVal
+				case servicePort.TargetPort.IntVal > 0:
+					ports[i].Remote = uint16(servicePort.TargetPort.IntVal)
+					continue
+				default:
+					ports[i].Remote = uint16(servicePort.Port)
+					continue
+				}
+			}
+		}
+
+		port, err := containerPort(pod, name)
+		if err != nil {
 			return nil, err
 		}
+		ports[i].Remote = port
+	}
 
-		return pod, nil
+	return ports, nil
+}
+
+// findServicePort finds a service port by name. Returns nil if the service
+// has no port with the name.
+func (s *Service) findServicePort(ctx context.Context, r CreateRequest, name string) (*corev1.ServicePort, error) {
+	key := store.Key{
+		APIVersion: r.APIVersion,
+		Kind:       r.Kind,
+		Namespace:  r.Namespace,
+		Name:       r.Name,
+	}
+
+	var service corev1.Service
+	found, err := store.GetAs(ctx, s.opts.ObjectStore, key, &service)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Errorf("service %q not found", r.Name)
 	}
 
-	return nil, errors.New("no matching pod found for service")
+	for i := range service.Spec.Ports {
+		if service.Spec.Ports[i].Name == name {
+			return &service.Spec.Ports[i], nil
+		}
+	}
+
+	return nil, nil
 }
 
-// verifyPod returns true if the specified pod can be found and is in the running phase.
-// Otherwise returns false and an error describing the cause.
-func (s *Service) verifyPod(ctx context.Context, namespace, name string) (bool, error) {
+// getPod gets a pod from the object store.
+func (s *Service) getPod(ctx context.Context, namespace, name string) (*corev1.Pod, error) {
 	o := s.opts.ObjectStore
 	if o == nil {
-		return false, errors.New("nil objectstore")
+		return nil, errors.New("nil objectstore")
 	}
 
 	key := store.Key{
@@ -267,30 +447,87 @@ func (s *Service) verifyPod(ctx context.Context, namespace, name string) (bool,
 		Namespace:  namespace,
 		Name:       name,
 	}
-	var pod corev1.Pod
-	found, err := store.GetAs(ctx, o, key, &pod)
+	pod := &corev1.Pod{}
+	found, err := store.GetAs(ctx, o, key, pod)
+	if err != nil {
+		return nil, err
+	}
+	if !found || pod.Name == "" {
+		return nil, errors.New("pod not found")
+	}
+
+	return pod, nil
+}
+
+// verifyPod returns true if the specified pod can be found and is running.
+// Otherwise returns false and an error describing the cause.
+func (s *Service) verifyPod(ctx context.Context, namespace, name string) (bool, error) {
+	pod, err := s.getPod(ctx, namespace, name)
 	if err != nil {
 		return false, err
 	}
-	if !found {
-		return false, nil
+
+	if err := checkPodRunning(pod); err != nil {
+		return false, err
 	}
 
-	if pod.Name == "" {
-		return false, errors.New("pod not found")
+	return true, nil
+}
+
+// checkPodRunning returns an error if a pod is terminating or isn't running.
+func checkPodRunning(pod *corev1.Pod) error {
+	if pod.DeletionTimestamp != nil {
+		return errors.New("pod is terminating")
 	}
 
 	if pod.Status.Phase != corev1.PodRunning {
-		return false, errors.Errorf("pod not running, phase=%v", pod.Status.Phase)
+		return errors.Errorf("pod not running, phase=%v", pod.Status.Phase)
 	}
 
-	return true, nil
+	return nil
+}
+
+// checkPodReady returns an error if a pod is terminating, isn't running, or
+// has containers which aren't ready.
+func checkPodReady(pod *corev1.Pod) error {
+	if err := checkPodRunning(pod); err != nil {
+		return err
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if !status.Ready {
+			return errors.Errorf("container %q is not ready", status.Name)
+		}
+	}
+
+	return nil
+}
+
+func podRestarts(pod *corev1.Pod) int32 {
+	var restarts int32
+	for _, status := range pod.Status.ContainerStatuses {
+		restarts += status.RestartCount
+	}
+	return restarts
+}
+
+// containerPort finds a container port in a pod by name.
+func containerPort(pod *corev1.Pod, name string) (uint16, error) {
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == name {
+				return uint16(p.ContainerPort), nil
+			}
+		}
+	}
+
+	return 0, errors.Errorf("pod %q does not have a port named %q", pod.Name, name)
 }
 
 // createForwarder creates a port forwarder, forwards traffic, and blocks until
 // port state information is populated.
 // Returns forwarder id.
-func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequest CreateRequest) (string, error) {
+func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequest CreateRequest, profile string) (string, error) {
 	logger := s.logger.With("context", "PortForwardService.createForwarder")
 
 	if s.opts.PortForwarder == nil {
@@ -356,6 +593,7 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 			Namespace: podRequest.Namespace,
 			Name:      podRequest.Name,
 		},
+		Profile: profile,
 
 		cancel: cancel,
 		ctx:    ctx,
@@ -389,7 +627,7 @@ func (s *Service) createForwarder(alerter action.Alerter, targetRequest, podRequ
 		}
 
 		// Cleanup state for terminated port-forward
-		s.StopForwarder(forwarderID)
+		s.stopForwarder(forwarderID)
 	}()
 
 	// Block until ports state is ready
@@ -496,11 +734,34 @@ func (s *Service) Get(id string) (State, bool) {
 // Create creates a new port forward for the specified object and remote port.
 // Implements PortForwardInterface.
 func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema.GroupVersionKind, name string, namespace string, remotePort uint16) (CreateResponse, error) {
+	return s.CreateFromRequest(ctx, alerter, newForwardRequest(gvk, name, namespace, remotePort))
+}
+
+// CreateFromRequest creates a new port forward for a request. The request can
+// choose a pod of a service or workload, and can contain named ports.
+// Implements PortForwardInterface.
+func (s *Service) CreateFromRequest(ctx context.Context, alerter action.Alerter, req CreateRequest) (CreateResponse, error) {
+	id, err := s.create(ctx, alerter, req, "")
+	if err != nil {
+		return emptyPortForwardResponse, err
+	}
+
+	// Compose response based on forwarder state
+	response, err := s.responseForCreate(id)
+	if err != nil {
+		return emptyPortForwardResponse, errors.Wrapf(err, "fetching state for forwarder: %v", id)
+	}
+
+	return response, nil
+}
+
+// create resolves a request into a pod and creates a forwarder for it.
+// Returns forwarder id.
+func (s *Service) create(ctx context.Context, alerter action.Alerter, req CreateRequest, profile string) (string, error) {
 	logger := s.logger.With("context", "PortForwardService.Create")
-	req := newForwardRequest(gvk, name, namespace, remotePort)
 
 	if err := s.validateCreateRequest(req); err != nil {
-		return emptyPortForwardResponse, errors.Wrap(err, "invalid request")
+		return "", errors.Wrap(err, "invalid request")
 	}
 
 	// Resolve the request into a pod, update the request
@@ -510,38 +771,43 @@ func (s *Service) Create(ctx context.Context, alerter action.Alerter, gvk schema
 		"name", req.Name,
 		"namespace", req.Namespace,
 	).Debugf("resolving pod from object")
-	podName, err := s.resolvePod(ctx, req)
+	pod, err := s.resolvePod(ctx, req)
+	if err != nil {
+		return "", errors.Wrap(err, "resolving pod")
+	}
+	logger.Debugf("resolved to pod %q", pod.Name)
+
+	ports, err := s.resolvePorts(ctx, req, pod)
 	if err != nil {
-		return emptyPortForwardResponse, errors.Wrap(err, "resolving pod")
+		return "", errors.Wrap(err, "resolving ports")
 	}
-	logger.Debugf("resolved to pod %q", podName)
-	podReq := req
-	podReq.Name = podName
-	podReq.Kind = "Pod"
 
 	id, err := s.createForwarder(alerter, req, CreateRequest{
 		Namespace:  req.Namespace,
-		APIVersion: req.APIVersion,
+		APIVersion: "v1",
 		Kind:       "Pod",
-		Name:       podName,
-		Ports:      req.Ports,
-	})
+		Name:       pod.Name,
+		Ports:      ports,
+	}, profile)
 	if err != nil {
-		return emptyPortForwardResponse, errors.Wrap(err, "creating forwarder")
+		return "", errors.Wrap(err, "creating forwarder")
 	}
 
-	// Compose response based on forwarder state
-	response, err := s.responseForCreate(id)
-	if err != nil {
-		return emptyPortForwardResponse, errors.Wrapf(err, "fetching state for forwarder: %v", id)
-	}
-
-	return response, nil
+	return id, nil
 }
 
-// StopForwarder stops an individual port forward specified by id.
+// StopForwarder stops an individual port forward specified by id. If the
+// port forward was started by a profile, the profile is stopped as well.
 // Implements PortForwardInterface.
 func (s *Service) StopForwarder(id string) {
+	if state, ok := s.Get(id); ok && state.Profile != "" {
+		s.StopProfile(state.Profile)
+	}
+
+	s.stopForwarder(id)
+}
+
+func (s *Service) stopForwarder(id string) {
 	s.state.Lock()
 	defer s.state.Unlock()
 
@@ -557,6 +823,212 @@ func (s *Service) StopForwarder(id string) {
 	delete(s.state.portForwards, id)
 }
 
+// Profiles lists the saved port forward profiles.
+func (s *Service) Profiles() ([]Profile, error) {
+	profiles, err := s.profileStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles.List()
+}
+
+// ProfileRunning returns true if the named profile is running.
+func (s *Service) ProfileRunning(name string) bool {
+	s.profilesMu.Lock()
+	defer s.profilesMu.Unlock()
+
+	_, ok := s.runningProfiles[name]
+	return ok
+}
+
+// SaveProfile saves a port forward profile.
+func (s *Service) SaveProfile(profile Profile) error {
+	profiles, err := s.profileStore()
+	if err != nil {
+		return err
+	}
+
+	return profiles.Save(profile)
+}
+
+// DeleteProfile stops and deletes a port forward profile.
+func (s *Service) DeleteProfile(name string) error {
+	profiles, err := s.profileStore()
+	if err != nil {
+		return err
+	}
+
+	s.StopProfile(name)
+	return profiles.Delete(name)
+}
+
+// StartProfile starts a port forward from a profile. The port forward is
+// restarted with a newly resolved pod when its pod goes away, until the
+// profile is stopped. Profiles saved in another kube context are not started.
+func (s *Service) StartProfile(ctx context.Context, alerter action.Alerter, name, contextName string) (CreateResponse, error) {
+	profiles, err := s.profileStore()
+	if err != nil {
+		return emptyPortForwardResponse, err
+	}
+
+	profile, found, err := profiles.Get(name)
+	if err != nil {
+		return emptyPortForwardResponse, err
+	}
+	if !found {
+		return emptyPortForwardResponse, errors.Errorf("port forward profile %q not found", name)
+	}
+	if !profile.InContext(contextName) {
+		return emptyPortForwardResponse, errors.Errorf("port forward profile %q was saved in context %q", name, profile.Context)
+	}
+
+	profileCtx, cancel := context.WithCancel(s.ctx)
+
+	s.profilesMu.Lock()
+	if _, ok := s.runningProfiles[name]; ok {
+		s.profilesMu.Unlock()
+		cancel()
+		return emptyPortForwardResponse, errors.Errorf("port forward profile %q is already running", name)
+	}
+	s.runningProfiles[name] = cancel
+	s.profilesMu.Unlock()
+
+	id, err := s.create(ctx, alerter, profile.request(), profile.Name)
+	if err != nil {
+		s.StopProfile(name)
+		return emptyPortForwardResponse, err
+	}
+
+	go s.superviseProfile(profileCtx, alerter, profile, id)
+
+	response, err := s.responseForCreate(id)
+	if err != nil {
+		return emptyPortForwardResponse, errors.Wrapf(err, "fetching state for forwarder: %v", id)
+	}
+
+	return response, nil
+}
+
+// StopProfile stops a running port forward profile.
+func (s *Service) StopProfile(name string) {
+	s.profilesMu.Lock()
+	defer s.profilesMu.Unlock()
+
+	if cancel, ok := s.runningProfiles[name]; ok {
+		cancel()
+		delete(s.runningProfiles, name)
+	}
+}
+
+func (s *Service) profileStore() (*ProfileStore, error) {
+	if s.opts.Profiles == nil {
+		return nil, errors.New("port forward profiles are not configured")
+	}
+
+	return s.opts.Profiles, nil
+}
+
+// superviseProfile watches the pod of a profile's port forward, and creates a
+// new port forward when the pod is deleted or terminating, or has not been
+// ready for the grace period. The port forward is stopped when ctx is
+// cancelled.
+func (s *Service) superviseProfile(ctx context.Context, alerter action.Alerter, profile Profile, id string) {
+	logger := s.logger.With("context", "PortForwardService.superviseProfile", "profile", profile.Name)
+
+	// The object store removes the handler once the profile is stopped.
+	changed := make(chan struct{}, 1)
+	notify := func(interface{}) {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	handler := kcache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, newObj interface{}) { notify(newObj) },
+		DeleteFunc: notify,
+	}
+
+	if s.opts.ObjectStore != nil {
+		podKey := store.Key{Namespace: profile.Namespace, APIVersion: "v1", Kind: "Pod"}
+		if err := s.opts.ObjectStore.Watch(ctx, podKey, handler); err != nil {
+			logger.WithErr(err).Errorf("unable to watch pods for port forward profile")
+		}
+	}
+
+	var notReadySince time.Time
+	var graceCh, retryCh <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.stopForwarder(id)
+			return
+		case <-changed:
+		case <-s.forwarderDone(id):
+		case <-graceCh:
+		case <-retryCh:
+		}
+
+		if id != "" {
+			state, ok := s.Get(id)
+			if ok {
+				pod, err := s.getPod(ctx, state.Pod.Namespace, state.Pod.Name)
+				if err == nil {
+					err = checkPodRunning(pod)
+				}
+				if err == nil {
+					if checkPodReady(pod) == nil {
+						notReadySince, graceCh = time.Time{}, nil
+						continue
+					}
+
+					if notReadySince.IsZero() {
+						notReadySince = time.Now()
+						graceCh = time.After(profileReadyGracePeriod)
+					}
+					if time.Since(notReadySince) < profileReadyGracePeriod {
+						continue
+					}
+					err = errors.Errorf("pod has not been ready for %s", profileReadyGracePeriod)
+				}
+
+				logger.WithErr(err).Infof("pod %q is no longer available, reconnecting", state.Pod.Name)
+				s.stopForwarder(id)
+			}
+
+			id = ""
+			notReadySince, graceCh = time.Time{}, nil
+		}
+
+		newID, err := s.create(ctx, alerter, profile.request(), profile.Name)
+		if err != nil {
+			logger.WithErr(err).Debugf("unable to reconnect port forward")
+			retryCh = time.After(profileRetryInterval)
+			continue
+		}
+		id, retryCh = newID, nil
+	}
+}
+
+// forwarderDone returns a channel which is closed when a port forward stops.
+// It returns nil if id is empty.
+func (s *Service) forwarderDone(id string) <-chan struct{} {
+	if id == "" {
+		return nil
+	}
+
+	state, ok := s.Get(id)
+	if !ok || state.ctx == nil {
+		done := make(chan struct{})
+		close(done)
+		return done
+	}
+
+	return state.ctx.Done()
+}
+
 type notFound struct{}
 
 // Check that struct satisfies interface
@@ -0,0 +1,332 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package portforward
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/octant/internal/queryer"
+	queryerFake "github.com/vmware-tanzu/octant/internal/queryer/fake"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	storeFake "github.com/vmware-tanzu/octant/pkg/store/fake"
+)
+
+func readyPod(name string, restarts int32) *corev1.Pod {
+	return testutil.CreatePod(name, func(pod *corev1.Pod) {
+		pod.Spec.Containers = []corev1.Container{
+			{
+				Name: "app",
+				Ports: []corev1.ContainerPort{
+					{Name: "http", ContainerPort: 8080},
+				},
+			},
+		}
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+			{Name: "app", Ready: true, RestartCount: restarts},
+		}
+	})
+}
+
+func TestService_validateCreateRequest(t *testing.T) {
+	cases := []struct {
+		name       string
+		apiVersion string
+		kind       string
+		ports      []PortForwardPortSpec
+		isErr      bool
+	}{
+		{name: "pod", apiVersion: "v1", kind: "Pod", ports: []PortForwardPortSpec{{Remote: 80}}},
+		{name: "service", apiVersion: "v1", kind: "Service", ports: []PortForwardPortSpec{{Remote: 80}}},
+		{name: "deployment", apiVersion: "apps/v1", kind: "Deployment", ports: []PortForwardPortSpec{{Remote: 80}}},
+		{name: "stateful set", apiVersion: "apps/v1", kind: "StatefulSet", ports: []PortForwardPortSpec{{Remote: 80}}},
+		{name: "replica set", apiVersion: "apps/v1", kind: "ReplicaSet", ports: []PortForwardPortSpec{{Remote: 80}}},
+		{name: "daemon set", apiVersion: "apps/v1", kind: "DaemonSet", ports: []PortForwardPortSpec{{Remote: 80}}},
+		{name: "named port", apiVersion: "apps/v1", kind: "Deployment", ports: []PortForwardPortSpec{{RemoteName: "http"}}},
+		{name: "wrong api version", apiVersion: "v1", kind: "Deployment", ports: []PortForwardPortSpec{{Remote: 80}}, isErr: true},
+		{name: "unsupported kind", apiVersion: "batch/v1", kind: "Job", ports: []PortForwardPortSpec{{Remote: 80}}, isErr: true},
+		{name: "missing port", apiVersion: "v1", kind: "Pod", ports: []PortForwardPortSpec{{}}, isErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := New(context.Background(), ServiceOptions{})
+			err := s.validateCreateRequest(CreateRequest{
+				Namespace:  "default",
+				APIVersion: tc.apiVersion,
+				Kind:       tc.kind,
+				Name:       "name",
+				Ports:      tc.ports,
+			})
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_checkPodReady(t *testing.T) {
+	now := metav1.Now()
+
+	cases := []struct {
+		name  string
+		pod   func() *corev1.Pod
+		isErr bool
+	}{
+		{
+			name: "ready",
+			pod:  func() *corev1.Pod { return readyPod("pod", 0) },
+		},
+		{
+			name: "terminating",
+			pod: func() *corev1.Pod {
+				pod := readyPod("pod", 0)
+				pod.DeletionTimestamp = &now
+				return pod
+			},
+			isErr: true,
+		},
+		{
+			name: "pending",
+			pod: func() *corev1.Pod {
+				pod := readyPod("pod", 0)
+				pod.Status.Phase = corev1.PodPending
+				return pod
+			},
+			isErr: true,
+		},
+		{
+			name: "container not ready",
+			pod: func() *corev1.Pod {
+				pod := readyPod("pod", 0)
+				pod.Status.ContainerStatuses[0].Ready = false
+				return pod
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkPodReady(tc.pod())
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_checkPodRunning(t *testing.T) {
+	now := metav1.Now()
+
+	cases := []struct {
+		name  string
+		pod   func() *corev1.Pod
+		isErr bool
+	}{
+		{
+			name: "running",
+			pod:  func() *corev1.Pod { return readyPod("pod", 0) },
+		},
+		{
+			name: "container not ready",
+			pod: func() *corev1.Pod {
+				pod := readyPod("pod", 0)
+				pod.Status.ContainerStatuses[0].Ready = false
+				return pod
+			},
+		},
+		{
+			name: "terminating",
+			pod: func() *corev1.Pod {
+				pod := readyPod("pod", 0)
+				pod.DeletionTimestamp = &now
+				return pod
+			},
+			isErr: true,
+		},
+		{
+			name: "pending",
+			pod: func() *corev1.Pod {
+				pod := readyPod("pod", 0)
+				pod.Status.Phase = corev1.PodPending
+				return pod
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkPodRunning(tc.pod())
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_selectPod(t *testing.T) {
+	notReady := readyPod("a", 0)
+	notReady.Status.ContainerStatuses[0].Ready = false
+	restarted := readyPod("b", 3)
+	forwarded := readyPod("c", 0)
+	idle := readyPod("d", 0)
+
+	s := New(context.Background(), ServiceOptions{})
+	s.state.portForwards["id"] = State{
+		Pod: Target{Namespace: forwarded.Namespace, Name: forwarded.Name},
+	}
+
+	got := s.selectPod([]*corev1.Pod{notReady, restarted, forwarded, idle})
+	require.NotNil(t, got)
+	assert.Equal(t, "d", got.Name)
+
+	got = s.selectPod([]*corev1.Pod{notReady, restarted, forwarded})
+	require.NotNil(t, got)
+	assert.Equal(t, "b", got.Name)
+
+	assert.Nil(t, s.selectPod([]*corev1.Pod{notReady}))
+}
+
+func TestService_resolvePod_workload(t *testing.T) {
+	deployment := testutil.CreateDeployment("web")
+
+	notReady := readyPod("web-1", 0)
+	notReady.Status.ContainerStatuses[0].Ready = false
+	ready := readyPod("web-2", 0)
+
+	cases := []struct {
+		name     string
+		pod      string
+		expected string
+		isErr    bool
+	}{
+		{name: "select ready pod", expected: "web-2"},
+		{name: "choose pod", pod: "web-2", expected: "web-2"},
+		{name: "choose pod which is not ready", pod: "web-1", isErr: true},
+		{name: "choose pod which does not belong to the workload", pod: "other", isErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := storeFake.NewMockStore(controller)
+			key := store.Key{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Namespace:  deployment.Namespace,
+				Name:       deployment.Name,
+			}
+			objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, deployment), nil)
+
+			q := queryerFake.NewMockQueryer(controller)
+			q.EXPECT().PodsForWorkload(gomock.Any(), gomock.Any()).Return([]*corev1.Pod{notReady, ready}, nil)
+
+			s := New(context.Background(), ServiceOptions{
+				ObjectStore: objectStore,
+				NewQueryer:  func() queryer.Queryer { return q },
+			})
+
+			got, err := s.resolvePod(context.Background(), CreateRequest{
+				Namespace:  deployment.Namespace,
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+				Pod:        tc.pod,
+			})
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got.Name)
+		})
+	}
+}
+
+func TestService_resolvePorts(t *testing.T) {
+	pod := readyPod("web-1", 0)
+
+	service := testutil.CreateService("web")
+	service.Spec.Ports = []corev1.ServicePort{
+		{Name: "http", Port: 80, TargetPort: intstr.FromString("http")},
+		{Name: "metrics", Port: 9090, TargetPort: intstr.FromInt(9091)},
+		{Name: "admin", Port: 9000},
+	}
+
+	cases := []struct {
+		name     string
+		kind     string
+		port     PortForwardPortSpec
+		expected uint16
+		isErr    bool
+	}{
+		{name: "numbered port", kind: "Pod", port: PortForwardPortSpec{Remote: 80}, expected: 80},
+		{name: "container port name", kind: "Pod", port: PortForwardPortSpec{RemoteName: "http"}, expected: 8080},
+		{name: "unknown container port name", kind: "Pod", port: PortForwardPortSpec{RemoteName: "grpc"}, isErr: true},
+		{name: "service port with named target", kind: "Service", port: PortForwardPortSpec{RemoteName: "http"}, expected: 8080},
+		{name: "service port with numbered target", kind: "Service", port: PortForwardPortSpec{RemoteName: "metrics"}, expected: 9091},
+		{name: "service port without target", kind: "Service", port: PortForwardPortSpec{RemoteName: "admin"}, expected: 9000},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			objectStore := storeFake.NewMockStore(controller)
+			if tc.kind == "Service" {
+				key := store.Key{
+					APIVersion: "v1",
+					Kind:       "Service",
+					Namespace:  service.Namespace,
+					Name:       service.Name,
+				}
+				objectStore.EXPECT().Get(gomock.Any(), key).Return(testutil.ToUnstructured(t, service), nil)
+			}
+
+			s := New(context.Background(), ServiceOptions{ObjectStore: objectStore})
+
+			name := pod.Name
+			if tc.kind == "Service" {
+				name = service.Name
+			}
+
+			got, err := s.resolvePorts(context.Background(), CreateRequest{
+				Namespace:  pod.Namespace,
+				APIVersion: "v1",
+				Kind:       tc.kind,
+				Name:       name,
+				Ports:      []PortForwardPortSpec{tc.port},
+			}, pod)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, tc.expected, got[0].Remote)
+		})
+	}
+}
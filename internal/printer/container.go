@@ -236,6 +236,21 @@ func isPodGVK(gvk schema.GroupVersionKind) bool {
 	return gvk.Group == "" && gvk.Version == "v1" && gvk.Kind == "Pod"
 }
 
+// isWorkloadGVK returns true if the gvk is a workload which can be port forwarded
+// through one of its pods.
+func isWorkloadGVK(gvk schema.GroupVersionKind) bool {
+	if gvk.Group != "apps" || gvk.Version != "v1" {
+		return false
+	}
+
+	switch gvk.Kind {
+	case "Deployment", "StatefulSet", "ReplicaSet", "DaemonSet":
+		return true
+	default:
+		return false
+	}
+}
+
 type notFound interface {
 	NotFound() bool
 }
@@ -252,7 +267,8 @@ func describeContainerPorts(
 	var err error
 	gvk := parent.GetObjectKind().GroupVersionKind()
 	isPod := isPodGVK(gvk)
-	if isPod {
+	isWorkload := isWorkloadGVK(gvk)
+	if isPod || isWorkload {
 		accessor := meta.NewAccessor()
 		namespace, err = accessor.Namespace(parent)
 		if err != nil {
@@ -265,7 +281,12 @@ func describeContainerPorts(
 		}
 	}
 
-	states, err := portForwardService.FindPod(namespace, gvk, name)
+	var states []portforward.State
+	if isWorkload {
+		states, err = portForwardService.FindTarget(namespace, gvk, name)
+	} else {
+		states, err = portForwardService.FindPod(namespace, gvk, name)
+	}
 	if err != nil {
 		if _, ok := err.(notFound); !ok {
 			return nil, errors.Wrap(err, "query port forward service for pod")
@@ -288,7 +309,7 @@ func describeContainerPorts(
 		pfs := component.PortForwardState{}
 		var port *component.Port
 
-		if isPod && cPort.Protocol == corev1.ProtocolTCP {
+		if (isPod || isWorkload) && cPort.Protocol == corev1.ProtocolTCP {
 			pfs.IsForwardable = true
 		}
 
@@ -519,6 +540,7 @@ func editContainerAction(owner runtime.Object, container *corev1.Container) (com
 		component.NewFormFieldText("Image", "containerImage", container.Image),
 		component.NewFormFieldHidden("containersPath", string(containersPathData)),
 		component.NewFormFieldHidden("containerName", container.Name),
+		octant.ForceApplyFormField(),
 	)
 	if err != nil {
 		return component.Action{}, err
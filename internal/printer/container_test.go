@@ -474,6 +474,7 @@ func Test_editContainerAction(t *testing.T) {
 		component.NewFormFieldText("Image", "containerImage", container.Image),
 		component.NewFormFieldHidden("containersPath", `["spec","template","spec","containers"]`),
 		component.NewFormFieldHidden("containerName", container.Name),
+		octant.ForceApplyFormField(),
 	)
 	require.NoError(t, err)
 
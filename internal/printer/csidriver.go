@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// CSIDriverListHandler is a printFunc that prints CSI drivers
+func CSIDriverListHandler(ctx context.Context, list *storagev1.CSIDriverList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("csi driver list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Attach Required", "Pod Info On Mount", "Modes", "Age")
+	ot := NewObjectTable("CSI Drivers", "We couldn't find any CSI drivers!", cols, options.DashConfig.ObjectStore())
+
+	for _, csiDriver := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&csiDriver, csiDriver.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Attach Required"] = component.NewText(formatOptionalBool(csiDriver.Spec.AttachRequired))
+		row["Pod Info On Mount"] = component.NewText(formatOptionalBool(csiDriver.Spec.PodInfoOnMount))
+		row["Modes"] = component.NewText(csiDriverModes(&csiDriver))
+		row["Age"] = component.NewTimestamp(csiDriver.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &csiDriver, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// CSIDriverHandler is a printFunc that prints a CSIDriver
+func CSIDriverHandler(ctx context.Context, csiDriver *storagev1.CSIDriver, options Options) (component.Component, error) {
+	o := NewObject(csiDriver)
+	o.EnableEvents()
+
+	ch, err := newCSIDriverHandler(csiDriver, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ch.Config(); err != nil {
+		return nil, errors.Wrap(err, "print csidriver configuration")
+	}
+
+	if err := ch.StorageClasses(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print csidriver storage classes")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// CSIDriverConfiguration generates a CSI driver configuration
+type CSIDriverConfiguration struct {
+	csiDriver *storagev1.CSIDriver
+}
+
+// NewCSIDriverConfiguration creates an instance of CSIDriverConfiguration
+func NewCSIDriverConfiguration(csiDriver *storagev1.CSIDriver) *CSIDriverConfiguration {
+	return &CSIDriverConfiguration{
+		csiDriver: csiDriver,
+	}
+}
+
+// Create creates a CSI driver configuration summary
+func (c *CSIDriverConfiguration) Create() (*component.Summary, error) {
+	if c.csiDriver == nil {
+		return nil, errors.New("csi driver is nil")
+	}
+
+	csiDriver := c.csiDriver
+
+	sections := component.SummarySections{}
+	sections.AddText("Attach Required", formatOptionalBool(csiDriver.Spec.AttachRequired))
+	sections.AddText("Pod Info On Mount", formatOptionalBool(csiDriver.Spec.PodInfoOnMount))
+	sections.AddText("Volume Lifecycle Modes", csiDriverModes(csiDriver))
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createCSIDriverStorageClassesView(ctx context.Context, csiDriver *storagev1.CSIDriver, options Options) (component.Component, error) {
+	if csiDriver == nil {
+		return nil, errors.New("unable to list storage classes for a nil csi driver")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	ul, _, err := objectStore.List(ctx, store.KeyFromGroupVersionKind(gvk.StorageClass))
+	if err != nil {
+		return nil, errors.Wrap(err, "list storage classes")
+	}
+
+	list := &storagev1.StorageClassList{}
+	for i := range ul.Items {
+		storageClass := storagev1.StorageClass{}
+		if err := kubernetes.FromUnstructured(&ul.Items[i], &storageClass); err != nil {
+			return nil, err
+		}
+
+		if storageClass.Provisioner == csiDriver.Name {
+			list.Items = append(list.Items, storageClass)
+		}
+	}
+
+	return StorageClassListHandler(ctx, list, options)
+}
+
+func csiDriverModes(csiDriver *storagev1.CSIDriver) string {
+	if len(csiDriver.Spec.VolumeLifecycleModes) == 0 {
+		return string(storagev1.VolumeLifecyclePersistent)
+	}
+
+	var modes []string
+	for _, mode := range csiDriver.Spec.VolumeLifecycleModes {
+		modes = append(modes, string(mode))
+	}
+
+	return strings.Join(modes, ", ")
+}
+
+func formatOptionalBool(b *bool) string {
+	if b == nil {
+		return "<unset>"
+	}
+
+	return fmt.Sprintf("%t", *b)
+}
+
+type csiDriverObject interface {
+	Config() error
+	StorageClasses(ctx context.Context, options Options) error
+}
+
+type csiDriverHandler struct {
+	csiDriver          *storagev1.CSIDriver
+	configFunc         func(*storagev1.CSIDriver) (*component.Summary, error)
+	storageClassesFunc func(context.Context, *storagev1.CSIDriver, Options) (component.Component, error)
+	object             *Object
+}
+
+var _ csiDriverObject = (*csiDriverHandler)(nil)
+
+func newCSIDriverHandler(csiDriver *storagev1.CSIDriver, object *Object) (*csiDriverHandler, error) {
+	if csiDriver == nil {
+		return nil, errors.New("can't print a nil csi driver")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print csi driver using a nil object printer")
+	}
+
+	ch := &csiDriverHandler{
+		csiDriver:          csiDriver,
+		configFunc:         defaultCSIDriverConfig,
+		storageClassesFunc: defaultCSIDriverStorageClasses,
+		object:             object,
+	}
+
+	return ch, nil
+}
+
+func (c *csiDriverHandler) Config() error {
+	out, err := c.configFunc(c.csiDriver)
+	if err != nil {
+		return err
+	}
+
+	c.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultCSIDriverConfig(csiDriver *storagev1.CSIDriver) (*component.Summary, error) {
+	return NewCSIDriverConfiguration(csiDriver).Create()
+}
+
+func (c *csiDriverHandler) StorageClasses(ctx context.Context, options Options) error {
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.storageClassesFunc(ctx, c.csiDriver, options)
+		},
+	})
+
+	return nil
+}
+
+func defaultCSIDriverStorageClasses(ctx context.Context, csiDriver *storagev1.CSIDriver, options Options) (component.Component, error) {
+	return createCSIDriverStorageClassesView(ctx, csiDriver, options)
+}
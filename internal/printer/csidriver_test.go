@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_CSIDriverListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	csiDriver := testutil.CreateCSIDriver("csi.example.com")
+	csiDriver.CreationTimestamp = metav1.Time{Time: testutil.Time()}
+	csiDriver.Spec.AttachRequired = pointer.BoolPtr(true)
+	csiDriver.Spec.VolumeLifecycleModes = []storagev1.VolumeLifecycleMode{
+		storagev1.VolumeLifecyclePersistent,
+		storagev1.VolumeLifecycleEphemeral,
+	}
+
+	tpo.PathForObject(csiDriver, csiDriver.Name, "/csi-driver")
+
+	list := &storagev1.CSIDriverList{
+		Items: []storagev1.CSIDriver{*csiDriver},
+	}
+
+	ctx := context.Background()
+	got, err := CSIDriverListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Attach Required", "Pod Info On Mount", "Modes", "Age")
+	expected := component.NewTable("CSI Drivers", "We couldn't find any CSI drivers!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", csiDriver.Name, "/csi-driver",
+			genObjectStatus(component.TextStatusOK, []string{"storage.k8s.io/v1 CSIDriver is OK"})),
+		"Attach Required":   component.NewText("true"),
+		"Pod Info On Mount": component.NewText("<unset>"),
+		"Modes":             component.NewText("Persistent, Ephemeral"),
+		"Age":               component.NewTimestamp(testutil.Time()),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, csiDriver),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_CSIDriverConfiguration(t *testing.T) {
+	csiDriver := testutil.CreateCSIDriver("csi.example.com")
+	csiDriver.Spec.PodInfoOnMount = pointer.BoolPtr(false)
+
+	cases := []struct {
+		name      string
+		csiDriver *storagev1.CSIDriver
+		expected  component.Component
+		isErr     bool
+	}{
+		{
+			name:      "general",
+			csiDriver: csiDriver,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Attach Required",
+					Content: component.NewText("<unset>"),
+				},
+				{
+					Header:  "Pod Info On Mount",
+					Content: component.NewText("false"),
+				},
+				{
+					Header:  "Volume Lifecycle Modes",
+					Content: component.NewText("Persistent"),
+				},
+			}...),
+		},
+		{
+			name:      "csi driver is nil",
+			csiDriver: nil,
+			isErr:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cc := NewCSIDriverConfiguration(tc.csiDriver)
+
+			summary, err := cc.Create()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
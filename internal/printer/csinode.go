@@ -0,0 +1,200 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// CSINodeListHandler is a printFunc that prints CSI nodes
+func CSINodeListHandler(ctx context.Context, list *storagev1.CSINodeList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("csi node list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Drivers", "Age")
+	ot := NewObjectTable("CSI Nodes", "We couldn't find any CSI nodes!", cols, options.DashConfig.ObjectStore())
+
+	for _, csiNode := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&csiNode, csiNode.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Drivers"] = component.NewText(fmt.Sprintf("%d", len(csiNode.Spec.Drivers)))
+		row["Age"] = component.NewTimestamp(csiNode.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &csiNode, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// CSINodeHandler is a printFunc that prints a CSINode
+func CSINodeHandler(ctx context.Context, csiNode *storagev1.CSINode, options Options) (component.Component, error) {
+	o := NewObject(csiNode)
+	o.EnableEvents()
+
+	ch, err := newCSINodeHandler(csiNode, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ch.Config(options); err != nil {
+		return nil, errors.Wrap(err, "print csinode configuration")
+	}
+
+	if err := ch.Drivers(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print csinode drivers")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// CSINodeConfiguration generates a CSI node configuration
+type CSINodeConfiguration struct {
+	csiNode *storagev1.CSINode
+}
+
+// NewCSINodeConfiguration creates an instance of CSINodeConfiguration
+func NewCSINodeConfiguration(csiNode *storagev1.CSINode) *CSINodeConfiguration {
+	return &CSINodeConfiguration{
+		csiNode: csiNode,
+	}
+}
+
+// Create creates a CSI node configuration summary
+func (c *CSINodeConfiguration) Create(options Options) (*component.Summary, error) {
+	if c.csiNode == nil {
+		return nil, errors.New("csi node is nil")
+	}
+
+	sections := component.SummarySections{}
+	sections.Add("Node", nodeLink(c.csiNode.Name, options))
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createCSINodeDriversView(ctx context.Context, csiNode *storagev1.CSINode, options Options) (*component.Table, error) {
+	if csiNode == nil {
+		return nil, errors.New("unable to generate drivers for a nil csi node")
+	}
+
+	cols := component.NewTableCols("Name", "Node ID", "Topology Keys", "Allocatable Volumes")
+	table := component.NewTable("Drivers", "There are no CSI drivers registered on this node!", cols)
+
+	for _, driver := range csiNode.Spec.Drivers {
+		name, err := csiDriverLink(ctx, driver.Name, options)
+		if err != nil {
+			return nil, err
+		}
+
+		allocatable := "<unset>"
+		if driver.Allocatable != nil && driver.Allocatable.Count != nil {
+			allocatable = fmt.Sprintf("%d", *driver.Allocatable.Count)
+		}
+
+		table.Add(component.TableRow{
+			"Name":                name,
+			"Node ID":             component.NewText(driver.NodeID),
+			"Topology Keys":       component.NewText(strings.Join(driver.TopologyKeys, ", ")),
+			"Allocatable Volumes": component.NewText(allocatable),
+		})
+	}
+
+	return table, nil
+}
+
+// nodeLink creates a link to a node. If the link can't be generated, the
+// node name is returned as text.
+func nodeLink(name string, options Options) component.Component {
+	if name == "" {
+		return component.NewText("")
+	}
+
+	apiVersion, kind := gvk.Node.ToAPIVersionAndKind()
+	link, err := options.Link.ForGVK("", apiVersion, kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return link
+}
+
+type csiNodeObject interface {
+	Config(options Options) error
+	Drivers(ctx context.Context, options Options) error
+}
+
+type csiNodeHandler struct {
+	csiNode     *storagev1.CSINode
+	configFunc  func(*storagev1.CSINode, Options) (*component.Summary, error)
+	driversFunc func(context.Context, *storagev1.CSINode, Options) (*component.Table, error)
+	object      *Object
+}
+
+var _ csiNodeObject = (*csiNodeHandler)(nil)
+
+func newCSINodeHandler(csiNode *storagev1.CSINode, object *Object) (*csiNodeHandler, error) {
+	if csiNode == nil {
+		return nil, errors.New("can't print a nil csi node")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print csi node using a nil object printer")
+	}
+
+	ch := &csiNodeHandler{
+		csiNode:     csiNode,
+		configFunc:  defaultCSINodeConfig,
+		driversFunc: defaultCSINodeDrivers,
+		object:      object,
+	}
+
+	return ch, nil
+}
+
+func (c *csiNodeHandler) Config(options Options) error {
+	out, err := c.configFunc(c.csiNode, options)
+	if err != nil {
+		return err
+	}
+
+	c.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultCSINodeConfig(csiNode *storagev1.CSINode, options Options) (*component.Summary, error) {
+	return NewCSINodeConfiguration(csiNode).Create(options)
+}
+
+func (c *csiNodeHandler) Drivers(ctx context.Context, options Options) error {
+	c.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return c.driversFunc(ctx, c.csiNode, options)
+		},
+	})
+
+	return nil
+}
+
+func defaultCSINodeDrivers(ctx context.Context, csiNode *storagev1.CSINode, options Options) (*component.Table, error) {
+	return createCSINodeDriversView(ctx, csiNode, options)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_CSINodeConfiguration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("", "v1", "Node", "node", "node", "/node")
+
+	cc := NewCSINodeConfiguration(testutil.CreateCSINode("node"))
+
+	summary, err := cc.Create(printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Node",
+			Content: component.NewLink("", "node", "/node"),
+		},
+	}...)
+
+	component.AssertEqual(t, expected, summary)
+}
+
+func Test_createCSINodeDriversView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	csiNode := testutil.CreateCSINode("node")
+	csiNode.Spec.Drivers = []storagev1.CSINodeDriver{
+		{
+			Name:         "csi.example.com",
+			NodeID:       "node-1",
+			TopologyKeys: []string{"topology.example.com/zone", "topology.example.com/rack"},
+			Allocatable: &storagev1.VolumeNodeResources{
+				Count: pointer.Int32Ptr(16),
+			},
+		},
+	}
+
+	ctx := context.Background()
+
+	key := store.Key{APIVersion: "storage.k8s.io/v1", Kind: "CSIDriver", Name: "csi.example.com"}
+	tpo.objectStore.EXPECT().Get(ctx, key).Return(&unstructured.Unstructured{}, nil)
+
+	got, err := createCSINodeDriversView(ctx, csiNode, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Node ID", "Topology Keys", "Allocatable Volumes")
+	expected := component.NewTable("Drivers", "There are no CSI drivers registered on this node!", cols)
+	expected.Add(component.TableRow{
+		"Name":                component.NewText("csi.example.com"),
+		"Node ID":             component.NewText("node-1"),
+		"Topology Keys":       component.NewText("topology.example.com/zone, topology.example.com/rack"),
+		"Allocatable Volumes": component.NewText("16"),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -92,6 +92,11 @@ func CreateCustomResourceList(crdObject *unstructured.Unstructured, resources *u
 			row[name] = component.NewText(s)
 		}
 
+		actions := workloadActions{scalable: version.Scalable, restartable: octant.HasPodTemplate(&cr)}
+		if err := addWorkloadGridActions(row, &cr, actions); err != nil {
+			return nil, fmt.Errorf("add workload actions: %w", err)
+		}
+
 		table.Add(row)
 	}
 
@@ -131,6 +136,17 @@ func CustomResourceHandler(ctx context.Context, crd, cr *unstructured.Unstructur
 	if err != nil {
 		return nil, err
 	}
+	setVolumeSnapshotPrinters(ctx, h, options)
+
+	version, err := crdVersion(crd, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := workloadActions{scalable: version.Scalable, restartable: octant.HasPodTemplate(cr)}
+	if err := addWorkloadButtons(object, cr, actions); err != nil {
+		return nil, err
+	}
 
 	if err := h.Config(); err != nil {
 		return nil, fmt.Errorf("print custom resource configuration: %w", err)
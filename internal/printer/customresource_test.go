@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/testutil"
@@ -54,6 +55,44 @@ func Test_CustomResourceListHandler(t *testing.T) {
 	component.AssertEqual(t, expected, got)
 }
 
+func Test_CustomResourceListHandler_scalable(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+
+	crd := testutil.LoadUnstructuredFromFile(t, "crd.yaml")
+	require.NoError(t, unstructured.SetNestedMap(crd.Object, map[string]interface{}{
+		"specReplicasPath": ".spec.replicas",
+	}, "spec", "subresources", "scale"))
+
+	resource := testutil.LoadUnstructuredFromFile(t, "crd-resource.yaml")
+
+	now := time.Now()
+	resource.SetCreationTimestamp(metav1.Time{Time: now})
+
+	tpo.PathForObject(resource, resource.GetName(), "/my-crontab")
+
+	list := testutil.ToUnstructuredList(t, resource)
+	got, err := CreateCustomResourceList(crd, list, "v1", tpo.link)
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows(
+		"crontabs.stable.example.com/v1", "We could not find any crontabs.stable.example.com/v1!",
+		component.NewTableCols("Name", "Labels", "Age"),
+		[]component.TableRow{
+			{
+				"Name":   component.NewLink("", resource.GetName(), "/my-crontab"),
+				"Age":    component.NewTimestamp(now),
+				"Labels": component.NewLabels(nil),
+				component.GridActionKey: gridActionsFactory(
+					buildWorkloadGridActions(t, resource, workloadActions{scalable: true})),
+			},
+		})
+
+	component.AssertEqual(t, expected, got)
+}
+
 func Test_CustomResourceListHandler_custom_columns(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -42,6 +42,10 @@ func DaemonSetListHandler(ctx context.Context, list *appsv1.DaemonSetList, opts
 		row["Age"] = component.NewTimestamp(daemonSet.ObjectMeta.CreationTimestamp.Time)
 		row["Node Selector"] = printSelectorMap(daemonSet.Spec.Template.Spec.NodeSelector)
 
+		if err := addWorkloadGridActions(row, &daemonSet, workloadActions{restartable: true}); err != nil {
+			return nil, fmt.Errorf("add workload actions: %w", err)
+		}
+
 		if err := ot.AddRowForObject(ctx, &daemonSet, row); err != nil {
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
@@ -55,6 +59,10 @@ func DaemonSetHandler(ctx context.Context, daemonSet *appsv1.DaemonSet, options
 	o := NewObject(daemonSet)
 	o.EnableEvents()
 
+	if err := addWorkloadButtons(o, daemonSet, workloadActions{restartable: true}); err != nil {
+		return nil, err
+	}
+
 	dsh, err := newDaemonSetHandler(daemonSet, o)
 	if err != nil {
 		return nil, err
@@ -72,6 +80,10 @@ func DaemonSetHandler(ctx context.Context, daemonSet *appsv1.DaemonSet, options
 		return nil, errors.Wrap(err, "print daemonset pods")
 	}
 
+	if err := dsh.RolloutHistory(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print daemonset rollout history")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -146,14 +158,16 @@ type daemonSetObject interface {
 	Config(options Options) error
 	Status(options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
+	RolloutHistory(ctx context.Context, options Options) error
 }
 
 type daemonSetHandler struct {
-	daemonSet  *appsv1.DaemonSet
-	configFunc func(*appsv1.DaemonSet, Options) (*component.Summary, error)
-	statusFunc func(*appsv1.DaemonSet, Options) (*component.Summary, error)
-	podFunc    func(context.Context, runtime.Object, Options) (component.Component, error)
-	object     *Object
+	daemonSet   *appsv1.DaemonSet
+	configFunc  func(*appsv1.DaemonSet, Options) (*component.Summary, error)
+	statusFunc  func(*appsv1.DaemonSet, Options) (*component.Summary, error)
+	podFunc     func(context.Context, runtime.Object, Options) (component.Component, error)
+	historyFunc func(context.Context, runtime.Object, Options) (component.Component, error)
+	object      *Object
 }
 
 var _ daemonSetObject = (*daemonSetHandler)(nil)
@@ -168,11 +182,12 @@ func newDaemonSetHandler(daemonSet *appsv1.DaemonSet, object *Object) (*daemonSe
 	}
 
 	dh := &daemonSetHandler{
-		daemonSet:  daemonSet,
-		configFunc: defaultDaemonSetConfig,
-		statusFunc: defaultDaemonSetSummary,
-		podFunc:    defaultDaemonSetPods,
-		object:     object,
+		daemonSet:   daemonSet,
+		configFunc:  defaultDaemonSetConfig,
+		statusFunc:  defaultDaemonSetSummary,
+		podFunc:     defaultDaemonSetPods,
+		historyFunc: defaultRolloutHistory,
+		object:      object,
 	}
 
 	return dh, nil
@@ -220,3 +235,13 @@ func (d *daemonSetHandler) Pods(ctx context.Context, object runtime.Object, opti
 func defaultDaemonSetPods(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
 	return createPodListView(ctx, object, options)
 }
+
+func (d *daemonSetHandler) RolloutHistory(ctx context.Context, options Options) error {
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return d.historyFunc(ctx, d.daemonSet, options)
+		},
+	})
+	return nil
+}
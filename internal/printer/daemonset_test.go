@@ -62,9 +62,10 @@ func Test_DaemonSetListHandler(t *testing.T) {
 		"Ready":         component.NewText("1"),
 		"Up-To-Date":    component.NewText("1"),
 		"Node Selector": component.NewSelectors(nil),
-		component.GridActionKey: gridActionsFactory([]component.GridAction{
+		component.GridActionKey: gridActionsFactory(append(
+			buildWorkloadGridActions(t, object, workloadActions{restartable: true}),
 			buildObjectDeleteAction(t, object),
-		}),
+		)),
 	})
 
 	component.AssertEqual(t, expected, got)
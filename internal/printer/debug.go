@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// debugPodAction creates an action which adds an ephemeral debug container to a pod.
+func debugPodAction(pod *corev1.Pod) (component.Action, error) {
+	if pod == nil {
+		return component.Action{}, errors.New("pod is nil")
+	}
+
+	choices := []component.InputChoice{
+		{Label: "None", Value: "", Checked: true},
+	}
+	for _, c := range pod.Spec.Containers {
+		choices = append(choices, component.InputChoice{Label: c.Name, Value: c.Name})
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionDebugContainer, pod,
+		component.NewFormFieldText("Image", "image", octant.DefaultDebugImage),
+		component.NewFormFieldRadio("Target Container", "targetContainer", choices),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Debug",
+		Title: fmt.Sprintf("Debug Pod %s", pod.Name),
+		Form:  form,
+	}, nil
+}
+
+// debugNodeAction creates an action which creates a node debugger pod on a node.
+func debugNodeAction(node *corev1.Node) (component.Action, error) {
+	if node == nil {
+		return component.Action{}, errors.New("node is nil")
+	}
+
+	form, err := component.CreateFormForObject(octant.ActionDebugNode, node,
+		component.NewFormFieldText("Image", "image", octant.DefaultDebugImage),
+		component.NewFormFieldText("Namespace", "debugNamespace", "default"),
+	)
+	if err != nil {
+		return component.Action{}, err
+	}
+
+	return component.Action{
+		Name:  "Debug Shell",
+		Title: fmt.Sprintf("Debug Node %s", node.Name),
+		Form:  form,
+	}, nil
+}
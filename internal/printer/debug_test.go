@@ -0,0 +1,68 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_debugPodAction(t *testing.T) {
+	pod := testutil.CreatePod("pod")
+	pod.Spec.Containers = []corev1.Container{{Name: "app"}, {Name: "sidecar"}}
+
+	got, err := debugPodAction(pod)
+	require.NoError(t, err)
+
+	form, err := component.CreateFormForObject(octant.ActionDebugContainer, pod,
+		component.NewFormFieldText("Image", "image", "busybox"),
+		component.NewFormFieldRadio("Target Container", "targetContainer", []component.InputChoice{
+			{Label: "None", Value: "", Checked: true},
+			{Label: "app", Value: "app"},
+			{Label: "sidecar", Value: "sidecar"},
+		}),
+	)
+	require.NoError(t, err)
+
+	expected := component.Action{
+		Name:  "Debug",
+		Title: "Debug Pod pod",
+		Form:  form,
+	}
+	require.Equal(t, expected, got)
+
+	_, err = debugPodAction(nil)
+	require.Error(t, err)
+}
+
+func Test_debugNodeAction(t *testing.T) {
+	node := testutil.CreateNode("node")
+
+	got, err := debugNodeAction(node)
+	require.NoError(t, err)
+
+	form, err := component.CreateFormForObject(octant.ActionDebugNode, node,
+		component.NewFormFieldText("Image", "image", "busybox"),
+		component.NewFormFieldText("Namespace", "debugNamespace", "default"),
+	)
+	require.NoError(t, err)
+
+	expected := component.Action{
+		Name:  "Debug Shell",
+		Title: "Debug Node node",
+		Form:  form,
+	}
+	require.Equal(t, expected, got)
+
+	_, err = debugNodeAction(nil)
+	require.Error(t, err)
+}
@@ -58,6 +58,10 @@ func DeploymentListHandler(ctx context.Context, list *appsv1.DeploymentList, opt
 		row["Containers"] = containers
 		row["Selector"] = printSelector(d.Spec.Selector)
 
+		if err := addWorkloadGridActions(row, &d, workloadActions{scalable: true, restartable: true}); err != nil {
+			return nil, fmt.Errorf("add workload actions: %w", err)
+		}
+
 		if err := ot.AddRowForObject(ctx, &d, row); err != nil {
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
@@ -71,6 +75,10 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 	o := NewObject(deployment)
 	o.EnableEvents()
 
+	if err := addWorkloadButtons(o, deployment, workloadActions{scalable: true, restartable: true}); err != nil {
+		return nil, err
+	}
+
 	dh, err := newDeploymentHandler(deployment, o)
 	if err != nil {
 		return nil, err
@@ -88,6 +96,9 @@ func DeploymentHandler(ctx context.Context, deployment *appsv1.Deployment, optio
 	if err := dh.Conditions(); err != nil {
 		return nil, errors.Wrap(err, "print deployment conditions")
 	}
+	if err := dh.RolloutHistory(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print deployment rollout history")
+	}
 
 	return o.ToComponent(ctx, options)
 }
@@ -269,6 +280,7 @@ func editDeploymentAction(deployment *appsv1.Deployment) ([]component.Action, er
 
 	form, err := component.CreateFormForObject(octant.ActionDeploymentConfiguration, deployment,
 		component.NewFormFieldNumber("Replicas", "replicas", fmt.Sprintf("%d", *replicas)),
+		octant.ForceApplyFormField(),
 	)
 	if err != nil {
 		return nil, err
@@ -288,6 +300,7 @@ type deploymentObject interface {
 	Status() error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
 	Conditions() error
+	RolloutHistory(ctx context.Context, options Options) error
 }
 
 type deploymentHandler struct {
@@ -296,6 +309,7 @@ type deploymentHandler struct {
 	summaryFunc    func(*appsv1.Deployment) (*component.Summary, error)
 	podFunc        func(context.Context, []runtime.Object, Options) (component.Component, error)
 	conditionsFunc func(*appsv1.Deployment) (*component.Table, error)
+	historyFunc    func(context.Context, runtime.Object, Options) (component.Component, error)
 	object         *Object
 }
 
@@ -316,6 +330,7 @@ func newDeploymentHandler(deployment *appsv1.Deployment, object *Object) (*deplo
 		summaryFunc:    defaultDeploymentSummary,
 		podFunc:        defaultDeploymentPods,
 		conditionsFunc: defaultDeploymentConditions,
+		historyFunc:    defaultRolloutHistory,
 		object:         object,
 	}
 
@@ -369,6 +384,21 @@ func defaultDeploymentConditions(deployment *appsv1.Deployment) (*component.Tabl
 	return createDeploymentConditionsView(deployment)
 }
 
+func (d *deploymentHandler) RolloutHistory(ctx context.Context, options Options) error {
+	if err := addRolloutPauseButton(d.object, d.deployment, d.deployment.Spec.Paused); err != nil {
+		return err
+	}
+
+	d.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return d.historyFunc(ctx, d.deployment, options)
+		},
+	})
+
+	return nil
+}
+
 func (d *deploymentHandler) Pods(ctx context.Context, object runtime.Object, options Options) error {
 	d.object.EnablePodTemplate(d.deployment.Spec.Template)
 
@@ -108,9 +108,10 @@ func Test_DeploymentListHandler(t *testing.T) {
 		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "my_app")}),
 		"Status":     component.NewText("2/3"),
 		"Containers": containers,
-		component.GridActionKey: gridActionsFactory([]component.GridAction{
+		component.GridActionKey: gridActionsFactory(append(
+			buildWorkloadGridActions(t, object, workloadActions{scalable: true, restartable: true}),
 			buildObjectDeleteAction(t, object),
-		}),
+		)),
 	})
 
 	component.AssertEqual(t, expected, got)
@@ -378,6 +379,7 @@ func Test_editDeploymentAction(t *testing.T) {
 		Form: component.Form{
 			Fields: []component.FormField{
 				component.NewFormFieldNumber("Replicas", "replicas", "3"),
+				octant.ForceApplyFormField(),
 				component.NewFormFieldHidden("apiVersion", apiVersion),
 				component.NewFormFieldHidden("kind", kind),
 				component.NewFormFieldHidden("name", deployment.Name),
@@ -0,0 +1,328 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// maxEndpointsShown is the number of addresses shown in an endpoints list
+// before the remainder is summarized.
+const maxEndpointsShown = 3
+
+// EndpointsListHandler is a printFunc that prints endpoints
+func EndpointsListHandler(ctx context.Context, list *corev1.EndpointsList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("endpoints list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Endpoints", "Ready", "Age")
+	ot := NewObjectTable("Endpoints", "We couldn't find any endpoints!", cols, options.DashConfig.ObjectStore())
+
+	for _, endpoints := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&endpoints, endpoints.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ready, total := countEndpointsAddresses(&endpoints)
+
+		row["Name"] = nameLink
+		row["Labels"] = component.NewLabels(endpoints.Labels)
+		row["Endpoints"] = component.NewText(formatEndpointsSubsets(endpoints.Subsets))
+		row["Ready"] = component.NewText(fmt.Sprintf("%d/%d", ready, total))
+		row["Age"] = component.NewTimestamp(endpoints.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &endpoints, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// EndpointsHandler is a printFunc that prints an Endpoints
+func EndpointsHandler(ctx context.Context, endpoints *corev1.Endpoints, options Options) (component.Component, error) {
+	o := NewObject(endpoints)
+	o.EnableEvents()
+
+	eh, err := newEndpointsHandler(endpoints, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := eh.Config(options); err != nil {
+		return nil, errors.Wrap(err, "print endpoints configuration")
+	}
+
+	if err := eh.Addresses(options); err != nil {
+		return nil, errors.Wrap(err, "print endpoints addresses")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// EndpointsConfiguration generates an endpoints configuration
+type EndpointsConfiguration struct {
+	endpoints *corev1.Endpoints
+}
+
+// NewEndpointsConfiguration creates an instance of EndpointsConfiguration
+func NewEndpointsConfiguration(endpoints *corev1.Endpoints) *EndpointsConfiguration {
+	return &EndpointsConfiguration{
+		endpoints: endpoints,
+	}
+}
+
+// Create creates an endpoints configuration summary
+func (e *EndpointsConfiguration) Create(options Options) (*component.Summary, error) {
+	if e.endpoints == nil {
+		return nil, errors.New("endpoints is nil")
+	}
+
+	endpoints := e.endpoints
+
+	sections := component.SummarySections{}
+	sections.Add("Service", serviceLink(endpoints.Namespace, endpoints.Name, options))
+
+	ready, total := countEndpointsAddresses(endpoints)
+	sections.AddText("Ready Addresses", fmt.Sprintf("%d/%d", ready, total))
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createEndpointsAddressesView(endpoints *corev1.Endpoints, options Options) (*component.Table, error) {
+	if endpoints == nil {
+		return nil, errors.New("unable to generate addresses for nil endpoints")
+	}
+
+	cols := component.NewTableCols("Target", "IP", "Node Name", "Ready", "Ports")
+	table := component.NewTable("Addresses", "There are no addresses!", cols)
+
+	for _, subset := range endpoints.Subsets {
+		ports := component.NewText(formatEndpointPorts(subset.Ports))
+
+		addRow := func(address corev1.EndpointAddress, ready bool) {
+			table.Add(component.TableRow{
+				"Target":    endpointTargetRef(endpoints.Namespace, address.TargetRef, options),
+				"IP":        component.NewText(address.IP),
+				"Node Name": component.NewText(endpointAddressNodeName(address)),
+				"Ready":     endpointReadyText(ready),
+				"Ports":     ports,
+			})
+		}
+
+		for _, address := range subset.Addresses {
+			addRow(address, true)
+		}
+
+		for _, address := range subset.NotReadyAddresses {
+			addRow(address, false)
+		}
+	}
+
+	return table, nil
+}
+
+// endpointTargetRef creates a link to the object an endpoint routes to.
+// Endpoints without a target are described as such.
+func endpointTargetRef(namespace string, targetRef *corev1.ObjectReference, options Options) component.Component {
+	if targetRef == nil {
+		return component.NewText("No target")
+	}
+
+	if targetRef.Namespace != "" {
+		namespace = targetRef.Namespace
+	}
+
+	apiVersion := targetRef.APIVersion
+	if apiVersion == "" {
+		apiVersion = "v1"
+	}
+
+	link, err := options.Link.ForGVK(namespace, apiVersion, targetRef.Kind, targetRef.Name, targetRef.Name)
+	if err != nil {
+		return component.NewText(targetRef.Name)
+	}
+
+	return link
+}
+
+func endpointAddressNodeName(address corev1.EndpointAddress) string {
+	if address.NodeName == nil {
+		return ""
+	}
+
+	return *address.NodeName
+}
+
+func endpointReadyText(ready bool) *component.Text {
+	if ready {
+		return component.NewText("Ready")
+	}
+
+	text := component.NewText("Not Ready")
+	text.SetStatus(component.TextStatusWarning)
+	return text
+}
+
+// serviceLink creates a link to a service. If the link can't be generated,
+// the service name is returned as text.
+func serviceLink(namespace, name string, options Options) component.Component {
+	if name == "" {
+		return component.NewText("")
+	}
+
+	apiVersion, kind := gvk.Service.ToAPIVersionAndKind()
+	link, err := options.Link.ForGVK(namespace, apiVersion, kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return link
+}
+
+func countEndpointsAddresses(endpoints *corev1.Endpoints) (int, int) {
+	var ready, total int
+	for _, subset := range endpoints.Subsets {
+		ready += len(subset.Addresses)
+		total += len(subset.Addresses) + len(subset.NotReadyAddresses)
+	}
+
+	return ready, total
+}
+
+// formatEndpointsSubsets prints ready addresses the same way kubectl does, e.g.
+// `10.1.1.1:80,10.1.1.2:80 + 3 more...`.
+func formatEndpointsSubsets(subsets []corev1.EndpointSubset) string {
+	var list []string
+	count := 0
+
+	for _, subset := range subsets {
+		for _, address := range subset.Addresses {
+			if len(subset.Ports) == 0 {
+				count++
+				if len(list) < maxEndpointsShown {
+					list = append(list, address.IP)
+				}
+				continue
+			}
+
+			for _, port := range subset.Ports {
+				count++
+				if len(list) < maxEndpointsShown {
+					list = append(list, fmt.Sprintf("%s:%d", address.IP, port.Port))
+				}
+			}
+		}
+	}
+
+	if len(list) == 0 {
+		return "<none>"
+	}
+
+	out := strings.Join(list, ",")
+	if extra := count - len(list); extra > 0 {
+		out = fmt.Sprintf("%s + %d more...", out, extra)
+	}
+
+	return out
+}
+
+func formatEndpointPorts(ports []corev1.EndpointPort) string {
+	if len(ports) == 0 {
+		return "<none>"
+	}
+
+	var out []string
+	for _, port := range ports {
+		protocol := port.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+
+		s := fmt.Sprintf("%d/%s", port.Port, protocol)
+		if port.Name != "" {
+			s = fmt.Sprintf("%s %s", port.Name, s)
+		}
+
+		out = append(out, s)
+	}
+
+	return strings.Join(out, ", ")
+}
+
+type endpointsObject interface {
+	Config(options Options) error
+	Addresses(options Options) error
+}
+
+type endpointsHandler struct {
+	endpoints     *corev1.Endpoints
+	configFunc    func(*corev1.Endpoints, Options) (*component.Summary, error)
+	addressesFunc func(*corev1.Endpoints, Options) (*component.Table, error)
+	object        *Object
+}
+
+var _ endpointsObject = (*endpointsHandler)(nil)
+
+func newEndpointsHandler(endpoints *corev1.Endpoints, object *Object) (*endpointsHandler, error) {
+	if endpoints == nil {
+		return nil, errors.New("can't print nil endpoints")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print endpoints using a nil object printer")
+	}
+
+	eh := &endpointsHandler{
+		endpoints:     endpoints,
+		configFunc:    defaultEndpointsConfig,
+		addressesFunc: defaultEndpointsAddresses,
+		object:        object,
+	}
+
+	return eh, nil
+}
+
+func (e *endpointsHandler) Config(options Options) error {
+	out, err := e.configFunc(e.endpoints, options)
+	if err != nil {
+		return err
+	}
+
+	e.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultEndpointsConfig(endpoints *corev1.Endpoints, options Options) (*component.Summary, error) {
+	return NewEndpointsConfiguration(endpoints).Create(options)
+}
+
+func (e *endpointsHandler) Addresses(options Options) error {
+	e.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return e.addressesFunc(e.endpoints, options)
+		},
+	})
+
+	return nil
+}
+
+func defaultEndpointsAddresses(endpoints *corev1.Endpoints, options Options) (*component.Table, error) {
+	return createEndpointsAddressesView(endpoints, options)
+}
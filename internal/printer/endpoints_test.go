@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createTestEndpoints() *corev1.Endpoints {
+	nodeName := "node"
+
+	endpoints := testutil.CreateEndpoints("service")
+	endpoints.CreationTimestamp = metav1.Time{Time: testutil.Time()}
+	endpoints.Subsets = []corev1.EndpointSubset{
+		{
+			Addresses: []corev1.EndpointAddress{
+				{
+					IP:       "10.1.1.1",
+					NodeName: &nodeName,
+					TargetRef: &corev1.ObjectReference{
+						Kind:      "Pod",
+						Namespace: "namespace",
+						Name:      "pod-1",
+					},
+				},
+			},
+			NotReadyAddresses: []corev1.EndpointAddress{
+				{IP: "10.1.1.2"},
+			},
+			Ports: []corev1.EndpointPort{
+				{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+
+	return endpoints
+}
+
+func Test_EndpointsListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	endpoints := createTestEndpoints()
+	tpo.PathForObject(endpoints, endpoints.Name, "/endpoints")
+
+	list := &corev1.EndpointsList{
+		Items: []corev1.Endpoints{*endpoints},
+	}
+
+	ctx := context.Background()
+	got, err := EndpointsListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Labels", "Endpoints", "Ready", "Age")
+	expected := component.NewTable("Endpoints", "We couldn't find any endpoints!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", endpoints.Name, "/endpoints",
+			genObjectStatus(component.TextStatusOK, []string{"v1 Endpoints is OK"})),
+		"Labels":    component.NewLabels(endpoints.Labels),
+		"Endpoints": component.NewText("10.1.1.1:80"),
+		"Ready":     component.NewText("1/2"),
+		"Age":       component.NewTimestamp(testutil.Time()),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, endpoints),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_EndpointsConfiguration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("namespace", "v1", "Service", "service", "service", "/service")
+
+	ec := NewEndpointsConfiguration(createTestEndpoints())
+	summary, err := ec.Create(printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Service",
+			Content: component.NewLink("", "service", "/service"),
+		},
+		{
+			Header:  "Ready Addresses",
+			Content: component.NewText("1/2"),
+		},
+	}...)
+
+	component.AssertEqual(t, expected, summary)
+}
+
+func Test_createEndpointsAddressesView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("namespace", "v1", "Pod", "pod-1", "pod-1", "/pod-1")
+
+	got, err := createEndpointsAddressesView(createTestEndpoints(), printOptions)
+	require.NoError(t, err)
+
+	notReady := component.NewText("Not Ready")
+	notReady.SetStatus(component.TextStatusWarning)
+
+	cols := component.NewTableCols("Target", "IP", "Node Name", "Ready", "Ports")
+	expected := component.NewTable("Addresses", "There are no addresses!", cols)
+	expected.Add(
+		component.TableRow{
+			"Target":    component.NewLink("", "pod-1", "/pod-1"),
+			"IP":        component.NewText("10.1.1.1"),
+			"Node Name": component.NewText("node"),
+			"Ready":     component.NewText("Ready"),
+			"Ports":     component.NewText("http 80/TCP"),
+		},
+		component.TableRow{
+			"Target":    component.NewText("No target"),
+			"IP":        component.NewText("10.1.1.2"),
+			"Node Name": component.NewText(""),
+			"Ready":     notReady,
+			"Ports":     component.NewText("http 80/TCP"),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_formatEndpointsSubsets(t *testing.T) {
+	cases := []struct {
+		name     string
+		subsets  []corev1.EndpointSubset
+		expected string
+	}{
+		{
+			name:     "no addresses",
+			expected: "<none>",
+		},
+		{
+			name: "addresses without ports",
+			subsets: []corev1.EndpointSubset{
+				{Addresses: []corev1.EndpointAddress{{IP: "10.1.1.1"}}},
+			},
+			expected: "10.1.1.1",
+		},
+		{
+			name: "more than the maximum shown",
+			subsets: []corev1.EndpointSubset{
+				{
+					Addresses: []corev1.EndpointAddress{{IP: "10.1.1.1"}, {IP: "10.1.1.2"}},
+					Ports:     []corev1.EndpointPort{{Port: 80}, {Port: 443}},
+				},
+			},
+			expected: "10.1.1.1:80,10.1.1.1:443,10.1.1.2:80 + 1 more...",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatEndpointsSubsets(tc.subsets))
+		})
+	}
+}
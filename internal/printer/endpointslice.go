@@ -0,0 +1,270 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// EndpointSliceListHandler is a printFunc that prints endpoint slices
+func EndpointSliceListHandler(ctx context.Context, list *discoveryv1beta1.EndpointSliceList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("endpoint slice list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Address Type", "Ports", "Endpoints", "Ready", "Age")
+	ot := NewObjectTable("Endpoint Slices", "We couldn't find any endpoint slices!", cols, options.DashConfig.ObjectStore())
+
+	for _, endpointSlice := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&endpointSlice, endpointSlice.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		ready, total := countEndpointSliceEndpoints(&endpointSlice)
+
+		row["Name"] = nameLink
+		row["Labels"] = component.NewLabels(endpointSlice.Labels)
+		row["Address Type"] = component.NewText(string(endpointSlice.AddressType))
+		row["Ports"] = component.NewText(formatEndpointSlicePorts(endpointSlice.Ports))
+		row["Endpoints"] = component.NewText(formatEndpointSliceAddresses(endpointSlice.Endpoints))
+		row["Ready"] = component.NewText(fmt.Sprintf("%d/%d", ready, total))
+		row["Age"] = component.NewTimestamp(endpointSlice.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &endpointSlice, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// EndpointSliceHandler is a printFunc that prints an EndpointSlice
+func EndpointSliceHandler(ctx context.Context, endpointSlice *discoveryv1beta1.EndpointSlice, options Options) (component.Component, error) {
+	o := NewObject(endpointSlice)
+	o.EnableEvents()
+
+	eh, err := newEndpointSliceHandler(endpointSlice, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := eh.Config(options); err != nil {
+		return nil, errors.Wrap(err, "print endpointslice configuration")
+	}
+
+	if err := eh.Endpoints(options); err != nil {
+		return nil, errors.Wrap(err, "print endpointslice endpoints")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// EndpointSliceConfiguration generates an endpoint slice configuration
+type EndpointSliceConfiguration struct {
+	endpointSlice *discoveryv1beta1.EndpointSlice
+}
+
+// NewEndpointSliceConfiguration creates an instance of EndpointSliceConfiguration
+func NewEndpointSliceConfiguration(endpointSlice *discoveryv1beta1.EndpointSlice) *EndpointSliceConfiguration {
+	return &EndpointSliceConfiguration{
+		endpointSlice: endpointSlice,
+	}
+}
+
+// Create creates an endpoint slice configuration summary
+func (e *EndpointSliceConfiguration) Create(options Options) (*component.Summary, error) {
+	if e.endpointSlice == nil {
+		return nil, errors.New("endpoint slice is nil")
+	}
+
+	endpointSlice := e.endpointSlice
+
+	sections := component.SummarySections{}
+
+	if serviceName, ok := endpointSlice.Labels[discoveryv1beta1.LabelServiceName]; ok {
+		sections.Add("Service", serviceLink(endpointSlice.Namespace, serviceName, options))
+	}
+
+	if managedBy, ok := endpointSlice.Labels[discoveryv1beta1.LabelManagedBy]; ok {
+		sections.AddText("Managed By", managedBy)
+	}
+
+	sections.AddText("Address Type", string(endpointSlice.AddressType))
+	sections.AddText("Ports", formatEndpointSlicePorts(endpointSlice.Ports))
+
+	ready, total := countEndpointSliceEndpoints(endpointSlice)
+	sections.AddText("Ready Endpoints", fmt.Sprintf("%d/%d", ready, total))
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createEndpointSliceEndpointsView(endpointSlice *discoveryv1beta1.EndpointSlice, options Options) (*component.Table, error) {
+	if endpointSlice == nil {
+		return nil, errors.New("unable to generate endpoints for a nil endpoint slice")
+	}
+
+	cols := component.NewTableCols("Target", "Addresses", "Hostname", "Node Name", "Ready")
+	table := component.NewTable("Endpoints", "There are no endpoints!", cols)
+
+	for _, endpoint := range endpointSlice.Endpoints {
+		hostname := ""
+		if endpoint.Hostname != nil {
+			hostname = *endpoint.Hostname
+		}
+
+		table.Add(component.TableRow{
+			"Target":    endpointTargetRef(endpointSlice.Namespace, endpoint.TargetRef, options),
+			"Addresses": component.NewText(strings.Join(endpoint.Addresses, ", ")),
+			"Hostname":  component.NewText(hostname),
+			"Node Name": component.NewText(endpoint.Topology[corev1.LabelHostname]),
+			"Ready":     endpointReadyText(isEndpointReady(endpoint)),
+		})
+	}
+
+	return table, nil
+}
+
+// isEndpointReady returns true if an endpoint is ready. An unknown ready
+// condition is interpreted as ready.
+func isEndpointReady(endpoint discoveryv1beta1.Endpoint) bool {
+	return endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+}
+
+func countEndpointSliceEndpoints(endpointSlice *discoveryv1beta1.EndpointSlice) (int, int) {
+	ready := 0
+	for _, endpoint := range endpointSlice.Endpoints {
+		if isEndpointReady(endpoint) {
+			ready++
+		}
+	}
+
+	return ready, len(endpointSlice.Endpoints)
+}
+
+func formatEndpointSliceAddresses(endpoints []discoveryv1beta1.Endpoint) string {
+	var list []string
+	count := 0
+
+	for _, endpoint := range endpoints {
+		for _, address := range endpoint.Addresses {
+			count++
+			if len(list) < maxEndpointsShown {
+				list = append(list, address)
+			}
+		}
+	}
+
+	if len(list) == 0 {
+		return "<none>"
+	}
+
+	out := strings.Join(list, ",")
+	if extra := count - len(list); extra > 0 {
+		out = fmt.Sprintf("%s + %d more...", out, extra)
+	}
+
+	return out
+}
+
+func formatEndpointSlicePorts(ports []discoveryv1beta1.EndpointPort) string {
+	if len(ports) == 0 {
+		return "<none>"
+	}
+
+	var out []string
+	for _, port := range ports {
+		protocol := corev1.ProtocolTCP
+		if port.Protocol != nil {
+			protocol = *port.Protocol
+		}
+
+		s := string(protocol)
+		if port.Port != nil {
+			s = fmt.Sprintf("%d/%s", *port.Port, protocol)
+		}
+
+		if port.Name != nil && *port.Name != "" {
+			s = fmt.Sprintf("%s %s", *port.Name, s)
+		}
+
+		out = append(out, s)
+	}
+
+	return strings.Join(out, ", ")
+}
+
+type endpointSliceObject interface {
+	Config(options Options) error
+	Endpoints(options Options) error
+}
+
+type endpointSliceHandler struct {
+	endpointSlice *discoveryv1beta1.EndpointSlice
+	configFunc    func(*discoveryv1beta1.EndpointSlice, Options) (*component.Summary, error)
+	endpointsFunc func(*discoveryv1beta1.EndpointSlice, Options) (*component.Table, error)
+	object        *Object
+}
+
+var _ endpointSliceObject = (*endpointSliceHandler)(nil)
+
+func newEndpointSliceHandler(endpointSlice *discoveryv1beta1.EndpointSlice, object *Object) (*endpointSliceHandler, error) {
+	if endpointSlice == nil {
+		return nil, errors.New("can't print a nil endpoint slice")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print endpoint slice using a nil object printer")
+	}
+
+	eh := &endpointSliceHandler{
+		endpointSlice: endpointSlice,
+		configFunc:    defaultEndpointSliceConfig,
+		endpointsFunc: defaultEndpointSliceEndpoints,
+		object:        object,
+	}
+
+	return eh, nil
+}
+
+func (e *endpointSliceHandler) Config(options Options) error {
+	out, err := e.configFunc(e.endpointSlice, options)
+	if err != nil {
+		return err
+	}
+
+	e.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultEndpointSliceConfig(endpointSlice *discoveryv1beta1.EndpointSlice, options Options) (*component.Summary, error) {
+	return NewEndpointSliceConfiguration(endpointSlice).Create(options)
+}
+
+func (e *endpointSliceHandler) Endpoints(options Options) error {
+	e.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return e.endpointsFunc(e.endpointSlice, options)
+		},
+	})
+
+	return nil
+}
+
+func defaultEndpointSliceEndpoints(endpointSlice *discoveryv1beta1.EndpointSlice, options Options) (*component.Table, error) {
+	return createEndpointSliceEndpointsView(endpointSlice, options)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createTestEndpointSlice() *discoveryv1beta1.EndpointSlice {
+	protocol := corev1.ProtocolTCP
+
+	endpointSlice := testutil.CreateEndpointSlice("service-abcde")
+	endpointSlice.CreationTimestamp = metav1.Time{Time: testutil.Time()}
+	endpointSlice.Labels = map[string]string{
+		discoveryv1beta1.LabelServiceName: "service",
+	}
+	endpointSlice.Ports = []discoveryv1beta1.EndpointPort{
+		{
+			Name:     pointer.StringPtr("http"),
+			Port:     pointer.Int32Ptr(80),
+			Protocol: &protocol,
+		},
+	}
+	endpointSlice.Endpoints = []discoveryv1beta1.Endpoint{
+		{
+			Addresses: []string{"10.1.1.1"},
+			Hostname:  pointer.StringPtr("pod-1"),
+			TargetRef: &corev1.ObjectReference{
+				Kind: "Pod",
+				Name: "pod-1",
+			},
+			Topology: map[string]string{
+				corev1.LabelHostname: "node",
+			},
+		},
+		{
+			Addresses: []string{"10.1.1.2"},
+			Conditions: discoveryv1beta1.EndpointConditions{
+				Ready: pointer.BoolPtr(false),
+			},
+		},
+	}
+
+	return endpointSlice
+}
+
+func Test_EndpointSliceListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	endpointSlice := createTestEndpointSlice()
+	tpo.PathForObject(endpointSlice, endpointSlice.Name, "/endpoint-slice")
+
+	list := &discoveryv1beta1.EndpointSliceList{
+		Items: []discoveryv1beta1.EndpointSlice{*endpointSlice},
+	}
+
+	ctx := context.Background()
+	got, err := EndpointSliceListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Labels", "Address Type", "Ports", "Endpoints", "Ready", "Age")
+	expected := component.NewTable("Endpoint Slices", "We couldn't find any endpoint slices!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", endpointSlice.Name, "/endpoint-slice",
+			genObjectStatus(component.TextStatusOK, []string{"discovery.k8s.io/v1beta1 EndpointSlice is OK"})),
+		"Labels":       component.NewLabels(endpointSlice.Labels),
+		"Address Type": component.NewText("IPv4"),
+		"Ports":        component.NewText("http 80/TCP"),
+		"Endpoints":    component.NewText("10.1.1.1,10.1.1.2"),
+		"Ready":        component.NewText("1/2"),
+		"Age":          component.NewTimestamp(testutil.Time()),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, endpointSlice),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_EndpointSliceConfiguration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("namespace", "v1", "Service", "service", "service", "/service")
+
+	ec := NewEndpointSliceConfiguration(createTestEndpointSlice())
+	summary, err := ec.Create(printOptions)
+	require.NoError(t, err)
+
+	expected := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Service",
+			Content: component.NewLink("", "service", "/service"),
+		},
+		{
+			Header:  "Address Type",
+			Content: component.NewText("IPv4"),
+		},
+		{
+			Header:  "Ports",
+			Content: component.NewText("http 80/TCP"),
+		},
+		{
+			Header:  "Ready Endpoints",
+			Content: component.NewText("1/2"),
+		},
+	}...)
+
+	component.AssertEqual(t, expected, summary)
+}
+
+func Test_createEndpointSliceEndpointsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK("namespace", "v1", "Pod", "pod-1", "pod-1", "/pod-1")
+
+	got, err := createEndpointSliceEndpointsView(createTestEndpointSlice(), printOptions)
+	require.NoError(t, err)
+
+	notReady := component.NewText("Not Ready")
+	notReady.SetStatus(component.TextStatusWarning)
+
+	cols := component.NewTableCols("Target", "Addresses", "Hostname", "Node Name", "Ready")
+	expected := component.NewTable("Endpoints", "There are no endpoints!", cols)
+	expected.Add(
+		component.TableRow{
+			"Target":    component.NewLink("", "pod-1", "/pod-1"),
+			"Addresses": component.NewText("10.1.1.1"),
+			"Hostname":  component.NewText("pod-1"),
+			"Node Name": component.NewText("node"),
+			"Ready":     component.NewText("Ready"),
+		},
+		component.TableRow{
+			"Target":    component.NewText("No target"),
+			"Addresses": component.NewText("10.1.1.2"),
+			"Hostname":  component.NewText(""),
+			"Node Name": component.NewText(""),
+			"Ready":     notReady,
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
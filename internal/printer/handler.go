@@ -23,18 +23,28 @@ func AddHandlers(p Handler) error {
 		CronJobHandler,
 		ClusterRoleListHandler,
 		ClusterRoleHandler,
+		CSIDriverHandler,
+		CSIDriverListHandler,
+		CSINodeHandler,
+		CSINodeListHandler,
 		CustomResourceDefinitionListHandler,
 		CustomResourceDefinitionHandler,
 		DaemonSetListHandler,
 		DaemonSetHandler,
 		DeploymentHandler,
 		DeploymentListHandler,
+		EndpointsHandler,
+		EndpointsListHandler,
+		EndpointSliceHandler,
+		EndpointSliceListHandler,
 		HorizontalPodAutoscalerHandler,
 		HorizontalPodAutoscalerListHandler,
 		IngressListHandler,
 		IngressHandler,
 		JobListHandler,
 		JobHandler,
+		LimitRangeHandler,
+		LimitRangeListHandler,
 		NodeHandler,
 		NodeListHandler,
 		NamespaceHandler,
@@ -47,10 +57,14 @@ func AddHandlers(p Handler) error {
 		ReplicationControllerListHandler,
 		PodHandler,
 		PodListHandler,
+		PodDisruptionBudgetHandler,
+		PodDisruptionBudgetListHandler,
 		PersistentVolumeHandler,
 		PersistentVolumeListHandler,
 		PersistentVolumeClaimHandler,
 		PersistentVolumeClaimListHandler,
+		ResourceQuotaHandler,
+		ResourceQuotaListHandler,
 		ServiceAccountListHandler,
 		ServiceAccountHandler,
 		ServiceHandler,
@@ -59,6 +73,8 @@ func AddHandlers(p Handler) error {
 		SecretListHandler,
 		StatefulSetHandler,
 		StatefulSetListHandler,
+		StorageClassHandler,
+		StorageClassListHandler,
 		RoleBindingListHandler,
 		RoleBindingHandler,
 		RoleListHandler,
@@ -69,6 +85,8 @@ func AddHandlers(p Handler) error {
 		MutatingWebhookConfigurationListHandler,
 		ValidatingWebhookConfigurationHandler,
 		ValidatingWebhookConfigurationListHandler,
+		VolumeAttachmentHandler,
+		VolumeAttachmentListHandler,
 	}
 
 	for _, handler := range handlers {
@@ -97,6 +97,13 @@ func buildObjectDeleteAction(t *testing.T, object runtime.Object) component.Grid
 	return action
 }
 
+func buildWorkloadGridActions(t *testing.T, object runtime.Object, actions workloadActions) []component.GridAction {
+	gridActions, err := workloadGridActions(object, actions)
+	require.NoError(t, err)
+
+	return gridActions
+}
+
 // genObjectStatus generates object status for a link. It can be used
 // when testing list handlers. This will be needed until there is a
 // way to test that the list handlers are working without external
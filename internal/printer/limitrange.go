@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// LimitRangeListHandler is a printFunc that prints limit ranges
+func LimitRangeListHandler(ctx context.Context, list *corev1.LimitRangeList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("limit range list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Types", "Age")
+	ot := NewObjectTable("Limit Ranges", "We couldn't find any limit ranges!", cols, options.DashConfig.ObjectStore())
+
+	for _, limitRange := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&limitRange, limitRange.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		var types []string
+		for _, item := range limitRange.Spec.Limits {
+			types = append(types, string(item.Type))
+		}
+
+		row["Name"] = nameLink
+		row["Labels"] = component.NewLabels(limitRange.Labels)
+		row["Types"] = component.NewText(strings.Join(types, ", "))
+		row["Age"] = component.NewTimestamp(limitRange.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &limitRange, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// LimitRangeHandler is a printFunc that prints a LimitRange
+func LimitRangeHandler(ctx context.Context, limitRange *corev1.LimitRange, options Options) (component.Component, error) {
+	o := NewObject(limitRange)
+	o.EnableEvents()
+
+	lh, err := newLimitRangeHandler(limitRange, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lh.Config(); err != nil {
+		return nil, errors.Wrap(err, "print limitrange configuration")
+	}
+
+	if err := lh.Limits(); err != nil {
+		return nil, errors.Wrap(err, "print limitrange limits")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// LimitRangeConfiguration generates a limit range configuration
+type LimitRangeConfiguration struct {
+	limitRange *corev1.LimitRange
+}
+
+// NewLimitRangeConfiguration creates an instance of LimitRangeConfiguration
+func NewLimitRangeConfiguration(limitRange *corev1.LimitRange) *LimitRangeConfiguration {
+	return &LimitRangeConfiguration{
+		limitRange: limitRange,
+	}
+}
+
+// Create creates a limit range configuration summary
+func (l *LimitRangeConfiguration) Create() (*component.Summary, error) {
+	if l.limitRange == nil {
+		return nil, errors.New("limit range is nil")
+	}
+
+	sections := component.SummarySections{}
+
+	for _, item := range l.limitRange.Spec.Limits {
+		var defaults []string
+		for _, name := range sortedResourceNames(item.Default) {
+			quantity := item.Default[name]
+			defaults = append(defaults, fmt.Sprintf("%s: %s", name, quantity.String()))
+		}
+
+		var defaultRequests []string
+		for _, name := range sortedResourceNames(item.DefaultRequest) {
+			quantity := item.DefaultRequest[name]
+			defaultRequests = append(defaultRequests, fmt.Sprintf("%s: %s", name, quantity.String()))
+		}
+
+		if len(defaults) > 0 {
+			sections.AddText(fmt.Sprintf("%s Default Limit", item.Type), strings.Join(defaults, ", "))
+		}
+
+		if len(defaultRequests) > 0 {
+			sections.AddText(fmt.Sprintf("%s Default Request", item.Type), strings.Join(defaultRequests, ", "))
+		}
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createLimitRangeLimitsView(limitRange *corev1.LimitRange) (*component.Table, error) {
+	if limitRange == nil {
+		return nil, errors.New("unable to generate limits for a nil limit range")
+	}
+
+	cols := component.NewTableCols("Type", "Resource", "Min", "Max", "Default Request", "Default Limit", "Max Limit/Request Ratio")
+	table := component.NewTable("Limits", "This limit range does not have any limits!", cols)
+
+	for _, item := range limitRange.Spec.Limits {
+		for _, name := range sortedResourceNames(item.Min, item.Max, item.Default, item.DefaultRequest, item.MaxLimitRequestRatio) {
+			row := component.TableRow{
+				"Type":                    component.NewText(string(item.Type)),
+				"Resource":                component.NewText(string(name)),
+				"Min":                     component.NewText(limitRangeQuantity(item.Min, name)),
+				"Max":                     component.NewText(limitRangeQuantity(item.Max, name)),
+				"Default Request":         component.NewText(limitRangeQuantity(item.DefaultRequest, name)),
+				"Default Limit":           component.NewText(limitRangeQuantity(item.Default, name)),
+				"Max Limit/Request Ratio": component.NewText(limitRangeQuantity(item.MaxLimitRequestRatio, name)),
+			}
+
+			table.Add(row)
+		}
+	}
+
+	return table, nil
+}
+
+func limitRangeQuantity(list corev1.ResourceList, name corev1.ResourceName) string {
+	quantity, ok := list[name]
+	if !ok {
+		return "-"
+	}
+
+	return quantity.String()
+}
+
+type limitRangeObject interface {
+	Config() error
+	Limits() error
+}
+
+type limitRangeHandler struct {
+	limitRange *corev1.LimitRange
+	configFunc func(*corev1.LimitRange) (*component.Summary, error)
+	limitsFunc func(*corev1.LimitRange) (*component.Table, error)
+	object     *Object
+}
+
+var _ limitRangeObject = (*limitRangeHandler)(nil)
+
+func newLimitRangeHandler(limitRange *corev1.LimitRange, object *Object) (*limitRangeHandler, error) {
+	if limitRange == nil {
+		return nil, errors.New("can't print a nil limit range")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print limit range using a nil object printer")
+	}
+
+	lh := &limitRangeHandler{
+		limitRange: limitRange,
+		configFunc: defaultLimitRangeConfig,
+		limitsFunc: defaultLimitRangeLimits,
+		object:     object,
+	}
+
+	return lh, nil
+}
+
+func (l *limitRangeHandler) Config() error {
+	out, err := l.configFunc(l.limitRange)
+	if err != nil {
+		return err
+	}
+
+	l.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultLimitRangeConfig(limitRange *corev1.LimitRange) (*component.Summary, error) {
+	return NewLimitRangeConfiguration(limitRange).Create()
+}
+
+func (l *limitRangeHandler) Limits() error {
+	if l.limitRange == nil {
+		return errors.New("can't display limits for nil limit range")
+	}
+
+	l.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return l.limitsFunc(l.limitRange)
+		},
+	})
+
+	return nil
+}
+
+func defaultLimitRangeLimits(limitRange *corev1.LimitRange) (*component.Table, error) {
+	return createLimitRangeLimitsView(limitRange)
+}
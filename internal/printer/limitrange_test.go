@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createTestLimitRange() *corev1.LimitRange {
+	limitRange := testutil.CreateLimitRange("limits")
+	limitRange.CreationTimestamp = metav1.Time{Time: testutil.Time()}
+	limitRange.Spec.Limits = []corev1.LimitRangeItem{
+		{
+			Type: corev1.LimitTypeContainer,
+			Max: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("2"),
+			},
+			Default: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			},
+			DefaultRequest: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("100m"),
+			},
+		},
+		{
+			Type: corev1.LimitTypePod,
+			Max: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+	}
+
+	return limitRange
+}
+
+func Test_LimitRangeListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	limitRange := createTestLimitRange()
+	tpo.PathForObject(limitRange, limitRange.Name, "/limits")
+
+	list := &corev1.LimitRangeList{
+		Items: []corev1.LimitRange{*limitRange},
+	}
+
+	ctx := context.Background()
+	got, err := LimitRangeListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Labels", "Types", "Age")
+	expected := component.NewTable("Limit Ranges", "We couldn't find any limit ranges!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", limitRange.Name, "/limits",
+			genObjectStatus(component.TextStatusOK, []string{"v1 LimitRange is OK"})),
+		"Labels": component.NewLabels(limitRange.Labels),
+		"Types":  component.NewText("Container, Pod"),
+		"Age":    component.NewTimestamp(testutil.Time()),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, limitRange),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_LimitRangeConfiguration(t *testing.T) {
+	cases := []struct {
+		name       string
+		limitRange *corev1.LimitRange
+		expected   component.Component
+		isErr      bool
+	}{
+		{
+			name:       "general",
+			limitRange: createTestLimitRange(),
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Container Default Limit",
+					Content: component.NewText("cpu: 500m, memory: 256Mi"),
+				},
+				{
+					Header:  "Container Default Request",
+					Content: component.NewText("cpu: 100m"),
+				},
+			}...),
+		},
+		{
+			name:       "limit range is nil",
+			limitRange: nil,
+			isErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lc := NewLimitRangeConfiguration(tc.limitRange)
+
+			summary, err := lc.Create()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
+
+func Test_createLimitRangeLimitsView(t *testing.T) {
+	got, err := createLimitRangeLimitsView(createTestLimitRange())
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Type", "Resource", "Min", "Max", "Default Request", "Default Limit", "Max Limit/Request Ratio")
+	expected := component.NewTableWithRows("Limits", "This limit range does not have any limits!", cols,
+		[]component.TableRow{
+			{
+				"Type":                    component.NewText("Container"),
+				"Resource":                component.NewText("cpu"),
+				"Min":                     component.NewText("-"),
+				"Max":                     component.NewText("2"),
+				"Default Request":         component.NewText("100m"),
+				"Default Limit":           component.NewText("500m"),
+				"Max Limit/Request Ratio": component.NewText("-"),
+			},
+			{
+				"Type":                    component.NewText("Container"),
+				"Resource":                component.NewText("memory"),
+				"Min":                     component.NewText("-"),
+				"Max":                     component.NewText("-"),
+				"Default Request":         component.NewText("-"),
+				"Default Limit":           component.NewText("256Mi"),
+				"Max Limit/Request Ratio": component.NewText("-"),
+			},
+			{
+				"Type":                    component.NewText("Pod"),
+				"Resource":                component.NewText("memory"),
+				"Min":                     component.NewText("-"),
+				"Max":                     component.NewText("1Gi"),
+				"Default Request":         component.NewText("-"),
+				"Default Limit":           component.NewText("-"),
+				"Max Limit/Request Ratio": component.NewText("-"),
+			},
+		})
+
+	component.AssertEqual(t, expected, got)
+}
@@ -293,6 +293,12 @@ func (n *NodeConfiguration) Create(options Options) (*component.Summary, error)
 		},
 	}...)
 
+	action, err := debugNodeAction(n.node)
+	if err != nil {
+		return nil, err
+	}
+	summary.AddAction(action)
+
 	return summary, nil
 }
 
@@ -64,6 +64,56 @@ func Test_NodeConfiguration(t *testing.T) {
 	node.Spec.PodCIDR = "10.244.0.0/24"
 	node.Status.NodeInfo.SystemUUID = "5AF9704C-2606-11B2-A85C-C7F92F2B85CA"
 
+	generalSummary := component.NewSummary("Status", []component.SummarySection{
+		{
+			Header:  "Architecture",
+			Content: component.NewText("amd64"),
+		},
+		{
+			Header:  "Boot ID",
+			Content: component.NewText("7eee89e0-b78a-4c30-a1bc-d43ad479b35a"),
+		},
+		{
+			Header:  "Container Runtime Version",
+			Content: component.NewText("containerd://1.2.6-0ubuntu1"),
+		},
+		{
+			Header:  "Kernel Version",
+			Content: component.NewText("4.15.0-58-generic"),
+		},
+		{
+			Header:  "KubeProxy Version",
+			Content: component.NewText("v1.15.3"),
+		},
+		{
+			Header:  "Kubelet Version",
+			Content: component.NewText("v1.15.3"),
+		},
+		{
+			Header:  "Machine ID",
+			Content: component.NewText("87050f150cca41c0ab58b7672b5dbc11"),
+		},
+		{
+			Header:  "Operating System",
+			Content: component.NewText("linux"),
+		},
+		{
+			Header:  "OS Image",
+			Content: component.NewText("Ubuntu Disco Dingo (development branch)"),
+		},
+		{
+			Header:  "Pod CIDR",
+			Content: component.NewText("10.244.0.0/24"),
+		},
+		{
+			Header:  "System UUID",
+			Content: component.NewText("5AF9704C-2606-11B2-A85C-C7F92F2B85CA"),
+		},
+	}...)
+	debugAction, err := debugNodeAction(node)
+	require.NoError(t, err)
+	generalSummary.AddAction(debugAction)
+
 	cases := []struct {
 		name     string
 		node     *corev1.Node
@@ -71,54 +121,9 @@ func Test_NodeConfiguration(t *testing.T) {
 		expected *component.Summary
 	}{
 		{
-			name: "general",
-			node: node,
-			expected: component.NewSummary("Status", []component.SummarySection{
-				{
-					Header:  "Architecture",
-					Content: component.NewText("amd64"),
-				},
-				{
-					Header:  "Boot ID",
-					Content: component.NewText("7eee89e0-b78a-4c30-a1bc-d43ad479b35a"),
-				},
-				{
-					Header:  "Container Runtime Version",
-					Content: component.NewText("containerd://1.2.6-0ubuntu1"),
-				},
-				{
-					Header:  "Kernel Version",
-					Content: component.NewText("4.15.0-58-generic"),
-				},
-				{
-					Header:  "KubeProxy Version",
-					Content: component.NewText("v1.15.3"),
-				},
-				{
-					Header:  "Kubelet Version",
-					Content: component.NewText("v1.15.3"),
-				},
-				{
-					Header:  "Machine ID",
-					Content: component.NewText("87050f150cca41c0ab58b7672b5dbc11"),
-				},
-				{
-					Header:  "Operating System",
-					Content: component.NewText("linux"),
-				},
-				{
-					Header:  "OS Image",
-					Content: component.NewText("Ubuntu Disco Dingo (development branch)"),
-				},
-				{
-					Header:  "Pod CIDR",
-					Content: component.NewText("10.244.0.0/24"),
-				},
-				{
-					Header:  "System UUID",
-					Content: component.NewText("5AF9704C-2606-11B2-A85C-C7F92F2B85CA"),
-				},
-			}...),
+			name:     "general",
+			node:     node,
+			expected: generalSummary,
 		},
 		{
 			name:  "pod is nil",
@@ -11,7 +11,9 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -49,7 +51,7 @@ func PersistentVolumeListHandler(ctx context.Context, list *corev1.PersistentVol
 		row["Reclaim Policy"] = component.NewText(string(pv.Spec.PersistentVolumeReclaimPolicy))
 		row["Status"] = component.NewText(string(pv.Status.Phase))
 		row["Claim"] = claimLink
-		row["Storage Class"] = component.NewText(pv.Spec.StorageClassName)
+		row["Storage Class"] = storageClassLink(pv.Spec.StorageClassName, options)
 		row["Reason"] = component.NewText(pv.Status.Reason)
 		row["Age"] = component.NewTimestamp(pv.CreationTimestamp.Time)
 
@@ -79,12 +81,17 @@ func PersistentVolumeHandler(ctx context.Context, pv *corev1.PersistentVolume, o
 		return nil, errors.Wrap(err, "print persistent volume claims")
 	}
 
+	if err := pvh.VolumeAttachments(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print persistent volume attachments")
+	}
+
 	return obj.ToComponent(ctx, options)
 }
 
 type persistentVolumeHandler struct {
 	configFunc       func(*corev1.PersistentVolume, Options) (*component.Summary, error)
 	statusFunc       func(context.Context, *corev1.PersistentVolume, Options) (*component.Summary, error)
+	attachmentsFunc  func(context.Context, *corev1.PersistentVolume, Options) (component.Component, error)
 	persistentVolume *corev1.PersistentVolume
 	object           *Object
 }
@@ -100,6 +107,7 @@ func newPersistentVolumeHandler(pv *corev1.PersistentVolume, object *Object) (*p
 	pvh := &persistentVolumeHandler{
 		configFunc:       defaultPersistentVolumeConfig,
 		statusFunc:       defaultPersistentVolumeStatus,
+		attachmentsFunc:  defaultPersistentVolumeAttachments,
 		persistentVolume: pv,
 		object:           object,
 	}
@@ -125,6 +133,16 @@ func (pvh *persistentVolumeHandler) Status(ctx context.Context, options Options)
 	return nil
 }
 
+func (pvh *persistentVolumeHandler) VolumeAttachments(ctx context.Context, options Options) error {
+	pvh.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return pvh.attachmentsFunc(ctx, pvh.persistentVolume, options)
+		},
+	})
+	return nil
+}
+
 func defaultPersistentVolumeConfig(pv *corev1.PersistentVolume, options Options) (*component.Summary, error) {
 	return NewPersistentVolumeConfiguration(pv).Create(options)
 }
@@ -159,7 +177,7 @@ func (pvc *PersistentVolumeConfiguration) Create(options Options) (*component.Su
 
 	var sections component.SummarySections
 	sections.AddText("Reclaim Policy", string(pv.Spec.PersistentVolumeReclaimPolicy))
-	sections.AddText("Storage Class", pv.Spec.StorageClassName)
+	sections.Add("Storage Class", storageClassLink(pv.Spec.StorageClassName, options))
 	sections.AddText("Access Modes", accessModes)
 	sections.AddText("Capacity", capacity)
 
@@ -210,6 +228,38 @@ func (pvs *PersistentVolumeStatus) Create(ctx context.Context, options Options)
 	return summary, nil
 }
 
+func defaultPersistentVolumeAttachments(ctx context.Context, pv *corev1.PersistentVolume, options Options) (component.Component, error) {
+	return createPersistentVolumeAttachmentsView(ctx, pv, options)
+}
+
+func createPersistentVolumeAttachmentsView(ctx context.Context, pv *corev1.PersistentVolume, options Options) (component.Component, error) {
+	if pv == nil {
+		return nil, errors.New("Persistent Volume is nil")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	ul, _, err := objectStore.List(ctx, store.KeyFromGroupVersionKind(gvk.VolumeAttachment))
+	if err != nil {
+		return nil, errors.Wrap(err, "list volume attachments")
+	}
+
+	list := &storagev1.VolumeAttachmentList{}
+	for i := range ul.Items {
+		volumeAttachment := storagev1.VolumeAttachment{}
+		if err := kubernetes.FromUnstructured(&ul.Items[i], &volumeAttachment); err != nil {
+			return nil, err
+		}
+
+		name := volumeAttachment.Spec.Source.PersistentVolumeName
+		if name != nil && *name == pv.Name {
+			list.Items = append(list.Items, volumeAttachment)
+		}
+	}
+
+	return VolumeAttachmentListHandler(ctx, list, options)
+}
+
 func getBoundPersistentVolumeClaim(ctx context.Context, pv *corev1.PersistentVolume, options Options) (*corev1.PersistentVolumeClaim, error) {
 	objectStore := options.DashConfig.ObjectStore()
 	pvc := &corev1.PersistentVolumeClaim{}
@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/octant/internal/gvk"
 	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
@@ -68,7 +69,7 @@ func PersistentVolumeClaimListHandler(ctx context.Context, list *corev1.Persiste
 		row["Status"] = component.NewText(string(persistentVolumeClaim.Status.Phase))
 		row["Capacity"] = component.NewText(capacity)
 		row["Access Modes"] = component.NewText(accessModes)
-		row["Storage Class"] = component.NewText(printPersistentVolumeClaimClass(&persistentVolumeClaim))
+		row["Storage Class"] = storageClassLink(printPersistentVolumeClaimClass(&persistentVolumeClaim), options)
 		ts := persistentVolumeClaim.CreationTimestamp.Time
 		row["Age"] = component.NewTimestamp(ts)
 
@@ -138,7 +139,11 @@ func (p *PersistentVolumeClaimConfiguration) Create(options Options) (*component
 	}
 
 	if storageClassName := persistentVolumeClaim.Spec.StorageClassName; storageClassName != nil {
-		sections.AddText("Storage Class Name", string(*storageClassName))
+		sections.Add("Storage Class Name", storageClassLink(*storageClassName, options))
+	}
+
+	if dataSource := persistentVolumeClaim.Spec.DataSource; dataSource != nil {
+		sections.Add("Data Source", persistentVolumeClaimDataSource(persistentVolumeClaim.Namespace, dataSource, options))
 	}
 
 	if labels := persistentVolumeClaim.Labels; labels != nil {
@@ -196,6 +201,29 @@ func createPersistentVolumeClaimStatusView(ctx context.Context, persistentVolume
 	return summary, nil
 }
 
+// persistentVolumeClaimDataSource creates a link to the object a persistent volume
+// claim was populated from, e.g. a VolumeSnapshot or another claim.
+func persistentVolumeClaimDataSource(namespace string, dataSource *corev1.TypedLocalObjectReference, options Options) component.Component {
+	text := fmt.Sprintf("%s %s", dataSource.Kind, dataSource.Name)
+
+	var apiVersion string
+	switch {
+	case dataSource.APIGroup == nil && dataSource.Kind == gvk.PersistentVolumeClaim.Kind:
+		apiVersion = gvk.PersistentVolumeClaim.GroupVersion().String()
+	case dataSource.APIGroup != nil && *dataSource.APIGroup == gvk.VolumeSnapshot.Group && dataSource.Kind == gvk.VolumeSnapshot.Kind:
+		apiVersion = gvk.VolumeSnapshot.GroupVersion().String()
+	default:
+		return component.NewText(text)
+	}
+
+	link, err := options.Link.ForGVK(namespace, apiVersion, dataSource.Kind, dataSource.Name, text)
+	if err != nil {
+		return component.NewText(text)
+	}
+
+	return link
+}
+
 func printPersistentVolumeClaimClass(persistentVolumeClaim *corev1.PersistentVolumeClaim) string {
 	if class, found := persistentVolumeClaim.Annotations[corev1.BetaStorageClassAnnotation]; found {
 		return class
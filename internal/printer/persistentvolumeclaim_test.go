@@ -20,6 +20,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
 )
 
 func Test_PersistentVolumeClaimListHandler(t *testing.T) {
@@ -61,7 +62,7 @@ func Test_PersistentVolumeClaimListHandler(t *testing.T) {
 				"Volume":        component.NewLink("", pv.GetName(), fmt.Sprintf("/%s", pv.GetName())),
 				"Capacity":      component.NewText("10Gi"),
 				"Access Modes":  component.NewText("RWO"),
-				"Storage Class": component.NewText("manual"),
+				"Storage Class": component.NewLink("", "manual", "/manual"),
 				"Age":           component.NewTimestamp(now),
 				component.GridActionKey: gridActionsFactory([]component.GridAction{
 					buildObjectDeleteAction(t, object),
@@ -79,7 +80,7 @@ func Test_PersistentVolumeClaimListHandler(t *testing.T) {
 				"Volume":        component.NewText(""),
 				"Capacity":      component.NewText(""),
 				"Access Modes":  component.NewText(""),
-				"Storage Class": component.NewText("manual"),
+				"Storage Class": component.NewLink("", "manual", "/manual"),
 				"Age":           component.NewTimestamp(now),
 				component.GridActionKey: gridActionsFactory([]component.GridAction{
 					buildObjectDeleteAction(t, object),
@@ -104,6 +105,7 @@ func Test_PersistentVolumeClaimListHandler(t *testing.T) {
 			table := component.NewTable("Persistent Volume Claims", "We couldn't find any persistent volume claims!", cols)
 
 			tpo.PathForObject(object, object.Name, "/pvc")
+			tpo.PathForGVK("", "storage.k8s.io/v1", "StorageClass", "manual", "manual", "/manual")
 
 			if tc.persistentvolume != nil {
 				tpo.PathForObject(tc.persistentvolume, tc.persistentvolume.GetName(), fmt.Sprintf("/%s", tc.persistentvolume.GetName()))
@@ -143,6 +145,13 @@ func Test_PersistentVolumeClaimConfiguration(t *testing.T) {
 		MatchLabels: labels,
 	}
 
+	snapshotPVC := testutil.CreatePersistentVolumeClaim("restored")
+	snapshotPVC.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: pointer.StringPtr("snapshot.storage.k8s.io"),
+		Kind:     "VolumeSnapshot",
+		Name:     "snapshot",
+	}
+
 	cases := []struct {
 		name                  string
 		persistentVolumeClaim *corev1.PersistentVolumeClaim
@@ -167,7 +176,7 @@ func Test_PersistentVolumeClaimConfiguration(t *testing.T) {
 				},
 				{
 					Header:  "Storage Class Name",
-					Content: component.NewText("manual"),
+					Content: component.NewLink("", "manual", "/manual"),
 				},
 				{
 					Header:  "Labels",
@@ -179,6 +188,28 @@ func Test_PersistentVolumeClaimConfiguration(t *testing.T) {
 				},
 			}...),
 		},
+		{
+			name:                  "volume snapshot data source",
+			persistentVolumeClaim: snapshotPVC,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Volume Mode",
+					Content: component.NewText("Filesystem"),
+				},
+				{
+					Header:  "Access Modes",
+					Content: component.NewText("RWO"),
+				},
+				{
+					Header:  "Storage Class Name",
+					Content: component.NewLink("", "manual", "/manual"),
+				},
+				{
+					Header:  "Data Source",
+					Content: component.NewLink("", "VolumeSnapshot snapshot", "/snapshot"),
+				},
+			}...),
+		},
 		{
 			name:                  "pvc is nil",
 			persistentVolumeClaim: nil,
@@ -193,6 +224,9 @@ func Test_PersistentVolumeClaimConfiguration(t *testing.T) {
 		tpo := newTestPrinterOptions(controller)
 		printOptions := tpo.ToOptions()
 
+		tpo.PathForGVK("", "storage.k8s.io/v1", "StorageClass", "manual", "manual", "/manual")
+		tpo.PathForGVK("namespace", "snapshot.storage.k8s.io/v1beta1", "VolumeSnapshot", "snapshot", "VolumeSnapshot snapshot", "/snapshot")
+
 		pc := NewPersistentVolumeClaimConfiguration(tc.persistentVolumeClaim)
 
 		summary, err := pc.Create(printOptions)
@@ -252,6 +252,15 @@ func (p *PodConfiguration) Create(options Options) (*component.Summary, error) {
 	})
 
 	summary := component.NewSummary("Configuration", sections...)
+
+	if pod.Status.Phase == corev1.PodRunning {
+		action, err := debugPodAction(pod)
+		if err != nil {
+			return nil, err
+		}
+		summary.AddAction(action)
+	}
+
 	return summary, nil
 }
 
@@ -264,6 +264,28 @@ func Test_PodConfiguration(t *testing.T) {
 
 	nodeLink := component.NewLink("", "node", "/node")
 
+	generalSummary := component.NewSummary("Configuration", []component.SummarySection{
+		{
+			Header:  "Priority",
+			Content: component.NewText("1000000"),
+		},
+		{
+			Header:  "PriorityClassName",
+			Content: component.NewText("high-priority"),
+		},
+		{
+			Header:  "Node",
+			Content: nodeLink,
+		},
+		{
+			Header:  "Service Account",
+			Content: component.NewLink("", "serviceAccount", "/service-account"),
+		},
+	}...)
+	debugAction, err := debugPodAction(validPod)
+	require.NoError(t, err)
+	generalSummary.AddAction(debugAction)
+
 	cases := []struct {
 		name     string
 		pod      *corev1.Pod
@@ -271,26 +293,9 @@ func Test_PodConfiguration(t *testing.T) {
 		expected *component.Summary
 	}{
 		{
-			name: "general",
-			pod:  validPod,
-			expected: component.NewSummary("Configuration", []component.SummarySection{
-				{
-					Header:  "Priority",
-					Content: component.NewText("1000000"),
-				},
-				{
-					Header:  "PriorityClassName",
-					Content: component.NewText("high-priority"),
-				},
-				{
-					Header:  "Node",
-					Content: nodeLink,
-				},
-				{
-					Header:  "Service Account",
-					Content: component.NewLink("", "serviceAccount", "/service-account"),
-				},
-			}...),
+			name:     "general",
+			pod:      validPod,
+			expected: generalSummary,
 		},
 		{
 			name:  "pod is nil",
@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// PodDisruptionBudgetListHandler is a printFunc that prints pod disruption budgets
+func PodDisruptionBudgetListHandler(ctx context.Context, list *policyv1beta1.PodDisruptionBudgetList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("pod disruption budget list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Min Available", "Max Unavailable", "Allowed Disruptions", "Age")
+	ot := NewObjectTable("Pod Disruption Budgets", "We couldn't find any pod disruption budgets!", cols, options.DashConfig.ObjectStore())
+
+	for _, podDisruptionBudget := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&podDisruptionBudget, podDisruptionBudget.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Labels"] = component.NewLabels(podDisruptionBudget.Labels)
+		row["Min Available"] = component.NewText(podDisruptionBudgetMinAvailable(&podDisruptionBudget))
+		row["Max Unavailable"] = component.NewText(podDisruptionBudgetMaxUnavailable(&podDisruptionBudget))
+		row["Allowed Disruptions"] = component.NewText(fmt.Sprintf("%d", podDisruptionBudget.Status.DisruptionsAllowed))
+		row["Age"] = component.NewTimestamp(podDisruptionBudget.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &podDisruptionBudget, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// PodDisruptionBudgetHandler is a printFunc that prints a PodDisruptionBudget
+func PodDisruptionBudgetHandler(ctx context.Context, podDisruptionBudget *policyv1beta1.PodDisruptionBudget, options Options) (component.Component, error) {
+	o := NewObject(podDisruptionBudget)
+	o.EnableEvents()
+
+	ph, err := newPodDisruptionBudgetHandler(podDisruptionBudget, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ph.Config(); err != nil {
+		return nil, errors.Wrap(err, "print poddisruptionbudget configuration")
+	}
+
+	if err := ph.Status(); err != nil {
+		return nil, errors.Wrap(err, "print poddisruptionbudget status")
+	}
+
+	if err := ph.Pods(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print poddisruptionbudget pods")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// PodDisruptionBudgetConfiguration generates a pod disruption budget configuration
+type PodDisruptionBudgetConfiguration struct {
+	podDisruptionBudget *policyv1beta1.PodDisruptionBudget
+}
+
+// NewPodDisruptionBudgetConfiguration creates an instance of PodDisruptionBudgetConfiguration
+func NewPodDisruptionBudgetConfiguration(podDisruptionBudget *policyv1beta1.PodDisruptionBudget) *PodDisruptionBudgetConfiguration {
+	return &PodDisruptionBudgetConfiguration{
+		podDisruptionBudget: podDisruptionBudget,
+	}
+}
+
+// Create creates a pod disruption budget configuration summary
+func (p *PodDisruptionBudgetConfiguration) Create() (*component.Summary, error) {
+	if p.podDisruptionBudget == nil {
+		return nil, errors.New("pod disruption budget is nil")
+	}
+
+	podDisruptionBudget := p.podDisruptionBudget
+
+	sections := component.SummarySections{}
+
+	if podDisruptionBudget.Spec.MinAvailable != nil {
+		sections.AddText("Min Available", podDisruptionBudget.Spec.MinAvailable.String())
+	}
+
+	if podDisruptionBudget.Spec.MaxUnavailable != nil {
+		sections.AddText("Max Unavailable", podDisruptionBudget.Spec.MaxUnavailable.String())
+	}
+
+	if podDisruptionBudget.Spec.Selector != nil {
+		selectors, err := selectorToComponent(podDisruptionBudget.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+
+		sections.Add("Selectors", selectors)
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createPodDisruptionBudgetSummaryStatus(podDisruptionBudget *policyv1beta1.PodDisruptionBudget) (*component.Summary, error) {
+	if podDisruptionBudget == nil {
+		return nil, errors.New("unable to generate status for a nil pod disruption budget")
+	}
+
+	status := podDisruptionBudget.Status
+
+	sections := component.SummarySections{}
+
+	allowed := component.NewTextf("%d", status.DisruptionsAllowed)
+	if status.DisruptionsAllowed < 1 {
+		allowed.SetStatus(component.TextStatusWarning)
+	}
+	sections.Add("Allowed Disruptions", allowed)
+
+	sections.AddText("Current Healthy", fmt.Sprintf("%d", status.CurrentHealthy))
+	sections.AddText("Desired Healthy", fmt.Sprintf("%d", status.DesiredHealthy))
+	sections.AddText("Expected Pods", fmt.Sprintf("%d", status.ExpectedPods))
+	sections.AddText("Observed Generation", fmt.Sprintf("%d", status.ObservedGeneration))
+
+	summary := component.NewSummary("Status", sections...)
+	return summary, nil
+}
+
+func createPodDisruptionBudgetPodListView(ctx context.Context, podDisruptionBudget *policyv1beta1.PodDisruptionBudget, options Options) (component.Component, error) {
+	if podDisruptionBudget == nil {
+		return nil, errors.New("unable to list pods for a nil pod disruption budget")
+	}
+
+	options.DisableLabels = true
+	podList := &corev1.PodList{}
+
+	// A pod disruption budget with an empty or missing selector does not select any pods.
+	selector := podDisruptionBudget.Spec.Selector
+	if selector == nil || (len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0) {
+		return PodListHandler(ctx, podList, options)
+	}
+
+	key := store.Key{
+		Namespace:  podDisruptionBudget.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	pods, err := loadPods(ctx, key, options.DashConfig.ObjectStore(), selector)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range pods {
+		podList.Items = append(podList.Items, *pod)
+	}
+
+	return PodListHandler(ctx, podList, options)
+}
+
+func podDisruptionBudgetMinAvailable(podDisruptionBudget *policyv1beta1.PodDisruptionBudget) string {
+	if podDisruptionBudget.Spec.MinAvailable == nil {
+		return "N/A"
+	}
+
+	return podDisruptionBudget.Spec.MinAvailable.String()
+}
+
+func podDisruptionBudgetMaxUnavailable(podDisruptionBudget *policyv1beta1.PodDisruptionBudget) string {
+	if podDisruptionBudget.Spec.MaxUnavailable == nil {
+		return "N/A"
+	}
+
+	return podDisruptionBudget.Spec.MaxUnavailable.String()
+}
+
+type podDisruptionBudgetObject interface {
+	Config() error
+	Status() error
+	Pods(ctx context.Context, options Options) error
+}
+
+type podDisruptionBudgetHandler struct {
+	podDisruptionBudget *policyv1beta1.PodDisruptionBudget
+	configFunc          func(*policyv1beta1.PodDisruptionBudget) (*component.Summary, error)
+	statusFunc          func(*policyv1beta1.PodDisruptionBudget) (*component.Summary, error)
+	podFunc             func(context.Context, *policyv1beta1.PodDisruptionBudget, Options) (component.Component, error)
+	object              *Object
+}
+
+var _ podDisruptionBudgetObject = (*podDisruptionBudgetHandler)(nil)
+
+func newPodDisruptionBudgetHandler(podDisruptionBudget *policyv1beta1.PodDisruptionBudget, object *Object) (*podDisruptionBudgetHandler, error) {
+	if podDisruptionBudget == nil {
+		return nil, errors.New("can't print a nil pod disruption budget")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print pod disruption budget using a nil object printer")
+	}
+
+	ph := &podDisruptionBudgetHandler{
+		podDisruptionBudget: podDisruptionBudget,
+		configFunc:          defaultPodDisruptionBudgetConfig,
+		statusFunc:          defaultPodDisruptionBudgetStatus,
+		podFunc:             defaultPodDisruptionBudgetPods,
+		object:              object,
+	}
+
+	return ph, nil
+}
+
+func (p *podDisruptionBudgetHandler) Config() error {
+	out, err := p.configFunc(p.podDisruptionBudget)
+	if err != nil {
+		return err
+	}
+
+	p.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultPodDisruptionBudgetConfig(podDisruptionBudget *policyv1beta1.PodDisruptionBudget) (*component.Summary, error) {
+	return NewPodDisruptionBudgetConfiguration(podDisruptionBudget).Create()
+}
+
+func (p *podDisruptionBudgetHandler) Status() error {
+	out, err := p.statusFunc(p.podDisruptionBudget)
+	if err != nil {
+		return err
+	}
+
+	p.object.RegisterSummary(out)
+	return nil
+}
+
+func defaultPodDisruptionBudgetStatus(podDisruptionBudget *policyv1beta1.PodDisruptionBudget) (*component.Summary, error) {
+	return createPodDisruptionBudgetSummaryStatus(podDisruptionBudget)
+}
+
+func (p *podDisruptionBudgetHandler) Pods(ctx context.Context, options Options) error {
+	p.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return p.podFunc(ctx, p.podDisruptionBudget, options)
+		},
+	})
+	return nil
+}
+
+func defaultPodDisruptionBudgetPods(ctx context.Context, podDisruptionBudget *policyv1beta1.PodDisruptionBudget, options Options) (component.Component, error) {
+	return createPodDisruptionBudgetPodListView(ctx, podDisruptionBudget, options)
+}
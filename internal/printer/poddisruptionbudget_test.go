@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func createTestPodDisruptionBudget() *policyv1beta1.PodDisruptionBudget {
+	minAvailable := intstr.FromInt(2)
+
+	podDisruptionBudget := testutil.CreatePodDisruptionBudget("pdb")
+	podDisruptionBudget.CreationTimestamp = metav1.Time{Time: testutil.Time()}
+	podDisruptionBudget.Spec.MinAvailable = &minAvailable
+	podDisruptionBudget.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"app": "my_app",
+		},
+	}
+	podDisruptionBudget.Status = policyv1beta1.PodDisruptionBudgetStatus{
+		ObservedGeneration: 1,
+		DisruptionsAllowed: 1,
+		CurrentHealthy:     3,
+		DesiredHealthy:     2,
+		ExpectedPods:       3,
+	}
+
+	return podDisruptionBudget
+}
+
+func Test_PodDisruptionBudgetListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	podDisruptionBudget := createTestPodDisruptionBudget()
+	tpo.PathForObject(podDisruptionBudget, podDisruptionBudget.Name, "/pdb")
+
+	list := &policyv1beta1.PodDisruptionBudgetList{
+		Items: []policyv1beta1.PodDisruptionBudget{*podDisruptionBudget},
+	}
+
+	ctx := context.Background()
+	got, err := PodDisruptionBudgetListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Labels", "Min Available", "Max Unavailable", "Allowed Disruptions", "Age")
+	expected := component.NewTable("Pod Disruption Budgets", "We couldn't find any pod disruption budgets!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", podDisruptionBudget.Name, "/pdb",
+			genObjectStatus(component.TextStatusOK, []string{"policy/v1beta1 PodDisruptionBudget is OK"})),
+		"Labels":              component.NewLabels(podDisruptionBudget.Labels),
+		"Min Available":       component.NewText("2"),
+		"Max Unavailable":     component.NewText("N/A"),
+		"Allowed Disruptions": component.NewText("1"),
+		"Age":                 component.NewTimestamp(testutil.Time()),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, podDisruptionBudget),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_PodDisruptionBudgetConfiguration(t *testing.T) {
+	cases := []struct {
+		name                string
+		podDisruptionBudget *policyv1beta1.PodDisruptionBudget
+		expected            component.Component
+		isErr               bool
+	}{
+		{
+			name:                "general",
+			podDisruptionBudget: createTestPodDisruptionBudget(),
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Min Available",
+					Content: component.NewText("2"),
+				},
+				{
+					Header: "Selectors",
+					Content: component.NewSelectors([]component.Selector{
+						component.NewLabelSelector("app", "my_app"),
+					}),
+				},
+			}...),
+		},
+		{
+			name:                "pod disruption budget is nil",
+			podDisruptionBudget: nil,
+			isErr:               true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pc := NewPodDisruptionBudgetConfiguration(tc.podDisruptionBudget)
+
+			summary, err := pc.Create()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
+
+func Test_createPodDisruptionBudgetSummaryStatus(t *testing.T) {
+	podDisruptionBudget := createTestPodDisruptionBudget()
+	podDisruptionBudget.Status.DisruptionsAllowed = 0
+
+	got, err := createPodDisruptionBudgetSummaryStatus(podDisruptionBudget)
+	require.NoError(t, err)
+
+	allowed := component.NewText("0")
+	allowed.SetStatus(component.TextStatusWarning)
+
+	expected := component.NewSummary("Status", []component.SummarySection{
+		{Header: "Allowed Disruptions", Content: allowed},
+		{Header: "Current Healthy", Content: component.NewText("3")},
+		{Header: "Desired Healthy", Content: component.NewText("2")},
+		{Header: "Expected Pods", Content: component.NewText("3")},
+		{Header: "Observed Generation", Content: component.NewText("1")},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
@@ -52,6 +52,10 @@ func ReplicaSetListHandler(ctx context.Context, list *appsv1.ReplicaSetList, opt
 		row["Containers"] = containers
 		row["Selector"] = printSelector(rs.Spec.Selector)
 
+		if err := addWorkloadGridActions(row, &rs, workloadActions{scalable: true}); err != nil {
+			return nil, fmt.Errorf("add workload actions: %w", err)
+		}
+
 		if err := ot.AddRowForObject(ctx, &rs, row); err != nil {
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
@@ -65,6 +69,10 @@ func ReplicaSetHandler(ctx context.Context, replicaSet *appsv1.ReplicaSet, optio
 	o := NewObject(replicaSet)
 	o.EnableEvents()
 
+	if err := addWorkloadButtons(o, replicaSet, workloadActions{scalable: true}); err != nil {
+		return nil, err
+	}
+
 	rsh, err := newReplicaSetHandler(replicaSet, o)
 	if err != nil {
 		return nil, err
@@ -102,9 +102,10 @@ func Test_ReplicaSetListHandler(t *testing.T) {
 		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
 		"Status":     component.NewText("2/3"),
 		"Containers": containers,
-		component.GridActionKey: gridActionsFactory([]component.GridAction{
+		component.GridActionKey: gridActionsFactory(append(
+			buildWorkloadGridActions(t, &object.Items[0], workloadActions{scalable: true}),
 			buildObjectDeleteAction(t, &object.Items[0]),
-		}),
+		)),
 	})
 
 	component.AssertEqual(t, expected, got)
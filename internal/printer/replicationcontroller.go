@@ -54,6 +54,10 @@ func ReplicationControllerListHandler(ctx context.Context, list *corev1.Replicat
 
 		row["Selector"] = printSelectorMap(rc.Spec.Selector)
 
+		if err := addWorkloadGridActions(row, &rc, workloadActions{scalable: true}); err != nil {
+			return nil, fmt.Errorf("add workload actions: %w", err)
+		}
+
 		if err := ot.AddRowForObject(ctx, &rc, row); err != nil {
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
@@ -67,6 +71,10 @@ func ReplicationControllerHandler(ctx context.Context, rc *corev1.ReplicationCon
 	o := NewObject(rc)
 	o.EnableEvents()
 
+	if err := addWorkloadButtons(o, rc, workloadActions{scalable: true}); err != nil {
+		return nil, err
+	}
+
 	rch, err := newReplicationControllerHandler(rc, o)
 	if err != nil {
 		return nil, err
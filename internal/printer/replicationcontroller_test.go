@@ -95,9 +95,10 @@ func Test_ReplicationControllerListHandler(t *testing.T) {
 		"Age":        component.NewTimestamp(validReplicationControllerCreationTime),
 		"Containers": containers,
 		"Selector":   component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
-		component.GridActionKey: gridActionsFactory([]component.GridAction{
+		component.GridActionKey: gridActionsFactory(append(
+			buildWorkloadGridActions(t, validReplicationController, workloadActions{scalable: true}),
 			buildObjectDeleteAction(t, validReplicationController),
-		}),
+		)),
 	})
 
 	component.AssertEqual(t, expected, got)
@@ -0,0 +1,323 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+const (
+	// resourceQuotaWarningPercent is the usage percentage where a quota is considered close to being exhausted.
+	resourceQuotaWarningPercent = 80
+)
+
+// ResourceQuotaListHandler is a printFunc that prints resource quotas
+func ResourceQuotaListHandler(ctx context.Context, list *corev1.ResourceQuotaList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("resource quota list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Labels", "Request", "Limit", "Age")
+	ot := NewObjectTable("Resource Quotas", "We couldn't find any resource quotas!", cols, options.DashConfig.ObjectStore())
+
+	for _, resourceQuota := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&resourceQuota, resourceQuota.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		requests, limits := resourceQuotaUsageText(&resourceQuota)
+
+		row["Name"] = nameLink
+		row["Labels"] = component.NewLabels(resourceQuota.Labels)
+		row["Request"] = component.NewText(requests)
+		row["Limit"] = component.NewText(limits)
+		row["Age"] = component.NewTimestamp(resourceQuota.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &resourceQuota, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// ResourceQuotaHandler is a printFunc that prints a ResourceQuota
+func ResourceQuotaHandler(ctx context.Context, resourceQuota *corev1.ResourceQuota, options Options) (component.Component, error) {
+	o := NewObject(resourceQuota)
+	o.EnableEvents()
+
+	rh, err := newResourceQuotaHandler(resourceQuota, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rh.Config(); err != nil {
+		return nil, errors.Wrap(err, "print resourcequota configuration")
+	}
+
+	if err := rh.Usage(); err != nil {
+		return nil, errors.Wrap(err, "print resourcequota usage")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// ResourceQuotaConfiguration generates a resource quota configuration
+type ResourceQuotaConfiguration struct {
+	resourceQuota *corev1.ResourceQuota
+}
+
+// NewResourceQuotaConfiguration creates an instance of ResourceQuotaConfiguration
+func NewResourceQuotaConfiguration(resourceQuota *corev1.ResourceQuota) *ResourceQuotaConfiguration {
+	return &ResourceQuotaConfiguration{
+		resourceQuota: resourceQuota,
+	}
+}
+
+// Create creates a resource quota configuration summary
+func (r *ResourceQuotaConfiguration) Create() (*component.Summary, error) {
+	if r.resourceQuota == nil {
+		return nil, errors.New("resource quota is nil")
+	}
+
+	resourceQuota := r.resourceQuota
+
+	sections := component.SummarySections{}
+
+	if len(resourceQuota.Spec.Scopes) > 0 {
+		var scopes []string
+		for _, scope := range resourceQuota.Spec.Scopes {
+			scopes = append(scopes, string(scope))
+		}
+		sections.AddText("Scopes", strings.Join(scopes, ", "))
+	}
+
+	if scopeSelector := resourceQuota.Spec.ScopeSelector; scopeSelector != nil {
+		var expressions []string
+		for _, expression := range scopeSelector.MatchExpressions {
+			text := fmt.Sprintf("%s %s", expression.ScopeName, expression.Operator)
+			if len(expression.Values) > 0 {
+				text = fmt.Sprintf("%s [%s]", text, strings.Join(expression.Values, ", "))
+			}
+			expressions = append(expressions, text)
+		}
+		sections.AddText("Scope Selector", strings.Join(expressions, ", "))
+	}
+
+	sections.AddText("Resources", fmt.Sprintf("%d", len(resourceQuota.Spec.Hard)))
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createResourceQuotaUsageView(resourceQuota *corev1.ResourceQuota) (*component.Table, error) {
+	if resourceQuota == nil {
+		return nil, errors.New("unable to generate usage for a nil resource quota")
+	}
+
+	cols := component.NewTableCols("Resource", "Used", "Hard", "Usage")
+	table := component.NewTable("Usage", "This resource quota does not limit any resources!", cols)
+
+	for _, name := range sortedResourceNames(resourceQuota.Status.Hard) {
+		hard := resourceQuota.Status.Hard[name]
+		used, ok := resourceQuota.Status.Used[name]
+
+		usedText := "0"
+		if ok {
+			usedText = used.String()
+		}
+
+		row := component.TableRow{
+			"Resource": component.NewText(string(name)),
+			"Used":     component.NewText(usedText),
+			"Hard":     component.NewText(hard.String()),
+			"Usage":    resourceQuotaUsage(used, hard),
+		}
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+// resourceQuotaUsage creates a progress bar showing the percentage of
+// a quota which has been used. The status is set to warning when the
+// usage approaches the hard limit and to error when the limit has been reached.
+// A quota with no hard limit which isn't used has no usage.
+func resourceQuotaUsage(used, hard resource.Quantity) component.Component {
+	if hard.Sign() <= 0 {
+		if used.Sign() <= 0 {
+			return component.NewText("n/a")
+		}
+
+		bar := component.NewProgressBar("100%", 100)
+		bar.SetStatus(component.TextStatusError)
+		return bar
+	}
+
+	percent := quantityPercent(used, hard)
+	bar := component.NewProgressBar(fmt.Sprintf("%d%%", percent), int(percent))
+
+	switch {
+	case percent >= 100:
+		bar.SetStatus(component.TextStatusError)
+	case percent >= resourceQuotaWarningPercent:
+		bar.SetStatus(component.TextStatusWarning)
+	default:
+		bar.SetStatus(component.TextStatusOK)
+	}
+
+	return bar
+}
+
+// quantityPercent returns used as a whole percentage of hard. The quantities
+// are compared as big integers, so large quantities don't overflow.
+func quantityPercent(used, hard resource.Quantity) int64 {
+	usedDec, hardDec := used.AsDec(), hard.AsDec()
+
+	// Bring both quantities to the same scale before dividing.
+	usedScale, hardScale := int64(usedDec.Scale()), int64(hardDec.Scale())
+	scale := usedScale
+	if hardScale > scale {
+		scale = hardScale
+	}
+
+	scaled := func(unscaled *big.Int, from int64) *big.Int {
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(scale-from), nil)
+		return new(big.Int).Mul(unscaled, factor)
+	}
+
+	n := scaled(usedDec.UnscaledBig(), usedScale)
+	n.Mul(n, big.NewInt(100))
+	n.Quo(n, scaled(hardDec.UnscaledBig(), hardScale))
+
+	if !n.IsInt64() {
+		return math.MaxInt64
+	}
+	return n.Int64()
+}
+
+// resourceQuotaUsageText generates a used/hard description for a resource quota's
+// requests and limits.
+func resourceQuotaUsageText(resourceQuota *corev1.ResourceQuota) (string, string) {
+	var requests, limits []string
+
+	for _, name := range sortedResourceNames(resourceQuota.Status.Hard) {
+		hard := resourceQuota.Status.Hard[name]
+		used := resourceQuota.Status.Used[name]
+
+		text := fmt.Sprintf("%s: %s/%s", name, used.String(), hard.String())
+
+		if strings.HasPrefix(string(name), "limits.") {
+			limits = append(limits, text)
+			continue
+		}
+
+		requests = append(requests, text)
+	}
+
+	return strings.Join(requests, ", "), strings.Join(limits, ", ")
+}
+
+// sortedResourceNames returns the unique resource names found in lists in sorted order.
+func sortedResourceNames(lists ...corev1.ResourceList) []corev1.ResourceName {
+	seen := map[corev1.ResourceName]bool{}
+	var names []corev1.ResourceName
+	for _, list := range lists {
+		for name := range list {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+
+	return names
+}
+
+type resourceQuotaObject interface {
+	Config() error
+	Usage() error
+}
+
+type resourceQuotaHandler struct {
+	resourceQuota *corev1.ResourceQuota
+	configFunc    func(*corev1.ResourceQuota) (*component.Summary, error)
+	usageFunc     func(*corev1.ResourceQuota) (*component.Table, error)
+	object        *Object
+}
+
+var _ resourceQuotaObject = (*resourceQuotaHandler)(nil)
+
+func newResourceQuotaHandler(resourceQuota *corev1.ResourceQuota, object *Object) (*resourceQuotaHandler, error) {
+	if resourceQuota == nil {
+		return nil, errors.New("can't print a nil resource quota")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print resource quota using a nil object printer")
+	}
+
+	rh := &resourceQuotaHandler{
+		resourceQuota: resourceQuota,
+		configFunc:    defaultResourceQuotaConfig,
+		usageFunc:     defaultResourceQuotaUsage,
+		object:        object,
+	}
+
+	return rh, nil
+}
+
+func (r *resourceQuotaHandler) Config() error {
+	out, err := r.configFunc(r.resourceQuota)
+	if err != nil {
+		return err
+	}
+
+	r.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultResourceQuotaConfig(resourceQuota *corev1.ResourceQuota) (*component.Summary, error) {
+	return NewResourceQuotaConfiguration(resourceQuota).Create()
+}
+
+func (r *resourceQuotaHandler) Usage() error {
+	if r.resourceQuota == nil {
+		return errors.New("can't display usage for nil resource quota")
+	}
+
+	r.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return r.usageFunc(r.resourceQuota)
+		},
+	})
+
+	return nil
+}
+
+func defaultResourceQuotaUsage(resourceQuota *corev1.ResourceQuota) (*component.Table, error) {
+	return createResourceQuotaUsageView(resourceQuota)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_ResourceQuotaListHandler(t *testing.T) {
+	cols := component.NewTableCols("Name", "Labels", "Request", "Limit", "Age")
+	now := testutil.Time()
+
+	labels := map[string]string{
+		"app": "testing",
+	}
+
+	object := testutil.CreateResourceQuota("quota")
+	object.Labels = labels
+	object.CreationTimestamp = metav1.Time{Time: now}
+	object.Status = corev1.ResourceQuotaStatus{
+		Hard: corev1.ResourceList{
+			corev1.ResourceRequestsCPU: resource.MustParse("2"),
+			corev1.ResourceLimitsCPU:   resource.MustParse("4"),
+			corev1.ResourcePods:        resource.MustParse("10"),
+		},
+		Used: corev1.ResourceList{
+			corev1.ResourceRequestsCPU: resource.MustParse("1"),
+			corev1.ResourceLimitsCPU:   resource.MustParse("2"),
+			corev1.ResourcePods:        resource.MustParse("3"),
+		},
+	}
+
+	list := &corev1.ResourceQuotaList{
+		Items: []corev1.ResourceQuota{*object},
+	}
+
+	cases := []struct {
+		name     string
+		list     *corev1.ResourceQuotaList
+		expected *component.Table
+		isErr    bool
+	}{
+		{
+			name: "in general",
+			list: list,
+			expected: component.NewTableWithRows("Resource Quotas", "We couldn't find any resource quotas!", cols,
+				[]component.TableRow{
+					{
+						"Name": component.NewLink("", "quota", "/quota",
+							genObjectStatus(component.TextStatusOK, []string{"v1 ResourceQuota is OK"})),
+						"Labels":  component.NewLabels(labels),
+						"Request": component.NewText("pods: 3/10, requests.cpu: 1/2"),
+						"Limit":   component.NewText("limits.cpu: 2/4"),
+						"Age":     component.NewTimestamp(now),
+						component.GridActionKey: gridActionsFactory([]component.GridAction{
+							buildObjectDeleteAction(t, object),
+						}),
+					},
+				}),
+		},
+		{
+			name:  "list is nil",
+			list:  nil,
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			ctx := context.Background()
+
+			if tc.list != nil {
+				tpo.PathForObject(&tc.list.Items[0], tc.list.Items[0].Name, "/quota")
+			}
+
+			got, err := ResourceQuotaListHandler(ctx, tc.list, printOptions)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, got)
+		})
+	}
+}
+
+func Test_ResourceQuotaConfiguration(t *testing.T) {
+	resourceQuota := testutil.CreateResourceQuota("quota")
+	resourceQuota.Spec.Scopes = []corev1.ResourceQuotaScope{
+		corev1.ResourceQuotaScopeBestEffort,
+	}
+	resourceQuota.Spec.Hard = corev1.ResourceList{
+		corev1.ResourcePods: resource.MustParse("10"),
+	}
+
+	cases := []struct {
+		name          string
+		resourceQuota *corev1.ResourceQuota
+		expected      component.Component
+		isErr         bool
+	}{
+		{
+			name:          "general",
+			resourceQuota: resourceQuota,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Scopes",
+					Content: component.NewText("BestEffort"),
+				},
+				{
+					Header:  "Resources",
+					Content: component.NewText("1"),
+				},
+			}...),
+		},
+		{
+			name:          "resource quota is nil",
+			resourceQuota: nil,
+			isErr:         true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rc := NewResourceQuotaConfiguration(tc.resourceQuota)
+
+			summary, err := rc.Create()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
+
+func Test_createResourceQuotaUsageView(t *testing.T) {
+	resourceQuota := testutil.CreateResourceQuota("quota")
+	resourceQuota.Status = corev1.ResourceQuotaStatus{
+		Hard: corev1.ResourceList{
+			corev1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+			corev1.ResourcePods:           resource.MustParse("10"),
+			corev1.ResourceSecrets:        resource.MustParse("5"),
+			corev1.ResourceServices:       resource.MustParse("0"),
+			corev1.ResourceConfigMaps:     resource.MustParse("0"),
+		},
+		Used: corev1.ResourceList{
+			corev1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+			corev1.ResourcePods:           resource.MustParse("8"),
+			corev1.ResourceConfigMaps:     resource.MustParse("1"),
+		},
+	}
+
+	got, err := createResourceQuotaUsageView(resourceQuota)
+	require.NoError(t, err)
+
+	withStatus := func(text string, percent int, status component.TextStatus) *component.ProgressBar {
+		c := component.NewProgressBar(text, percent)
+		c.SetStatus(status)
+		return c
+	}
+
+	cols := component.NewTableCols("Resource", "Used", "Hard", "Usage")
+	expected := component.NewTableWithRows("Usage", "This resource quota does not limit any resources!", cols,
+		[]component.TableRow{
+			{
+				"Resource": component.NewText("configmaps"),
+				"Used":     component.NewText("1"),
+				"Hard":     component.NewText("0"),
+				"Usage":    withStatus("100%", 100, component.TextStatusError),
+			},
+			{
+				"Resource": component.NewText("pods"),
+				"Used":     component.NewText("8"),
+				"Hard":     component.NewText("10"),
+				"Usage":    withStatus("80%", 80, component.TextStatusWarning),
+			},
+			{
+				"Resource": component.NewText("requests.memory"),
+				"Used":     component.NewText("1Gi"),
+				"Hard":     component.NewText("1Gi"),
+				"Usage":    withStatus("100%", 100, component.TextStatusError),
+			},
+			{
+				"Resource": component.NewText("secrets"),
+				"Used":     component.NewText("0"),
+				"Hard":     component.NewText("5"),
+				"Usage":    withStatus("0%", 0, component.TextStatusOK),
+			},
+			{
+				"Resource": component.NewText("services"),
+				"Used":     component.NewText("0"),
+				"Hard":     component.NewText("0"),
+				"Usage":    component.NewText("n/a"),
+			},
+		})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_quantityPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		used     string
+		hard     string
+		expected int64
+	}{
+		{name: "milli", used: "500m", hard: "1", expected: 50},
+		{name: "binary suffix", used: "512Mi", hard: "1Gi", expected: 50},
+		{name: "over", used: "3", hard: "2", expected: 150},
+		{name: "large", used: "8E", hard: "9E", expected: 88},
+		{name: "tiny hard", used: "9E", hard: "1m", expected: math.MaxInt64},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := quantityPercent(resource.MustParse(test.used), resource.MustParse(test.hard))
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
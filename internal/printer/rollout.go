@@ -0,0 +1,157 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	sigyaml "sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/util/diff"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+var rolloutHistoryColumns = component.NewTableCols("Revision", "Change Cause", "Images", "Age", "Changes")
+
+// createRolloutHistoryView creates a table listing the revisions of a
+// Deployment, DaemonSet, or StatefulSet. Each revision shows how its pod
+// template differs from the current revision, and can be rolled back to.
+func createRolloutHistoryView(ctx context.Context, object runtime.Object, options Options) (*component.Table, error) {
+	if object == nil {
+		return nil, errors.New("unable to generate rollout history for a nil object")
+	}
+
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{Object: m}
+
+	history, err := octant.LoadRolloutHistory(ctx, options.DashConfig.ObjectStore(), u)
+	if err != nil {
+		return nil, errors.Wrap(err, "load rollout history")
+	}
+
+	key, err := store.KeyFromObject(u)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentLines []string
+	if current, ok := history.CurrentRevision(); ok {
+		currentYAML, err := podTemplateYAML(current.Template)
+		if err != nil {
+			return nil, err
+		}
+		currentLines = diff.SplitLines(currentYAML)
+	}
+
+	table := component.NewTable("Rollout History", "There is no rollout history", rolloutHistoryColumns)
+
+	for _, revision := range history.Revisions {
+		changeCause := revision.ChangeCause
+		if changeCause == "" {
+			changeCause = "<none>"
+		}
+
+		row := component.TableRow{
+			"Revision":     component.NewText(fmt.Sprintf("%d", revision.Revision)),
+			"Change Cause": component.NewText(changeCause),
+			"Images":       component.NewText(strings.Join(revision.Images(), ", ")),
+			"Age":          component.NewTimestamp(revision.CreationTimestamp.Time),
+		}
+
+		if revision.Revision == history.Current {
+			row["Changes"] = component.NewText("Current revision")
+			table.Add(row)
+			continue
+		}
+
+		revisionYAML, err := podTemplateYAML(revision.Template)
+		if err != nil {
+			return nil, err
+		}
+		row["Changes"] = podTemplateChanges(currentLines, diff.SplitLines(revisionYAML))
+
+		row.AddAction(rolloutUndoAction(key, revision.Revision))
+
+		table.Add(row)
+	}
+
+	return table, nil
+}
+
+func defaultRolloutHistory(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
+	return createRolloutHistoryView(ctx, object, options)
+}
+
+// podTemplateChanges shows the lines which would change if the revision was
+// rolled back to.
+func podTemplateChanges(current, revision []string) component.Component {
+	var changed []string
+	for _, line := range diff.Lines(current, revision) {
+		if !strings.HasPrefix(line, "  ") {
+			changed = append(changed, line)
+		}
+	}
+
+	if len(changed) == 0 {
+		return component.NewText("No changes")
+	}
+
+	return component.NewCodeBlock(strings.Join(changed, "\n"))
+}
+
+func rolloutUndoAction(key store.Key, revision int64) component.GridAction {
+	payload := key.ToActionPayload()
+	payload["revision"] = revision
+
+	return component.GridAction{
+		Name:       "Rollback",
+		ActionPath: octant.ActionRolloutUndo,
+		Payload:    payload,
+		Confirmation: &component.Confirmation{
+			Title: "Roll Back",
+			Body:  fmt.Sprintf("Are you sure you want to roll back *%s* **%s** to revision %d?", key.Kind, key.Name, revision),
+		},
+		Type: component.GridActionDanger,
+	}
+}
+
+// addRolloutPauseButton adds a button to pause a rollout, or to resume it if
+// it is paused.
+func addRolloutPauseButton(o ObjectInterface, object runtime.Object, paused bool) error {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return err
+	}
+
+	name, actionName := "Pause", octant.ActionRolloutPause
+	if paused {
+		name, actionName = "Resume", octant.ActionRolloutResume
+	}
+	o.AddButton(name, action.CreatePayload(actionName, key.ToActionPayload()))
+
+	return nil
+}
+
+func podTemplateYAML(template corev1.PodTemplateSpec) (string, error) {
+	data, err := sigyaml.Marshal(template)
+	if err != nil {
+		return "", errors.Wrap(err, "convert pod template to yaml")
+	}
+
+	return string(data), nil
+}
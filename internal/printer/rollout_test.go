@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_createRolloutHistoryView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	now := testutil.Time()
+
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Annotations = map[string]string{octant.DeploymentRevisionAnnotation: "2"}
+
+	createReplicaSet := func(name, revision, image string) *appsv1.ReplicaSet {
+		replicaSet := testutil.CreateAppReplicaSet(name)
+		replicaSet.CreationTimestamp = metav1.Time{Time: now}
+		replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, deployment))
+		replicaSet.Annotations = map[string]string{octant.DeploymentRevisionAnnotation: revision}
+		replicaSet.Spec.Template.Spec.Containers = []corev1.Container{{Name: "nginx", Image: image}}
+		return replicaSet
+	}
+
+	replicaSetKey := store.Key{
+		Namespace:  "namespace",
+		APIVersion: "apps/v1",
+		Kind:       "ReplicaSet",
+	}
+
+	tpo.objectStore.EXPECT().List(gomock.Any(), replicaSetKey).
+		Return(testutil.ToUnstructuredList(t,
+			createReplicaSet("rs1", "1", "nginx:1.18"),
+			createReplicaSet("rs2", "2", "nginx:1.19"),
+		), false, nil)
+
+	got, err := createRolloutHistoryView(context.Background(), deployment, printOptions)
+	require.NoError(t, err)
+
+	key, err := store.KeyFromObject(deployment)
+	require.NoError(t, err)
+
+	expected := component.NewTableWithRows("Rollout History", "There is no rollout history", rolloutHistoryColumns, []component.TableRow{
+		{
+			"Revision":     component.NewText("2"),
+			"Change Cause": component.NewText("<none>"),
+			"Images":       component.NewText("nginx:1.19"),
+			"Age":          component.NewTimestamp(now),
+			"Changes":      component.NewText("Current revision"),
+		},
+		{
+			"Revision":     component.NewText("1"),
+			"Change Cause": component.NewText("<none>"),
+			"Images":       component.NewText("nginx:1.18"),
+			"Age":          component.NewTimestamp(now),
+			"Changes":      component.NewCodeBlock("-   - image: nginx:1.19\n+   - image: nginx:1.18"),
+			component.GridActionKey: gridActionsFactory([]component.GridAction{
+				rolloutUndoAction(key, 1),
+			}),
+		},
+	})
+
+	component.AssertEqual(t, expected, got)
+}
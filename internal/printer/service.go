@@ -406,7 +406,7 @@ func createServiceEndpointsView(ctx context.Context, service *corev1.Service, op
 		Name:       service.Name,
 	}
 
-	cols := component.NewTableCols("Target", "IP", "Node Name")
+	cols := component.NewTableCols("Target", "IP", "Node Name", "Status", "Ports")
 	table := component.NewTable("Endpoints", "There are no endpoints!", cols)
 
 	if service.Spec.ExternalName != "" {
@@ -426,36 +426,97 @@ func createServiceEndpointsView(ctx context.Context, service *corev1.Service, op
 		return nil, errors.Wrap(err, "convert unstructured object to endpoints")
 	}
 
+	routedPods := make(map[string]bool)
+
 	for _, subset := range endpoints.Subsets {
-		for _, address := range subset.Addresses {
-			row := component.TableRow{}
-
-			var target component.Component = component.NewText("No target")
-			if targetRef := address.TargetRef; targetRef != nil {
-				// Only references to v1/Pod are possible here
-				target, err = options.Link.ForGVK(service.Namespace, "v1", targetRef.Kind,
-					targetRef.Name, targetRef.Name)
-				if err != nil {
-					return nil, err
-				}
+		ports := component.NewText(formatEndpointPorts(subset.Ports))
+
+		addRow := func(address corev1.EndpointAddress, ready bool) {
+			if targetRef := address.TargetRef; targetRef != nil && targetRef.Kind == "Pod" {
+				routedPods[targetRef.Name] = true
 			}
 
-			row["Target"] = target
-			row["IP"] = component.NewText(address.IP)
+			table.Add(component.TableRow{
+				"Target":    endpointTargetRef(service.Namespace, address.TargetRef, options),
+				"IP":        component.NewText(address.IP),
+				"Node Name": component.NewText(endpointAddressNodeName(address)),
+				"Status":    endpointReadyText(ready),
+				"Ports":     ports,
+			})
+		}
 
-			nodeName := ""
-			if address.NodeName != nil {
-				nodeName = *address.NodeName
-			}
-			row["Node Name"] = component.NewText(nodeName)
+		for _, address := range subset.Addresses {
+			addRow(address, true)
+		}
 
-			table.Add(row)
+		for _, address := range subset.NotReadyAddresses {
+			addRow(address, false)
 		}
 	}
 
+	missingPods, err := serviceMissingPods(ctx, service, routedPods, options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pod := range missingPods {
+		status := component.NewText("Not in Endpoints")
+		status.SetStatus(component.TextStatusError)
+
+		table.Add(component.TableRow{
+			"Target":    endpointTargetRef(service.Namespace, &corev1.ObjectReference{Kind: "Pod", Name: pod.Name}, options),
+			"IP":        component.NewText(pod.Status.PodIP),
+			"Node Name": component.NewText(pod.Spec.NodeName),
+			"Status":    status,
+			"Ports":     component.NewText(""),
+		})
+	}
+
 	return table, nil
 }
 
+// serviceMissingPods finds running pods matched by a service's selector which
+// are not routed to by its endpoints. Pods which are being deleted, or which
+// don't have an IP yet, are ignored.
+func serviceMissingPods(ctx context.Context, service *corev1.Service, routedPods map[string]bool, options Options) ([]corev1.Pod, error) {
+	if len(service.Spec.Selector) == 0 {
+		return nil, nil
+	}
+
+	selector := labels.Set(service.Spec.Selector)
+	key := store.Key{
+		Namespace:  service.Namespace,
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Selector:   &selector,
+	}
+
+	podList, _, err := options.DashConfig.ObjectStore().List(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list pods for service %s", service.Name)
+	}
+
+	var missing []corev1.Pod
+	for i := range podList.Items {
+		pod := corev1.Pod{}
+		if err := kubernetes.FromUnstructured(&podList.Items[i], &pod); err != nil {
+			return nil, err
+		}
+
+		if pod.DeletionTimestamp != nil || routedPods[pod.Name] {
+			continue
+		}
+
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+			continue
+		}
+
+		missing = append(missing, pod)
+	}
+
+	return missing, nil
+}
+
 func describePortShort(port corev1.ServicePort) string {
 	return fmt.Sprintf("%d/%s", port.Port, port.Protocol)
 }
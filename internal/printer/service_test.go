@@ -424,7 +424,7 @@ func Test_createServiceSummaryStatus(t *testing.T) {
 }
 
 func Test_createServiceEndpointsView(t *testing.T) {
-	cols := component.NewTableCols("Target", "IP", "Node Name")
+	cols := component.NewTableCols("Target", "IP", "Node Name", "Status", "Ports")
 
 	nodeName := "node"
 	endpoints := &corev1.Endpoints{
@@ -442,15 +442,56 @@ func Test_createServiceEndpointsView(t *testing.T) {
 						IP:       "10.1.1.1",
 					},
 				},
+				NotReadyAddresses: []corev1.EndpointAddress{
+					{
+						TargetRef: &corev1.ObjectReference{
+							Kind:      "Pod",
+							Name:      "pod-2",
+							Namespace: "default",
+						},
+						NodeName: &nodeName,
+						IP:       "10.1.1.2",
+					},
+				},
+				Ports: []corev1.EndpointPort{
+					{Name: "http", Port: 8080, Protocol: corev1.ProtocolTCP},
+				},
 			},
 		},
 	}
 
+	selectedPods := []runtime.Object{
+		testutil.CreatePod("pod-1"),
+		testutil.CreatePod("pod-3", func(pod *corev1.Pod) {
+			pod.Spec.NodeName = "node-2"
+			pod.Status.Phase = corev1.PodRunning
+			pod.Status.PodIP = "10.1.1.3"
+		}),
+		testutil.CreatePod("pod-pending", func(pod *corev1.Pod) {
+			pod.Status.Phase = corev1.PodPending
+		}),
+		testutil.CreatePod("pod-succeeded", func(pod *corev1.Pod) {
+			pod.Status.Phase = corev1.PodSucceeded
+			pod.Status.PodIP = "10.1.1.4"
+		}),
+		testutil.CreatePod("pod-failed", func(pod *corev1.Pod) {
+			pod.Status.Phase = corev1.PodFailed
+			pod.Status.PodIP = "10.1.1.5"
+		}),
+	}
+
+	notReady := component.NewText("Not Ready")
+	notReady.SetStatus(component.TextStatusWarning)
+
+	missing := component.NewText("Not in Endpoints")
+	missing.SetStatus(component.TextStatusError)
+
 	cases := []struct {
 		name    string
 		service *corev1.Service
+		pods    []runtime.Object
 		table   *component.Table
-		rows    component.TableRow
+		rows    []component.TableRow
 	}{
 		{
 			name: "endpoint",
@@ -461,10 +502,58 @@ func Test_createServiceEndpointsView(t *testing.T) {
 				},
 			},
 			table: component.NewTable("Endpoints", "There are no endpoints!", cols),
-			rows: component.TableRow{
-				"Target":    component.NewLink("", "pod", "/pod"),
-				"IP":        component.NewText("10.1.1.1"),
-				"Node Name": component.NewText("node"),
+			rows: []component.TableRow{
+				{
+					"Target":    component.NewLink("", "pod", "/pod"),
+					"IP":        component.NewText("10.1.1.1"),
+					"Node Name": component.NewText("node"),
+					"Status":    component.NewText("Ready"),
+					"Ports":     component.NewText("http 8080/TCP"),
+				},
+				{
+					"Target":    component.NewLink("", "pod", "/pod"),
+					"IP":        component.NewText("10.1.1.2"),
+					"Node Name": component.NewText("node"),
+					"Status":    notReady,
+					"Ports":     component.NewText("http 8080/TCP"),
+				},
+			},
+		},
+		{
+			name: "selected pod missing from endpoints",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "service",
+				},
+				Spec: corev1.ServiceSpec{
+					Selector: map[string]string{"app": "app"},
+				},
+			},
+			pods:  selectedPods,
+			table: component.NewTable("Endpoints", "There are no endpoints!", cols),
+			rows: []component.TableRow{
+				{
+					"Target":    component.NewLink("", "pod", "/pod"),
+					"IP":        component.NewText("10.1.1.1"),
+					"Node Name": component.NewText("node"),
+					"Status":    component.NewText("Ready"),
+					"Ports":     component.NewText("http 8080/TCP"),
+				},
+				{
+					"Target":    component.NewLink("", "pod", "/pod"),
+					"IP":        component.NewText("10.1.1.2"),
+					"Node Name": component.NewText("node"),
+					"Status":    notReady,
+					"Ports":     component.NewText("http 8080/TCP"),
+				},
+				{
+					"Target":    component.NewLink("", "pod", "/pod"),
+					"IP":        component.NewText("10.1.1.3"),
+					"Node Name": component.NewText("node-2"),
+					"Status":    missing,
+					"Ports":     component.NewText(""),
+				},
 			},
 		},
 		{
@@ -483,34 +572,42 @@ func Test_createServiceEndpointsView(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		controller := gomock.NewController(t)
-		defer controller.Finish()
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
 
-		tpo := newTestPrinterOptions(controller)
-		printOptions := tpo.ToOptions()
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
 
-		if tc.service.Spec.ExternalName == "" {
-			key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Endpoints", Name: "service"}
-			tpo.objectStore.EXPECT().
-				Get(gomock.Any(), gomock.Eq(key)).
-				Return(toUnstructured(t, endpoints), nil)
+			if tc.service.Spec.ExternalName == "" {
+				key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Endpoints", Name: "service"}
+				tpo.objectStore.EXPECT().
+					Get(gomock.Any(), gomock.Eq(key)).
+					Return(toUnstructured(t, endpoints), nil)
+
+				podLink := component.NewLink("", "pod", "/pod")
+				tpo.link.EXPECT().
+					ForGVK(gomock.Any(), "v1", "Pod", gomock.Any(), gomock.Any()).
+					Return(podLink, nil).
+					AnyTimes()
+			}
 
-			podLink := component.NewLink("", "pod", "/pod")
-			tpo.link.EXPECT().
-				ForGVK(gomock.Any(), "v1", "Pod", gomock.Any(), gomock.Any()).
-				Return(podLink, nil).
-				AnyTimes()
-		}
+			if tc.pods != nil {
+				selector := labels.Set(tc.service.Spec.Selector)
+				key := store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod", Selector: &selector}
+				tpo.objectStore.EXPECT().
+					List(gomock.Any(), gomock.Eq(key)).
+					Return(testutil.ToUnstructuredList(t, tc.pods...), false, nil)
+			}
 
-		ctx := context.Background()
-		got, err := createServiceEndpointsView(ctx, tc.service, printOptions)
-		require.NoError(t, err)
+			ctx := context.Background()
+			got, err := createServiceEndpointsView(ctx, tc.service, printOptions)
+			require.NoError(t, err)
 
-		if tc.rows != nil {
-			tc.table.Add(tc.rows)
-		}
+			tc.table.Add(tc.rows...)
 
-		component.AssertEqual(t, tc.table, got)
+			component.AssertEqual(t, tc.table, got)
+		})
 	}
 }
 
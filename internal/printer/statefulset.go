@@ -49,6 +49,10 @@ func StatefulSetListHandler(ctx context.Context, list *appsv1.StatefulSetList, o
 
 		row["Selector"] = printSelector(statefulSet.Spec.Selector)
 
+		if err := addWorkloadGridActions(row, &statefulSet, workloadActions{scalable: true, restartable: true}); err != nil {
+			return nil, fmt.Errorf("add workload actions: %w", err)
+		}
+
 		if err := ot.AddRowForObject(ctx, &statefulSet, row); err != nil {
 			return nil, fmt.Errorf("add row for object: %w", err)
 		}
@@ -62,6 +66,10 @@ func StatefulSetHandler(ctx context.Context, statefulSet *appsv1.StatefulSet, op
 	o := NewObject(statefulSet)
 	o.EnableEvents()
 
+	if err := addWorkloadButtons(o, statefulSet, workloadActions{scalable: true, restartable: true}); err != nil {
+		return nil, err
+	}
+
 	sh, err := newStatufulSetHandler(statefulSet, o)
 	if err != nil {
 		return nil, err
@@ -79,6 +87,10 @@ func StatefulSetHandler(ctx context.Context, statefulSet *appsv1.StatefulSet, op
 		return nil, errors.Wrap(err, "print statefulset pods")
 	}
 
+	if err := sh.RolloutHistory(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print statefulset rollout history")
+	}
+
 	return o.ToComponent(ctx, options)
 }
 
@@ -202,6 +214,7 @@ type statefulSetObject interface {
 	Config(options Options) error
 	Status(ctx context.Context, options Options) error
 	Pods(ctx context.Context, object runtime.Object, options Options) error
+	RolloutHistory(ctx context.Context, options Options) error
 }
 
 type statefulSetHandler struct {
@@ -209,6 +222,7 @@ type statefulSetHandler struct {
 	configFunc  func(*appsv1.StatefulSet, Options) (*component.Summary, error)
 	statusFunc  func(context.Context, *appsv1.StatefulSet, Options) (*component.Quadrant, error)
 	podFunc     func(context.Context, runtime.Object, Options) (component.Component, error)
+	historyFunc func(context.Context, runtime.Object, Options) (component.Component, error)
 	object      *Object
 }
 
@@ -228,6 +242,7 @@ func newStatufulSetHandler(statefulSet *appsv1.StatefulSet, object *Object) (*st
 		configFunc:  defaultStatefulSetConfig,
 		statusFunc:  defaultStatefulSetStatus,
 		podFunc:     defaultStatefulSetPods,
+		historyFunc: defaultRolloutHistory,
 		object:      object,
 	}
 
@@ -280,3 +295,13 @@ func (s *statefulSetHandler) Pods(ctx context.Context, object runtime.Object, op
 func defaultStatefulSetPods(ctx context.Context, object runtime.Object, options Options) (component.Component, error) {
 	return createPodListView(ctx, object, options)
 }
+
+func (s *statefulSetHandler) RolloutHistory(ctx context.Context, options Options) error {
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.historyFunc(ctx, s.statefulSet, options)
+		},
+	})
+	return nil
+}
@@ -93,9 +93,10 @@ func Test_StatefulSetListHandler(t *testing.T) {
 		"Current":  component.NewText("1"),
 		"Age":      component.NewTimestamp(now),
 		"Selector": component.NewSelectors([]component.Selector{component.NewLabelSelector("app", "myapp")}),
-		component.GridActionKey: gridActionsFactory([]component.GridAction{
+		component.GridActionKey: gridActionsFactory(append(
+			buildWorkloadGridActions(t, statefulSet, workloadActions{scalable: true, restartable: true}),
 			buildObjectDeleteAction(t, statefulSet),
-		}),
+		)),
 	})
 
 	component.AssertEqual(t, expected, got)
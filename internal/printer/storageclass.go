@@ -0,0 +1,327 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/util/kubernetes"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// StorageClassListHandler is a printFunc that prints storage classes
+func StorageClassListHandler(ctx context.Context, list *storagev1.StorageClassList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("storage class list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Provisioner", "Reclaim Policy", "Volume Binding Mode", "Allow Volume Expansion", "Age")
+	ot := NewObjectTable("Storage Classes", "We couldn't find any storage classes!", cols, options.DashConfig.ObjectStore())
+
+	for _, storageClass := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&storageClass, storageClassDisplayName(&storageClass))
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Provisioner"] = component.NewText(storageClass.Provisioner)
+		row["Reclaim Policy"] = component.NewText(storageClassReclaimPolicy(&storageClass))
+		row["Volume Binding Mode"] = component.NewText(storageClassVolumeBindingMode(&storageClass))
+		row["Allow Volume Expansion"] = component.NewText(fmt.Sprintf("%t", storageClassAllowVolumeExpansion(&storageClass)))
+		row["Age"] = component.NewTimestamp(storageClass.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &storageClass, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// StorageClassHandler is a printFunc that prints a StorageClass
+func StorageClassHandler(ctx context.Context, storageClass *storagev1.StorageClass, options Options) (component.Component, error) {
+	o := NewObject(storageClass)
+	o.EnableEvents()
+
+	sh, err := newStorageClassHandler(storageClass, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sh.Config(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print storageclass configuration")
+	}
+
+	if err := sh.Parameters(); err != nil {
+		return nil, errors.Wrap(err, "print storageclass parameters")
+	}
+
+	if err := sh.PersistentVolumes(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print storageclass persistent volumes")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// StorageClassConfiguration generates a storage class configuration
+type StorageClassConfiguration struct {
+	storageClass *storagev1.StorageClass
+}
+
+// NewStorageClassConfiguration creates an instance of StorageClassConfiguration
+func NewStorageClassConfiguration(storageClass *storagev1.StorageClass) *StorageClassConfiguration {
+	return &StorageClassConfiguration{
+		storageClass: storageClass,
+	}
+}
+
+// Create creates a storage class configuration summary
+func (s *StorageClassConfiguration) Create(ctx context.Context, options Options) (*component.Summary, error) {
+	if s.storageClass == nil {
+		return nil, errors.New("storage class is nil")
+	}
+
+	storageClass := s.storageClass
+
+	sections := component.SummarySections{}
+
+	provisioner, err := csiDriverLink(ctx, storageClass.Provisioner, options)
+	if err != nil {
+		return nil, err
+	}
+	sections.Add("Provisioner", provisioner)
+
+	sections.AddText("Reclaim Policy", storageClassReclaimPolicy(storageClass))
+	sections.AddText("Volume Binding Mode", storageClassVolumeBindingMode(storageClass))
+	sections.AddText("Allow Volume Expansion", fmt.Sprintf("%t", storageClassAllowVolumeExpansion(storageClass)))
+
+	if isDefaultStorageClass(storageClass) {
+		sections.AddText("Default", "true")
+	}
+
+	if len(storageClass.MountOptions) > 0 {
+		sections.AddText("Mount Options", strings.Join(storageClass.MountOptions, ", "))
+	}
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createStorageClassParametersView(storageClass *storagev1.StorageClass) (*component.Table, error) {
+	if storageClass == nil {
+		return nil, errors.New("unable to generate parameters for a nil storage class")
+	}
+
+	cols := component.NewTableCols("Key", "Value")
+	table := component.NewTable("Parameters", "This storage class does not have any parameters!", cols)
+
+	var keys []string
+	for key := range storageClass.Parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		table.Add(component.TableRow{
+			"Key":   component.NewText(key),
+			"Value": component.NewText(storageClass.Parameters[key]),
+		})
+	}
+
+	return table, nil
+}
+
+func createStorageClassPersistentVolumesView(ctx context.Context, storageClass *storagev1.StorageClass, options Options) (component.Component, error) {
+	if storageClass == nil {
+		return nil, errors.New("unable to list persistent volumes for a nil storage class")
+	}
+
+	objectStore := options.DashConfig.ObjectStore()
+
+	ul, _, err := objectStore.List(ctx, store.KeyFromGroupVersionKind(gvk.PersistentVolume))
+	if err != nil {
+		return nil, errors.Wrap(err, "list persistent volumes")
+	}
+
+	list := &corev1.PersistentVolumeList{}
+	for i := range ul.Items {
+		pv := corev1.PersistentVolume{}
+		if err := kubernetes.FromUnstructured(&ul.Items[i], &pv); err != nil {
+			return nil, err
+		}
+
+		if pv.Spec.StorageClassName == storageClass.Name {
+			list.Items = append(list.Items, pv)
+		}
+	}
+
+	return PersistentVolumeListHandler(ctx, list, options)
+}
+
+// storageClassLink creates a link to a storage class. If the link
+// can't be generated, the storage class name is returned as text.
+func storageClassLink(name string, options Options) component.Component {
+	if name == "" {
+		return component.NewText("")
+	}
+
+	apiVersion, kind := gvk.StorageClass.ToAPIVersionAndKind()
+	link, err := options.Link.ForGVK("", apiVersion, kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return link
+}
+
+// csiDriverLink creates a link to a CSI driver if a driver named after the
+// provisioner is registered in the cluster. In-tree provisioners are
+// returned as text.
+func csiDriverLink(ctx context.Context, provisioner string, options Options) (component.Component, error) {
+	key := store.KeyFromGroupVersionKind(gvk.CSIDriver)
+	key.Name = provisioner
+
+	u, err := options.DashConfig.ObjectStore().Get(ctx, key)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "get csi driver %s", provisioner)
+	}
+
+	if u == nil || u.GetName() == "" {
+		return component.NewText(provisioner), nil
+	}
+
+	return options.Link.ForObject(u, provisioner)
+}
+
+func storageClassDisplayName(storageClass *storagev1.StorageClass) string {
+	if isDefaultStorageClass(storageClass) {
+		return fmt.Sprintf("%s (default)", storageClass.Name)
+	}
+
+	return storageClass.Name
+}
+
+func isDefaultStorageClass(storageClass *storagev1.StorageClass) bool {
+	for _, key := range []string{"storageclass.kubernetes.io/is-default-class", "storageclass.beta.kubernetes.io/is-default-class"} {
+		if storageClass.Annotations[key] == "true" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func storageClassReclaimPolicy(storageClass *storagev1.StorageClass) string {
+	if storageClass.ReclaimPolicy == nil {
+		return string(corev1.PersistentVolumeReclaimDelete)
+	}
+
+	return string(*storageClass.ReclaimPolicy)
+}
+
+func storageClassVolumeBindingMode(storageClass *storagev1.StorageClass) string {
+	if storageClass.VolumeBindingMode == nil {
+		return string(storagev1.VolumeBindingImmediate)
+	}
+
+	return string(*storageClass.VolumeBindingMode)
+}
+
+func storageClassAllowVolumeExpansion(storageClass *storagev1.StorageClass) bool {
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion
+}
+
+type storageClassObject interface {
+	Config(ctx context.Context, options Options) error
+	Parameters() error
+	PersistentVolumes(ctx context.Context, options Options) error
+}
+
+type storageClassHandler struct {
+	storageClass          *storagev1.StorageClass
+	configFunc            func(context.Context, *storagev1.StorageClass, Options) (*component.Summary, error)
+	parametersFunc        func(*storagev1.StorageClass) (*component.Table, error)
+	persistentVolumesFunc func(context.Context, *storagev1.StorageClass, Options) (component.Component, error)
+	object                *Object
+}
+
+var _ storageClassObject = (*storageClassHandler)(nil)
+
+func newStorageClassHandler(storageClass *storagev1.StorageClass, object *Object) (*storageClassHandler, error) {
+	if storageClass == nil {
+		return nil, errors.New("can't print a nil storage class")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print storage class using a nil object printer")
+	}
+
+	sh := &storageClassHandler{
+		storageClass:          storageClass,
+		configFunc:            defaultStorageClassConfig,
+		parametersFunc:        defaultStorageClassParameters,
+		persistentVolumesFunc: defaultStorageClassPersistentVolumes,
+		object:                object,
+	}
+
+	return sh, nil
+}
+
+func (s *storageClassHandler) Config(ctx context.Context, options Options) error {
+	out, err := s.configFunc(ctx, s.storageClass, options)
+	if err != nil {
+		return err
+	}
+
+	s.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultStorageClassConfig(ctx context.Context, storageClass *storagev1.StorageClass, options Options) (*component.Summary, error) {
+	return NewStorageClassConfiguration(storageClass).Create(ctx, options)
+}
+
+func (s *storageClassHandler) Parameters() error {
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthHalf,
+		Func: func() (component.Component, error) {
+			return s.parametersFunc(s.storageClass)
+		},
+	})
+
+	return nil
+}
+
+func defaultStorageClassParameters(storageClass *storagev1.StorageClass) (*component.Table, error) {
+	return createStorageClassParametersView(storageClass)
+}
+
+func (s *storageClassHandler) PersistentVolumes(ctx context.Context, options Options) error {
+	s.object.RegisterItems(ItemDescriptor{
+		Width: component.WidthFull,
+		Func: func() (component.Component, error) {
+			return s.persistentVolumesFunc(ctx, s.storageClass, options)
+		},
+	})
+
+	return nil
+}
+
+func defaultStorageClassPersistentVolumes(ctx context.Context, storageClass *storagev1.StorageClass, options Options) (component.Component, error) {
+	return createStorageClassPersistentVolumesView(ctx, storageClass, options)
+}
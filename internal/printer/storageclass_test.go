@@ -0,0 +1,238 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_StorageClassListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	storageClass := testutil.CreateStorageClass("standard")
+	storageClass.CreationTimestamp = metav1.Time{Time: testutil.Time()}
+	storageClass.Annotations = map[string]string{
+		"storageclass.kubernetes.io/is-default-class": "true",
+	}
+
+	tpo.PathForObject(storageClass, "standard (default)", "/standard")
+
+	list := &storagev1.StorageClassList{
+		Items: []storagev1.StorageClass{*storageClass},
+	}
+
+	ctx := context.Background()
+	got, err := StorageClassListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Provisioner", "Reclaim Policy", "Volume Binding Mode", "Allow Volume Expansion", "Age")
+	expected := component.NewTable("Storage Classes", "We couldn't find any storage classes!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", "standard (default)", "/standard",
+			genObjectStatus(component.TextStatusOK, []string{"storage.k8s.io/v1 StorageClass is OK"})),
+		"Provisioner":            component.NewText("kubernetes.io/no-provisioner"),
+		"Reclaim Policy":         component.NewText("Delete"),
+		"Volume Binding Mode":    component.NewText("Immediate"),
+		"Allow Volume Expansion": component.NewText("false"),
+		"Age":                    component.NewTimestamp(testutil.Time()),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, storageClass),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_StorageClassConfiguration(t *testing.T) {
+	reclaimPolicy := corev1.PersistentVolumeReclaimRetain
+	bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+	allowExpansion := true
+
+	storageClass := testutil.CreateStorageClass("csi")
+	storageClass.Provisioner = "csi.example.com"
+	storageClass.ReclaimPolicy = &reclaimPolicy
+	storageClass.VolumeBindingMode = &bindingMode
+	storageClass.AllowVolumeExpansion = &allowExpansion
+	storageClass.MountOptions = []string{"debug", "nfsvers=4.1"}
+
+	csiDriver := testutil.CreateCSIDriver("csi.example.com")
+
+	cases := []struct {
+		name         string
+		storageClass *storagev1.StorageClass
+		csiDriver    *storagev1.CSIDriver
+		expected     component.Component
+		isErr        bool
+	}{
+		{
+			name:         "csi provisioner",
+			storageClass: storageClass,
+			csiDriver:    csiDriver,
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Provisioner",
+					Content: component.NewLink("", "csi.example.com", "/csi-driver"),
+				},
+				{
+					Header:  "Reclaim Policy",
+					Content: component.NewText("Retain"),
+				},
+				{
+					Header:  "Volume Binding Mode",
+					Content: component.NewText("WaitForFirstConsumer"),
+				},
+				{
+					Header:  "Allow Volume Expansion",
+					Content: component.NewText("true"),
+				},
+				{
+					Header:  "Mount Options",
+					Content: component.NewText("debug, nfsvers=4.1"),
+				},
+			}...),
+		},
+		{
+			name:         "in-tree provisioner",
+			storageClass: testutil.CreateStorageClass("manual"),
+			expected: component.NewSummary("Configuration", []component.SummarySection{
+				{
+					Header:  "Provisioner",
+					Content: component.NewText("kubernetes.io/no-provisioner"),
+				},
+				{
+					Header:  "Reclaim Policy",
+					Content: component.NewText("Delete"),
+				},
+				{
+					Header:  "Volume Binding Mode",
+					Content: component.NewText("Immediate"),
+				},
+				{
+					Header:  "Allow Volume Expansion",
+					Content: component.NewText("false"),
+				},
+			}...),
+		},
+		{
+			name:         "storage class is nil",
+			storageClass: nil,
+			isErr:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			tpo := newTestPrinterOptions(controller)
+			printOptions := tpo.ToOptions()
+
+			ctx := context.Background()
+
+			if tc.storageClass != nil {
+				key := store.Key{
+					APIVersion: "storage.k8s.io/v1",
+					Kind:       "CSIDriver",
+					Name:       tc.storageClass.Provisioner,
+				}
+
+				u := &unstructured.Unstructured{}
+				if tc.csiDriver != nil {
+					u = testutil.ToUnstructured(t, tc.csiDriver)
+					tpo.link.EXPECT().ForObject(u, tc.csiDriver.Name).
+						Return(component.NewLink("", tc.csiDriver.Name, "/csi-driver"), nil)
+				}
+
+				tpo.objectStore.EXPECT().Get(ctx, key).Return(u, nil)
+			}
+
+			sc := NewStorageClassConfiguration(tc.storageClass)
+
+			summary, err := sc.Create(ctx, printOptions)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			component.AssertEqual(t, tc.expected, summary)
+		})
+	}
+}
+
+func Test_createStorageClassParametersView(t *testing.T) {
+	storageClass := testutil.CreateStorageClass("standard")
+	storageClass.Parameters = map[string]string{
+		"type":   "pd-ssd",
+		"fsType": "ext4",
+	}
+
+	got, err := createStorageClassParametersView(storageClass)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Key", "Value")
+	expected := component.NewTable("Parameters", "This storage class does not have any parameters!", cols)
+	expected.Add(
+		component.TableRow{
+			"Key":   component.NewText("fsType"),
+			"Value": component.NewText("ext4"),
+		},
+		component.TableRow{
+			"Key":   component.NewText("type"),
+			"Value": component.NewText("pd-ssd"),
+		},
+	)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createStorageClassPersistentVolumesView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	storageClass := testutil.CreateStorageClass("standard")
+
+	matching := testutil.CreatePersistentVolume("matching")
+	matching.Spec.StorageClassName = "standard"
+	other := testutil.CreatePersistentVolume("other")
+	other.Spec.StorageClassName = "other"
+
+	ctx := context.Background()
+
+	key := store.Key{APIVersion: "v1", Kind: "PersistentVolume"}
+	tpo.objectStore.EXPECT().List(ctx, key).
+		Return(testutil.ToUnstructuredList(t, matching, other), false, nil)
+
+	tpo.PathForObject(matching, matching.Name, "/matching")
+	tpo.PathForGVK("", "storage.k8s.io/v1", "StorageClass", "standard", "standard", "/standard")
+
+	got, err := createStorageClassPersistentVolumesView(ctx, storageClass, printOptions)
+	require.NoError(t, err)
+
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+	require.Len(t, table.Rows(), 1)
+}
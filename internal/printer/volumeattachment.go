@@ -0,0 +1,236 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// VolumeAttachmentListHandler is a printFunc that prints volume attachments
+func VolumeAttachmentListHandler(ctx context.Context, list *storagev1.VolumeAttachmentList, options Options) (component.Component, error) {
+	if list == nil {
+		return nil, errors.New("volume attachment list is nil")
+	}
+
+	cols := component.NewTableCols("Name", "Attacher", "Persistent Volume", "Node", "Attached", "Age")
+	ot := NewObjectTable("Volume Attachments", "We couldn't find any volume attachments!", cols, options.DashConfig.ObjectStore())
+
+	for _, volumeAttachment := range list.Items {
+		row := component.TableRow{}
+		nameLink, err := options.Link.ForObject(&volumeAttachment, volumeAttachment.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		row["Name"] = nameLink
+		row["Attacher"] = component.NewText(volumeAttachment.Spec.Attacher)
+		row["Persistent Volume"] = volumeAttachmentPersistentVolume(&volumeAttachment, options)
+		row["Node"] = nodeLink(volumeAttachment.Spec.NodeName, options)
+		row["Attached"] = component.NewText(fmt.Sprintf("%t", volumeAttachment.Status.Attached))
+		row["Age"] = component.NewTimestamp(volumeAttachment.CreationTimestamp.Time)
+
+		if err := ot.AddRowForObject(ctx, &volumeAttachment, row); err != nil {
+			return nil, fmt.Errorf("add row for object: %w", err)
+		}
+	}
+
+	return ot.ToComponent()
+}
+
+// VolumeAttachmentHandler is a printFunc that prints a VolumeAttachment
+func VolumeAttachmentHandler(ctx context.Context, volumeAttachment *storagev1.VolumeAttachment, options Options) (component.Component, error) {
+	o := NewObject(volumeAttachment)
+	o.EnableEvents()
+
+	vh, err := newVolumeAttachmentHandler(volumeAttachment, o)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := vh.Config(ctx, options); err != nil {
+		return nil, errors.Wrap(err, "print volumeattachment configuration")
+	}
+
+	if err := vh.Status(); err != nil {
+		return nil, errors.Wrap(err, "print volumeattachment status")
+	}
+
+	return o.ToComponent(ctx, options)
+}
+
+// VolumeAttachmentConfiguration generates a volume attachment configuration
+type VolumeAttachmentConfiguration struct {
+	volumeAttachment *storagev1.VolumeAttachment
+}
+
+// NewVolumeAttachmentConfiguration creates an instance of VolumeAttachmentConfiguration
+func NewVolumeAttachmentConfiguration(volumeAttachment *storagev1.VolumeAttachment) *VolumeAttachmentConfiguration {
+	return &VolumeAttachmentConfiguration{
+		volumeAttachment: volumeAttachment,
+	}
+}
+
+// Create creates a volume attachment configuration summary
+func (v *VolumeAttachmentConfiguration) Create(ctx context.Context, options Options) (*component.Summary, error) {
+	if v.volumeAttachment == nil {
+		return nil, errors.New("volume attachment is nil")
+	}
+
+	volumeAttachment := v.volumeAttachment
+
+	sections := component.SummarySections{}
+
+	attacher, err := csiDriverLink(ctx, volumeAttachment.Spec.Attacher, options)
+	if err != nil {
+		return nil, err
+	}
+	sections.Add("Attacher", attacher)
+	sections.Add("Node", nodeLink(volumeAttachment.Spec.NodeName, options))
+	sections.Add("Persistent Volume", volumeAttachmentPersistentVolume(volumeAttachment, options))
+
+	summary := component.NewSummary("Configuration", sections...)
+	return summary, nil
+}
+
+func createVolumeAttachmentSummaryStatus(volumeAttachment *storagev1.VolumeAttachment) (*component.Summary, error) {
+	if volumeAttachment == nil {
+		return nil, errors.New("unable to generate status for a nil volume attachment")
+	}
+
+	status := volumeAttachment.Status
+
+	sections := component.SummarySections{}
+
+	attached := component.NewText(fmt.Sprintf("%t", status.Attached))
+	if !status.Attached {
+		attached.SetStatus(component.TextStatusWarning)
+	}
+	sections.Add("Attached", attached)
+
+	if status.AttachError != nil {
+		text := component.NewText(status.AttachError.Message)
+		text.SetStatus(component.TextStatusError)
+		sections.Add("Attach Error", text)
+	}
+
+	if status.DetachError != nil {
+		text := component.NewText(status.DetachError.Message)
+		text.SetStatus(component.TextStatusError)
+		sections.Add("Detach Error", text)
+	}
+
+	if len(status.AttachmentMetadata) > 0 {
+		var keys []string
+		for key := range status.AttachmentMetadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		cols := component.NewTableCols("Key", "Value")
+		table := component.NewTable("", "", cols)
+		for _, key := range keys {
+			table.Add(component.TableRow{
+				"Key":   component.NewText(key),
+				"Value": component.NewText(status.AttachmentMetadata[key]),
+			})
+		}
+
+		sections.Add("Attachment Metadata", table)
+	}
+
+	summary := component.NewSummary("Status", sections...)
+	return summary, nil
+}
+
+func volumeAttachmentPersistentVolume(volumeAttachment *storagev1.VolumeAttachment, options Options) component.Component {
+	name := volumeAttachment.Spec.Source.PersistentVolumeName
+	if name == nil {
+		return component.NewText("<inline>")
+	}
+
+	return persistentVolumeLink(*name, options)
+}
+
+// persistentVolumeLink creates a link to a persistent volume. If the link
+// can't be generated, the persistent volume name is returned as text.
+func persistentVolumeLink(name string, options Options) component.Component {
+	apiVersion, kind := gvk.PersistentVolume.ToAPIVersionAndKind()
+	link, err := options.Link.ForGVK("", apiVersion, kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return link
+}
+
+type volumeAttachmentObject interface {
+	Config(ctx context.Context, options Options) error
+	Status() error
+}
+
+type volumeAttachmentHandler struct {
+	volumeAttachment *storagev1.VolumeAttachment
+	configFunc       func(context.Context, *storagev1.VolumeAttachment, Options) (*component.Summary, error)
+	statusFunc       func(*storagev1.VolumeAttachment) (*component.Summary, error)
+	object           *Object
+}
+
+var _ volumeAttachmentObject = (*volumeAttachmentHandler)(nil)
+
+func newVolumeAttachmentHandler(volumeAttachment *storagev1.VolumeAttachment, object *Object) (*volumeAttachmentHandler, error) {
+	if volumeAttachment == nil {
+		return nil, errors.New("can't print a nil volume attachment")
+	}
+
+	if object == nil {
+		return nil, errors.New("can't print volume attachment using a nil object printer")
+	}
+
+	vh := &volumeAttachmentHandler{
+		volumeAttachment: volumeAttachment,
+		configFunc:       defaultVolumeAttachmentConfig,
+		statusFunc:       defaultVolumeAttachmentStatus,
+		object:           object,
+	}
+
+	return vh, nil
+}
+
+func (v *volumeAttachmentHandler) Config(ctx context.Context, options Options) error {
+	out, err := v.configFunc(ctx, v.volumeAttachment, options)
+	if err != nil {
+		return err
+	}
+
+	v.object.RegisterConfig(out)
+	return nil
+}
+
+func defaultVolumeAttachmentConfig(ctx context.Context, volumeAttachment *storagev1.VolumeAttachment, options Options) (*component.Summary, error) {
+	return NewVolumeAttachmentConfiguration(volumeAttachment).Create(ctx, options)
+}
+
+func (v *volumeAttachmentHandler) Status() error {
+	out, err := v.statusFunc(v.volumeAttachment)
+	if err != nil {
+		return err
+	}
+
+	v.object.RegisterSummary(out)
+	return nil
+}
+
+func defaultVolumeAttachmentStatus(volumeAttachment *storagev1.VolumeAttachment) (*component.Summary, error) {
+	return createVolumeAttachmentSummaryStatus(volumeAttachment)
+}
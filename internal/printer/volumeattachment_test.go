@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_VolumeAttachmentListHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	volumeAttachment := testutil.CreateVolumeAttachment("attachment", "pv")
+	volumeAttachment.CreationTimestamp = metav1.Time{Time: testutil.Time()}
+	volumeAttachment.Status.Attached = true
+
+	tpo.PathForObject(volumeAttachment, volumeAttachment.Name, "/attachment")
+	tpo.PathForGVK("", "v1", "PersistentVolume", "pv", "pv", "/pv")
+	tpo.PathForGVK("", "v1", "Node", "node", "node", "/node")
+
+	list := &storagev1.VolumeAttachmentList{
+		Items: []storagev1.VolumeAttachment{*volumeAttachment},
+	}
+
+	ctx := context.Background()
+	got, err := VolumeAttachmentListHandler(ctx, list, printOptions)
+	require.NoError(t, err)
+
+	cols := component.NewTableCols("Name", "Attacher", "Persistent Volume", "Node", "Attached", "Age")
+	expected := component.NewTable("Volume Attachments", "We couldn't find any volume attachments!", cols)
+	expected.Add(component.TableRow{
+		"Name": component.NewLink("", volumeAttachment.Name, "/attachment",
+			genObjectStatus(component.TextStatusOK, []string{"storage.k8s.io/v1 VolumeAttachment is OK"})),
+		"Attacher":          component.NewText("csi.example.com"),
+		"Persistent Volume": component.NewLink("", "pv", "/pv"),
+		"Node":              component.NewLink("", "node", "/node"),
+		"Attached":          component.NewText("true"),
+		"Age":               component.NewTimestamp(testutil.Time()),
+		component.GridActionKey: gridActionsFactory([]component.GridAction{
+			buildObjectDeleteAction(t, volumeAttachment),
+		}),
+	})
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createVolumeAttachmentSummaryStatus(t *testing.T) {
+	volumeAttachment := testutil.CreateVolumeAttachment("attachment", "pv")
+	volumeAttachment.Status.AttachError = &storagev1.VolumeError{
+		Message: "rpc error: timed out",
+	}
+	volumeAttachment.Status.AttachmentMetadata = map[string]string{
+		"devicePath": "/dev/xvdb",
+	}
+
+	got, err := createVolumeAttachmentSummaryStatus(volumeAttachment)
+	require.NoError(t, err)
+
+	attached := component.NewText("false")
+	attached.SetStatus(component.TextStatusWarning)
+
+	attachError := component.NewText("rpc error: timed out")
+	attachError.SetStatus(component.TextStatusError)
+
+	metadata := component.NewTable("", "", component.NewTableCols("Key", "Value"))
+	metadata.Add(component.TableRow{
+		"Key":   component.NewText("devicePath"),
+		"Value": component.NewText("/dev/xvdb"),
+	})
+
+	expected := component.NewSummary("Status", []component.SummarySection{
+		{Header: "Attached", Content: attached},
+		{Header: "Attach Error", Content: attachError},
+		{Header: "Attachment Metadata", Content: metadata},
+	}...)
+
+	component.AssertEqual(t, expected, got)
+}
+
+func Test_createPersistentVolumeAttachmentsView(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	pv := testutil.CreatePersistentVolume("pv")
+	attached := testutil.CreateVolumeAttachment("attached", "pv")
+	other := testutil.CreateVolumeAttachment("other", "other-pv")
+
+	ctx := context.Background()
+
+	key := store.Key{APIVersion: "storage.k8s.io/v1", Kind: "VolumeAttachment"}
+	tpo.objectStore.EXPECT().List(ctx, key).
+		Return(testutil.ToUnstructuredList(t, attached, other), false, nil)
+
+	tpo.PathForObject(attached, attached.Name, "/attached")
+	tpo.PathForGVK("", "v1", "PersistentVolume", "pv", "pv", "/pv")
+	tpo.PathForGVK("", "v1", "Node", "node", "node", "/node")
+
+	got, err := createPersistentVolumeAttachmentsView(ctx, pv, printOptions)
+	require.NoError(t, err)
+
+	table, ok := got.(*component.Table)
+	require.True(t, ok)
+	require.Len(t, table.Rows(), 1)
+}
@@ -0,0 +1,274 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// volumeSnapshot is the part of a snapshot.storage.k8s.io VolumeSnapshot
+// which is printed. The snapshot types aren't vendored, so the custom
+// resource is converted to this.
+type volumeSnapshot struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		Source struct {
+			PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+			VolumeSnapshotContentName *string `json:"volumeSnapshotContentName,omitempty"`
+		} `json:"source"`
+		VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+	} `json:"spec"`
+	Status *struct {
+		BoundVolumeSnapshotContentName *string              `json:"boundVolumeSnapshotContentName,omitempty"`
+		CreationTime                   *metav1.Time         `json:"creationTime,omitempty"`
+		ReadyToUse                     *bool                `json:"readyToUse,omitempty"`
+		RestoreSize                    *resource.Quantity   `json:"restoreSize,omitempty"`
+		Error                          *volumeSnapshotError `json:"error,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+// volumeSnapshotContent is the part of a snapshot.storage.k8s.io
+// VolumeSnapshotContent which is printed.
+type volumeSnapshotContent struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		VolumeSnapshotRef struct {
+			Namespace string `json:"namespace,omitempty"`
+			Name      string `json:"name,omitempty"`
+		} `json:"volumeSnapshotRef"`
+		DeletionPolicy string `json:"deletionPolicy"`
+		Driver         string `json:"driver"`
+		Source         struct {
+			VolumeHandle   *string `json:"volumeHandle,omitempty"`
+			SnapshotHandle *string `json:"snapshotHandle,omitempty"`
+		} `json:"source"`
+		VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+	} `json:"spec"`
+	Status *struct {
+		SnapshotHandle *string              `json:"snapshotHandle,omitempty"`
+		CreationTime   *int64               `json:"creationTime,omitempty"`
+		RestoreSize    *int64               `json:"restoreSize,omitempty"`
+		ReadyToUse     *bool                `json:"readyToUse,omitempty"`
+		Error          *volumeSnapshotError `json:"error,omitempty"`
+	} `json:"status,omitempty"`
+}
+
+type volumeSnapshotError struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// setVolumeSnapshotPrinters replaces the generic custom resource printers for
+// VolumeSnapshot and VolumeSnapshotContent objects.
+func setVolumeSnapshotPrinters(ctx context.Context, h *customResourceHandler, options Options) {
+	switch h.cr.GroupVersionKind().GroupKind() {
+	case gvk.VolumeSnapshot.GroupKind():
+		h.configFunc = func(_, cr *unstructured.Unstructured) (*component.Summary, error) {
+			return printVolumeSnapshotConfig(cr, options)
+		}
+		h.statusFunc = func(_, cr *unstructured.Unstructured) (*component.Summary, error) {
+			return printVolumeSnapshotStatus(cr, options)
+		}
+	case gvk.VolumeSnapshotContent.GroupKind():
+		h.configFunc = func(_, cr *unstructured.Unstructured) (*component.Summary, error) {
+			return printVolumeSnapshotContentConfig(ctx, cr, options)
+		}
+		h.statusFunc = func(_, cr *unstructured.Unstructured) (*component.Summary, error) {
+			return printVolumeSnapshotContentStatus(cr)
+		}
+	}
+}
+
+func printVolumeSnapshotConfig(cr *unstructured.Unstructured, options Options) (*component.Summary, error) {
+	snapshot := &volumeSnapshot{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, snapshot); err != nil {
+		return nil, errors.Wrap(err, "convert volume snapshot")
+	}
+
+	sections := component.SummarySections{}
+
+	source := snapshot.Spec.Source
+	switch {
+	case source.PersistentVolumeClaimName != nil:
+		sections.Add("Source", persistentVolumeClaimLink(snapshot.Namespace, *source.PersistentVolumeClaimName, options))
+	case source.VolumeSnapshotContentName != nil:
+		sections.Add("Source", volumeSnapshotContentLink(cr.GetAPIVersion(), *source.VolumeSnapshotContentName, options))
+	}
+
+	if snapshot.Spec.VolumeSnapshotClassName != nil {
+		sections.AddText("Volume Snapshot Class", *snapshot.Spec.VolumeSnapshotClassName)
+	}
+
+	return component.NewSummary("Configuration", sections...), nil
+}
+
+func printVolumeSnapshotStatus(cr *unstructured.Unstructured, options Options) (*component.Summary, error) {
+	snapshot := &volumeSnapshot{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, snapshot); err != nil {
+		return nil, errors.Wrap(err, "convert volume snapshot")
+	}
+
+	sections := component.SummarySections{}
+
+	status := snapshot.Status
+	if status == nil {
+		return component.NewSummary("Status", sections...), nil
+	}
+
+	if status.BoundVolumeSnapshotContentName != nil {
+		sections.Add("Volume Snapshot Content", volumeSnapshotContentLink(cr.GetAPIVersion(), *status.BoundVolumeSnapshotContentName, options))
+	}
+
+	sections.Add("Ready To Use", volumeSnapshotReadyToUse(status.ReadyToUse))
+
+	if status.RestoreSize != nil {
+		sections.AddText("Restore Size", status.RestoreSize.String())
+	}
+
+	if status.CreationTime != nil {
+		sections.Add("Creation Time", component.NewTimestamp(status.CreationTime.Time))
+	}
+
+	if message := volumeSnapshotErrorMessage(status.Error); message != nil {
+		sections.Add("Error", message)
+	}
+
+	return component.NewSummary("Status", sections...), nil
+}
+
+func printVolumeSnapshotContentConfig(ctx context.Context, cr *unstructured.Unstructured, options Options) (*component.Summary, error) {
+	content := &volumeSnapshotContent{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, content); err != nil {
+		return nil, errors.Wrap(err, "convert volume snapshot content")
+	}
+
+	sections := component.SummarySections{}
+
+	ref := content.Spec.VolumeSnapshotRef
+	if ref.Name != "" {
+		sections.Add("Volume Snapshot", volumeSnapshotLink(cr.GetAPIVersion(), ref.Namespace, ref.Name, options))
+	}
+
+	driver, err := csiDriverLink(ctx, content.Spec.Driver, options)
+	if err != nil {
+		return nil, err
+	}
+	sections.Add("Driver", driver)
+	sections.AddText("Deletion Policy", content.Spec.DeletionPolicy)
+
+	if content.Spec.VolumeSnapshotClassName != nil {
+		sections.AddText("Volume Snapshot Class", *content.Spec.VolumeSnapshotClassName)
+	}
+
+	source := content.Spec.Source
+	switch {
+	case source.VolumeHandle != nil:
+		sections.AddText("Volume Handle", *source.VolumeHandle)
+	case source.SnapshotHandle != nil:
+		sections.AddText("Snapshot Handle", *source.SnapshotHandle)
+	}
+
+	return component.NewSummary("Configuration", sections...), nil
+}
+
+func printVolumeSnapshotContentStatus(cr *unstructured.Unstructured) (*component.Summary, error) {
+	content := &volumeSnapshotContent{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cr.Object, content); err != nil {
+		return nil, errors.Wrap(err, "convert volume snapshot content")
+	}
+
+	sections := component.SummarySections{}
+
+	status := content.Status
+	if status == nil {
+		return component.NewSummary("Status", sections...), nil
+	}
+
+	sections.Add("Ready To Use", volumeSnapshotReadyToUse(status.ReadyToUse))
+
+	if status.RestoreSize != nil {
+		sections.AddText("Restore Size", resource.NewQuantity(*status.RestoreSize, resource.BinarySI).String())
+	}
+
+	if status.SnapshotHandle != nil {
+		sections.AddText("Snapshot Handle", *status.SnapshotHandle)
+	}
+
+	if status.CreationTime != nil {
+		sections.Add("Creation Time", component.NewTimestamp(time.Unix(0, *status.CreationTime)))
+	}
+
+	if message := volumeSnapshotErrorMessage(status.Error); message != nil {
+		sections.Add("Error", message)
+	}
+
+	return component.NewSummary("Status", sections...), nil
+}
+
+func volumeSnapshotReadyToUse(readyToUse *bool) *component.Text {
+	ready := readyToUse != nil && *readyToUse
+
+	text := component.NewText(fmt.Sprintf("%t", ready))
+	if !ready {
+		text.SetStatus(component.TextStatusWarning)
+	}
+
+	return text
+}
+
+func volumeSnapshotErrorMessage(snapshotError *volumeSnapshotError) *component.Text {
+	if snapshotError == nil || snapshotError.Message == nil {
+		return nil
+	}
+
+	text := component.NewText(*snapshotError.Message)
+	text.SetStatus(component.TextStatusError)
+	return text
+}
+
+// persistentVolumeClaimLink creates a link to a persistent volume claim. If the
+// link can't be generated, the persistent volume claim name is returned as text.
+func persistentVolumeClaimLink(namespace, name string, options Options) component.Component {
+	apiVersion, kind := gvk.PersistentVolumeClaim.ToAPIVersionAndKind()
+	link, err := options.Link.ForGVK(namespace, apiVersion, kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return link
+}
+
+// volumeSnapshotLink creates a link to a volume snapshot with the same API
+// version as the object linking to it.
+func volumeSnapshotLink(apiVersion, namespace, name string, options Options) component.Component {
+	link, err := options.Link.ForGVK(namespace, apiVersion, gvk.VolumeSnapshot.Kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return link
+}
+
+// volumeSnapshotContentLink creates a link to a volume snapshot content with
+// the same API version as the object linking to it.
+func volumeSnapshotContentLink(apiVersion, name string, options Options) component.Component {
+	link, err := options.Link.ForGVK("", apiVersion, gvk.VolumeSnapshotContent.Kind, name, name)
+	if err != nil {
+		return component.NewText(name)
+	}
+
+	return link
+}
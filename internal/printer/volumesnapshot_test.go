@@ -0,0 +1,140 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_printVolumeSnapshot(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK(testutil.DefaultNamespace, "v1", "PersistentVolumeClaim", "pvc", "pvc", "/pvc")
+	tpo.PathForGVK("", "snapshot.storage.k8s.io/v1beta1", "VolumeSnapshotContent", "content", "content", "/content")
+
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+		"kind":       "VolumeSnapshot",
+		"metadata": map[string]interface{}{
+			"namespace": testutil.DefaultNamespace,
+			"name":      "snapshot",
+		},
+		"spec": map[string]interface{}{
+			"source": map[string]interface{}{
+				"persistentVolumeClaimName": "pvc",
+			},
+			"volumeSnapshotClassName": "csi-snapclass",
+		},
+		"status": map[string]interface{}{
+			"boundVolumeSnapshotContentName": "content",
+			"creationTime":                   "2020-07-01T12:00:00Z",
+			"readyToUse":                     false,
+			"restoreSize":                    "1Gi",
+			"error": map[string]interface{}{
+				"message": "snapshot failed",
+			},
+		},
+	}}
+
+	config, err := printVolumeSnapshotConfig(cr, printOptions)
+	require.NoError(t, err)
+
+	expectedConfig := component.NewSummary("Configuration", []component.SummarySection{
+		{Header: "Source", Content: component.NewLink("", "pvc", "/pvc")},
+		{Header: "Volume Snapshot Class", Content: component.NewText("csi-snapclass")},
+	}...)
+	component.AssertEqual(t, expectedConfig, config)
+
+	status, err := printVolumeSnapshotStatus(cr, printOptions)
+	require.NoError(t, err)
+
+	readyToUse := component.NewText("false")
+	readyToUse.SetStatus(component.TextStatusWarning)
+	snapshotError := component.NewText("snapshot failed")
+	snapshotError.SetStatus(component.TextStatusError)
+
+	expectedStatus := component.NewSummary("Status", []component.SummarySection{
+		{Header: "Volume Snapshot Content", Content: component.NewLink("", "content", "/content")},
+		{Header: "Ready To Use", Content: readyToUse},
+		{Header: "Restore Size", Content: component.NewText("1Gi")},
+		{Header: "Creation Time", Content: component.NewTimestamp(time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC))},
+		{Header: "Error", Content: snapshotError},
+	}...)
+	component.AssertEqual(t, expectedStatus, status)
+}
+
+func Test_printVolumeSnapshotContent(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tpo := newTestPrinterOptions(controller)
+	printOptions := tpo.ToOptions()
+
+	tpo.PathForGVK(testutil.DefaultNamespace, "snapshot.storage.k8s.io/v1beta1", "VolumeSnapshot", "snapshot", "snapshot", "/snapshot")
+
+	ctx := context.Background()
+	key := store.Key{APIVersion: "storage.k8s.io/v1", Kind: "CSIDriver", Name: "csi.example.com"}
+	tpo.objectStore.EXPECT().Get(ctx, key).Return(nil, nil)
+
+	cr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "snapshot.storage.k8s.io/v1beta1",
+		"kind":       "VolumeSnapshotContent",
+		"metadata": map[string]interface{}{
+			"name": "content",
+		},
+		"spec": map[string]interface{}{
+			"deletionPolicy": "Delete",
+			"driver":         "csi.example.com",
+			"source": map[string]interface{}{
+				"volumeHandle": "vol-1",
+			},
+			"volumeSnapshotRef": map[string]interface{}{
+				"namespace": testutil.DefaultNamespace,
+				"name":      "snapshot",
+			},
+		},
+		"status": map[string]interface{}{
+			"readyToUse":     true,
+			"restoreSize":    int64(1073741824),
+			"snapshotHandle": "snap-1",
+		},
+	}}
+
+	config, err := printVolumeSnapshotContentConfig(ctx, cr, printOptions)
+	require.NoError(t, err)
+
+	expectedConfig := component.NewSummary("Configuration", []component.SummarySection{
+		{Header: "Volume Snapshot", Content: component.NewLink("", "snapshot", "/snapshot")},
+		{Header: "Driver", Content: component.NewText("csi.example.com")},
+		{Header: "Deletion Policy", Content: component.NewText("Delete")},
+		{Header: "Volume Handle", Content: component.NewText("vol-1")},
+	}...)
+	component.AssertEqual(t, expectedConfig, config)
+
+	status, err := printVolumeSnapshotContentStatus(cr)
+	require.NoError(t, err)
+
+	expectedStatus := component.NewSummary("Status", []component.SummarySection{
+		{Header: "Ready To Use", Content: component.NewText("true")},
+		{Header: "Restore Size", Content: component.NewText("1Gi")},
+		{Header: "Snapshot Handle", Content: component.NewText("snap-1")},
+	}...)
+	component.AssertEqual(t, expectedStatus, status)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/store"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+// workloadActions describes which workload actions are available for an object.
+type workloadActions struct {
+	// scalable is true if the object exposes the scale subresource.
+	scalable bool
+	// restartable is true if the object has a pod template which is rolled
+	// out when it changes.
+	restartable bool
+}
+
+// isScalable returns true if an object can be scaled. Objects with a controller
+// owner, e.g. replica sets created by a deployment, are scaled by their
+// controller, which reverts any other change to their replicas.
+func isScalable(object runtime.Object, actions workloadActions) bool {
+	if !actions.scalable {
+		return false
+	}
+
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return false
+	}
+
+	return metav1.GetControllerOf(accessor) == nil
+}
+
+func scaleActionPayload(key store.Key, delta float64) action.Payload {
+	payload := key.ToActionPayload()
+	payload["delta"] = delta
+	return payload
+}
+
+func restartConfirmation(key store.Key) *component.Confirmation {
+	return &component.Confirmation{
+		Title: fmt.Sprintf("Restart %s", key.Kind),
+		Body:  fmt.Sprintf("Are you sure you want to restart *%s* **%s**? All of its pods will be replaced.", key.Kind, key.Name),
+	}
+}
+
+// workloadGridActions creates grid actions to scale or restart an object.
+func workloadGridActions(object runtime.Object, actions workloadActions) ([]component.GridAction, error) {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return nil, fmt.Errorf("create key from object: %w", err)
+	}
+
+	var gridActions []component.GridAction
+
+	if isScalable(object, actions) {
+		gridActions = append(gridActions,
+			component.GridAction{
+				Name:       "Scale Up",
+				ActionPath: octant.ActionScale,
+				Payload:    scaleActionPayload(key, 1),
+				Type:       component.GridActionPrimary,
+			},
+			component.GridAction{
+				Name:       "Scale Down",
+				ActionPath: octant.ActionScale,
+				Payload:    scaleActionPayload(key, -1),
+				Type:       component.GridActionPrimary,
+			})
+	}
+
+	if actions.restartable {
+		gridActions = append(gridActions, component.GridAction{
+			Name:         "Restart",
+			ActionPath:   octant.ActionRolloutRestart,
+			Payload:      key.ToActionPayload(),
+			Confirmation: restartConfirmation(key),
+			Type:         component.GridActionPrimary,
+		})
+	}
+
+	return gridActions, nil
+}
+
+// addWorkloadGridActions adds grid actions to scale or restart an object to a row.
+func addWorkloadGridActions(row component.TableRow, object runtime.Object, actions workloadActions) error {
+	gridActions, err := workloadGridActions(object, actions)
+	if err != nil {
+		return err
+	}
+
+	for _, gridAction := range gridActions {
+		row.AddAction(gridAction)
+	}
+
+	return nil
+}
+
+// addWorkloadButtons adds buttons to scale or restart an object.
+func addWorkloadButtons(o ObjectInterface, object runtime.Object, actions workloadActions) error {
+	key, err := store.KeyFromObject(object)
+	if err != nil {
+		return fmt.Errorf("create key from object: %w", err)
+	}
+
+	if isScalable(object, actions) {
+		o.AddButton("Scale Up", action.CreatePayload(octant.ActionScale, scaleActionPayload(key, 1)))
+		o.AddButton("Scale Down", action.CreatePayload(octant.ActionScale, scaleActionPayload(key, -1)))
+	}
+
+	if actions.restartable {
+		confirmation := restartConfirmation(key)
+		o.AddButton("Restart", action.CreatePayload(octant.ActionRolloutRestart, key.ToActionPayload()),
+			component.WithButtonConfirmation(confirmation.Title, confirmation.Body))
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package printer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/octant"
+	"github.com/vmware-tanzu/octant/internal/testutil"
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/view/component"
+)
+
+func Test_workloadGridActions(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+
+	payload := func(delta float64) action.Payload {
+		return action.Payload{
+			"namespace":  "namespace",
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       "deployment",
+			"delta":      delta,
+		}
+	}
+
+	scaleUp := component.GridAction{
+		Name:       "Scale Up",
+		ActionPath: octant.ActionScale,
+		Payload:    payload(1),
+		Type:       component.GridActionPrimary,
+	}
+	scaleDown := component.GridAction{
+		Name:       "Scale Down",
+		ActionPath: octant.ActionScale,
+		Payload:    payload(-1),
+		Type:       component.GridActionPrimary,
+	}
+	restart := component.GridAction{
+		Name:       "Restart",
+		ActionPath: octant.ActionRolloutRestart,
+		Payload: action.Payload{
+			"namespace":  "namespace",
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       "deployment",
+		},
+		Confirmation: &component.Confirmation{
+			Title: "Restart Deployment",
+			Body:  "Are you sure you want to restart *Deployment* **deployment**? All of its pods will be replaced.",
+		},
+		Type: component.GridActionPrimary,
+	}
+
+	tests := []struct {
+		name     string
+		actions  workloadActions
+		expected []component.GridAction
+	}{
+		{
+			name:     "scale and restart",
+			actions:  workloadActions{scalable: true, restartable: true},
+			expected: []component.GridAction{scaleUp, scaleDown, restart},
+		},
+		{
+			name:     "scale",
+			actions:  workloadActions{scalable: true},
+			expected: []component.GridAction{scaleUp, scaleDown},
+		},
+		{
+			name:     "restart",
+			actions:  workloadActions{restartable: true},
+			expected: []component.GridAction{restart},
+		},
+		{
+			name:    "none",
+			actions: workloadActions{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := workloadGridActions(deployment, test.actions)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func Test_workloadGridActions_controlled(t *testing.T) {
+	replicaSet := testutil.CreateAppReplicaSet("replicaSet")
+	replicaSet.SetOwnerReferences(testutil.ToOwnerReferences(t, testutil.CreateDeployment("deployment")))
+
+	got, err := workloadGridActions(replicaSet, workloadActions{scalable: true})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
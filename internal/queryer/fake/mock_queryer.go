@@ -15,6 +15,7 @@ import (
 	v1beta10 "k8s.io/api/extensions/v1beta1"
 	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 	v12 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 )
 
@@ -162,6 +163,21 @@ func (mr *MockQueryerMockRecorder) PodsForService(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsForService", reflect.TypeOf((*MockQueryer)(nil).PodsForService), arg0, arg1)
 }
 
+// PodsForWorkload mocks base method
+func (m *MockQueryer) PodsForWorkload(arg0 context.Context, arg1 runtime.Object) ([]*v10.Pod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PodsForWorkload", arg0, arg1)
+	ret0, _ := ret[0].([]*v10.Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PodsForWorkload indicates an expected call of PodsForWorkload
+func (mr *MockQueryerMockRecorder) PodsForWorkload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodsForWorkload", reflect.TypeOf((*MockQueryer)(nil).PodsForWorkload), arg0, arg1)
+}
+
 // ScaleTarget mocks base method
 func (m *MockQueryer) ScaleTarget(arg0 context.Context, arg1 *v1.HorizontalPodAutoscaler) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
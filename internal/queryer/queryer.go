@@ -22,6 +22,7 @@ import (
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	kLabels "k8s.io/apimachinery/pkg/labels"
@@ -51,6 +52,7 @@ type Queryer interface {
 	OwnerReference(ctx context.Context, object *unstructured.Unstructured) (bool, []*unstructured.Unstructured, error)
 	ScaleTarget(ctx context.Context, hpa *autoscalingv1.HorizontalPodAutoscaler) (map[string]interface{}, error)
 	PodsForService(ctx context.Context, service *corev1.Service) ([]*corev1.Pod, error)
+	PodsForWorkload(ctx context.Context, workload runtime.Object) ([]*corev1.Pod, error)
 	ServicesForIngress(ctx context.Context, ingress *extv1beta1.Ingress) (*unstructured.UnstructuredList, error)
 	ServicesForPod(ctx context.Context, pod *corev1.Pod) ([]*corev1.Service, error)
 	ServiceAccountForPod(ctx context.Context, pod *corev1.Pod) (*corev1.ServiceAccount, error)
@@ -683,6 +685,39 @@ func (osq *ObjectStoreQueryer) PodsForService(ctx context.Context, service *core
 	return pods, nil
 }
 
+// PodsForWorkload returns the pods matching the selector of a workload.
+func (osq *ObjectStoreQueryer) PodsForWorkload(ctx context.Context, workload runtime.Object) ([]*corev1.Pod, error) {
+	if workload == nil {
+		return nil, errors.New("nil workload")
+	}
+
+	accessor, err := meta.Accessor(workload)
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := osq.getSelector(workload)
+	if err != nil {
+		return nil, err
+	}
+	if selector == nil {
+		return nil, errors.Errorf("%T %q does not have a pod selector", workload, accessor.GetName())
+	}
+
+	key := store.Key{
+		Namespace:  accessor.GetNamespace(),
+		APIVersion: "v1",
+		Kind:       "Pod",
+	}
+
+	pods, err := osq.loadPods(ctx, key, selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetching pods for workload: %v", accessor.GetName())
+	}
+
+	return pods, nil
+}
+
 func (osq *ObjectStoreQueryer) loadPods(ctx context.Context, key store.Key, labelSelector *metav1.LabelSelector) ([]*corev1.Pod, error) {
 	objects, _, err := osq.objectStore.List(ctx, key)
 	if err != nil {
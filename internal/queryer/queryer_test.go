@@ -893,6 +893,78 @@ func TestCacheQueryer_PodsForService(t *testing.T) {
 	}
 }
 
+func TestCacheQueryer_PodsForWorkload(t *testing.T) {
+	deployment := testutil.CreateDeployment("deployment")
+	deployment.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "one"},
+	}
+
+	pod1 := testutil.CreatePod("pod1")
+	pod1.Labels = map[string]string{"app": "one", "pod-template-hash": "abc"}
+
+	pod2 := testutil.CreatePod("pod2")
+	pod2.Labels = map[string]string{"app": "two"}
+
+	cases := []struct {
+		name     string
+		workload runtime.Object
+		setup    func(t *testing.T, o *storeFake.MockStore)
+		expected []*corev1.Pod
+		isErr    bool
+	}{
+		{
+			name:     "in general",
+			workload: deployment,
+			setup: func(t *testing.T, o *storeFake.MockStore) {
+				key := store.Key{
+					Namespace:  "namespace",
+					APIVersion: "v1",
+					Kind:       "Pod",
+				}
+				o.EXPECT().
+					List(gomock.Any(), gomock.Eq(key)).
+					Return(testutil.ToUnstructuredList(t, pod1, pod2), false, nil)
+			},
+			expected: []*corev1.Pod{pod1},
+		},
+		{
+			name:     "workload is nil",
+			workload: nil,
+			isErr:    true,
+		},
+		{
+			name:     "workload without selector",
+			workload: testutil.CreateCronJob("cronjob"),
+			isErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			o := storeFake.NewMockStore(controller)
+			discovery := queryerFake.NewMockDiscoveryInterface(controller)
+
+			if tc.setup != nil {
+				tc.setup(t, o)
+			}
+
+			oq := New(o, discovery)
+
+			got, err := oq.PodsForWorkload(context.Background(), tc.workload)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestCacheQueryer_ServicesForIngress_service_not_found(t *testing.T) {
 	ingress := testutil.CreateIngress("ingress")
 	ingress.Spec.Backend = &extv1beta1.IngressBackend{
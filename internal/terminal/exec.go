@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package terminal
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/vmware-tanzu/octant/internal/cluster"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+// newExecutor creates an executor which runs a command in a pod's container.
+// The executor's connection is closed when ctx is done.
+func newExecutor(ctx context.Context, restClient rest.Interface, config *rest.Config, key store.Key, options *corev1.PodExecOptions) (remotecommand.Executor, error) {
+	request := restClient.Post().
+		Resource("pods").
+		Name(key.Name).
+		Namespace(key.Namespace).
+		SubResource("exec")
+
+	request.VersionedParams(options, scheme.ParameterCodec)
+
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return remotecommand.NewSPDYExecutorForTransports(transport, &contextUpgrader{ctx: ctx, upgrader: upgrader}, "POST", request.URL())
+}
+
+// contextUpgrader closes the connections it creates when its context is done.
+// It lets streams which don't take a context be cancelled.
+type contextUpgrader struct {
+	ctx      context.Context
+	upgrader spdy.Upgrader
+}
+
+var _ spdy.Upgrader = (*contextUpgrader)(nil)
+
+// NewConnection creates a connection which is closed when the context is done.
+func (u *contextUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := u.upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-u.ctx.Done():
+			_ = conn.Close()
+		case <-conn.CloseChan():
+		}
+	}()
+
+	return conn, nil
+}
+
+// Exec runs a command in a pod's container without a TTY. Stdin is optional.
+// Exec returns when the command exits, or when ctx is done.
+func Exec(ctx context.Context, client cluster.ClientInterface, key store.Key, container string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	restClient, err := client.RESTClient()
+	if err != nil {
+		return errors.Wrap(err, "fetching RESTClient")
+	}
+
+	rc, err := newExecutor(ctx, restClient, client.RESTConfig(), key, &corev1.PodExecOptions{
+		Container: container,
+		Command:   command,
+		Stdin:     stdin != nil,
+		Stdout:    stdout != nil,
+		Stderr:    stderr != nil,
+	})
+	if err != nil {
+		return errors.Wrap(err, "create executor")
+	}
+
+	err = rc.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	return err
+}
@@ -17,7 +17,6 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 
@@ -64,8 +63,9 @@ type pty struct {
 	resize       chan remotecommand.TerminalSize
 	activityFunc func()
 
-	out  io.ReadWriter
-	size *remotecommand.TerminalSize
+	out      io.ReadWriter
+	size     *remotecommand.TerminalSize
+	recorder *Recorder
 
 	mu sync.RWMutex
 }
@@ -79,6 +79,8 @@ func (p *pty) Write(b []byte) (int, error) {
 	defer p.mu.Unlock()
 	defer p.activityFunc()
 
+	p.recorder.Output(b)
+
 	return p.out.Write(b)
 }
 
@@ -164,8 +166,19 @@ type instance struct {
 
 var _ Instance = (*instance)(nil)
 
+// InstanceOption is an option for configuring an instance.
+type InstanceOption func(p *pty)
+
+// WithRecorder records the instance's session. The recorder is closed when the
+// instance's context is done.
+func WithRecorder(recorder *Recorder) InstanceOption {
+	return func(p *pty) {
+		p.recorder = recorder
+	}
+}
+
 // NewTerminalInstance creates a concrete Terminal
-func NewTerminalInstance(ctx context.Context, client cluster.ClientInterface, logger log.Logger, key store.Key, container, command string, activityChan chan Instance) (Instance, error) {
+func NewTerminalInstance(ctx context.Context, client cluster.ClientInterface, logger log.Logger, key store.Key, container, command string, activityChan chan Instance, options ...InstanceOption) (Instance, error) {
 	ctx, cancelFn := context.WithCancel(ctx)
 
 	restClient, err := client.RESTClient()
@@ -184,6 +197,19 @@ func NewTerminalInstance(ctx context.Context, client cluster.ClientInterface, lo
 		size:      &remotecommand.TerminalSize{},
 	}
 
+	for _, option := range options {
+		option(termPty)
+	}
+
+	if termPty.recorder != nil {
+		go func() {
+			<-ctx.Done()
+			if err := termPty.recorder.Close(); err != nil {
+				logger.WithErr(err).Errorf("closing terminal recording")
+			}
+		}()
+	}
+
 	t := &instance{
 		restClient: restClient,
 		config:     client.RESTConfig(),
@@ -204,22 +230,14 @@ func NewTerminalInstance(ctx context.Context, client cluster.ClientInterface, lo
 }
 
 func (t *instance) terminalStream() error {
-	request := t.restClient.Post().
-		Resource("pods").
-		Name(t.key.Name).
-		Namespace(t.key.Namespace).
-		SubResource("exec")
-
-	request.VersionedParams(&corev1.PodExecOptions{
+	rc, err := newExecutor(t.ctx, t.restClient, t.config, t.key, &corev1.PodExecOptions{
 		Container: t.container,
 		Command:   parseCommand("/bin/sh"),
 		Stdin:     true,
 		Stdout:    true,
 		Stderr:    false,
 		TTY:       true,
-	}, scheme.ParameterCodec)
-
-	rc, err := remotecommand.NewSPDYExecutor(t.config, "POST", request.URL())
+	})
 	if err != nil {
 		fmt.Println(fmt.Sprintf("%v", err))
 		return err
@@ -244,6 +262,7 @@ func (t *instance) terminalStream() error {
 }
 
 func (t *instance) Resize(cols, rows uint16) {
+	t.pty.recorder.Resize(cols, rows)
 	t.pty.resize <- remotecommand.TerminalSize{
 		Width:  cols,
 		Height: rows,
@@ -298,6 +317,7 @@ func (t *instance) Write(key []byte) error {
 	if t.pty == nil {
 		return errors.New("can not execute command, no stdin")
 	}
+	t.pty.recorder.Input(key)
 	t.pty.keystroke <- key
 
 	return nil
@@ -0,0 +1,410 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package terminal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RecordingExtension is the file extension of terminal recordings.
+	RecordingExtension = ".cast"
+
+	asciicastVersion = 2
+
+	eventOutput = "o"
+	eventInput  = "i"
+	eventResize = "r"
+
+	defaultRecordingWidth  = 80
+	defaultRecordingHeight = 24
+
+	// maxRecordingHeaderSize is the longest header a recording can have.
+	maxRecordingHeaderSize = 64 * 1024
+	// recordingTailSize is how much of the end of a recording is read to
+	// find its last event.
+	recordingTailSize = 64 * 1024
+)
+
+// RecordingSource describes the container a recording was made in.
+type RecordingSource struct {
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+}
+
+// RecordingHeader is the header of an asciicast v2 recording. Source is not part
+// of the asciicast format, and is ignored by players.
+type RecordingHeader struct {
+	Version   int               `json:"version"`
+	Width     uint16            `json:"width"`
+	Height    uint16            `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Command   string            `json:"command,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	Source    RecordingSource   `json:"octant"`
+}
+
+// Recording describes a recording file.
+type Recording struct {
+	Name     string
+	Header   RecordingHeader
+	Duration time.Duration
+}
+
+// StartedAt returns the time the recording started.
+func (r Recording) StartedAt() time.Time {
+	return time.Unix(r.Header.Timestamp, 0)
+}
+
+// Recorder records a terminal session in the asciicast v2 format. A nil
+// Recorder does nothing, so instances without a recorder can call it.
+type Recorder struct {
+	w       io.WriteCloser
+	path    string
+	started time.Time
+	now     func() time.Time
+
+	// partial holds the bytes of a multi-byte character split across writes.
+	partial []byte
+	closed  bool
+
+	mu sync.Mutex
+}
+
+// NewRecorder creates a recorder which writes to w, and writes the header.
+func NewRecorder(w io.WriteCloser, header RecordingHeader, now func() time.Time) (*Recorder, error) {
+	if now == nil {
+		now = time.Now
+	}
+
+	started := now()
+
+	header.Version = asciicastVersion
+	header.Timestamp = started.Unix()
+	if header.Width == 0 || header.Height == 0 {
+		header.Width, header.Height = defaultRecordingWidth, defaultRecordingHeight
+	}
+
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal recording header")
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\n", data); err != nil {
+		return nil, errors.Wrap(err, "write recording header")
+	}
+
+	return &Recorder{
+		w:       w,
+		started: started,
+		now:     now,
+	}, nil
+}
+
+// CreateRecorder creates a recording file in dir for a terminal session.
+func CreateRecorder(dir string, source RecordingSource, command string) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "create recording directory")
+	}
+
+	name := fmt.Sprintf("%s-%s-%s-%s%s",
+		time.Now().UTC().Format("20060102T150405.000Z"),
+		source.Namespace, source.Pod, source.Container, RecordingExtension)
+
+	f, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "create recording file")
+	}
+
+	header := RecordingHeader{
+		Command: command,
+		Title:   fmt.Sprintf("%s/%s/%s", source.Namespace, source.Pod, source.Container),
+		Env:     map[string]string{"TERM": "xterm"},
+		Source:  source,
+	}
+
+	r, err := NewRecorder(f, header, nil)
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return nil, err
+	}
+	r.path = f.Name()
+
+	return r, nil
+}
+
+// Output records output from the terminal.
+func (r *Recorder) Output(b []byte) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data := append(r.partial, b...)
+	complete, partial := splitPartialRune(data)
+	r.partial = append([]byte(nil), partial...)
+
+	if len(complete) > 0 {
+		r.write(eventOutput, string(complete))
+	}
+}
+
+// Input records input sent to the terminal.
+func (r *Recorder) Input(b []byte) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.write(eventInput, string(b))
+}
+
+// Resize records the terminal being resized.
+func (r *Recorder) Resize(cols, rows uint16) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.write(eventResize, fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Close closes the recording. It is safe to call Close more than once.
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil
+	}
+
+	if len(r.partial) > 0 {
+		r.write(eventOutput, string(r.partial))
+		r.partial = nil
+	}
+
+	r.closed = true
+	return r.w.Close()
+}
+
+// Discard closes the recording and removes its file. It is used when the
+// session being recorded never starts. Recorders which weren't created with
+// CreateRecorder are only closed.
+func (r *Recorder) Discard() error {
+	if r == nil {
+		return nil
+	}
+
+	if err := r.Close(); err != nil {
+		return err
+	}
+
+	if r.path == "" {
+		return nil
+	}
+
+	if err := os.Remove(r.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove recording file")
+	}
+
+	return nil
+}
+
+// write writes an event. It must be called with the lock held. Errors are
+// ignored so a failing recording doesn't interrupt the session.
+func (r *Recorder) write(eventType, data string) {
+	if r.closed {
+		return
+	}
+
+	elapsed := r.now().Sub(r.started).Seconds()
+
+	line, err := json.Marshal([]interface{}{roundSeconds(elapsed), eventType, data})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(r.w, "%s\n", line)
+}
+
+// roundSeconds rounds to microseconds, which is the precision asciinema uses.
+func roundSeconds(seconds float64) float64 {
+	return float64(int64(seconds*1e6+0.5)) / 1e6
+}
+
+// splitPartialRune splits an incomplete multi-byte character from the end of b.
+func splitPartialRune(b []byte) ([]byte, []byte) {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(b[i]) {
+			continue
+		}
+		if utf8.FullRune(b[i:]) {
+			return b, nil
+		}
+		return b[:i], b[i:]
+	}
+
+	return b, nil
+}
+
+// ListRecordings lists the recordings in a directory, newest first. A missing
+// directory has no recordings.
+func ListRecordings(dir string) ([]Recording, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "read recording directory")
+	}
+
+	var recordings []Recording
+	for _, fi := range files {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != RecordingExtension {
+			continue
+		}
+
+		recording, err := readRecordingInfo(filepath.Join(dir, fi.Name()), fi)
+		if err != nil {
+			// Skip files which aren't recordings.
+			continue
+		}
+		recordings = append(recordings, recording)
+	}
+
+	sort.SliceStable(recordings, func(i, j int) bool {
+		return recordings[i].Header.Timestamp > recordings[j].Header.Timestamp
+	})
+
+	return recordings, nil
+}
+
+// OpenRecording opens a recording by name.
+func OpenRecording(dir, name string) (*os.File, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return nil, errors.Errorf("invalid recording name %q", name)
+	}
+
+	return os.Open(filepath.Join(dir, name+RecordingExtension))
+}
+
+func readRecordingInfo(path string, fi os.FileInfo) (Recording, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Recording{}, err
+	}
+	defer f.Close()
+
+	header, err := readRecordingHeader(f)
+	if err != nil {
+		return Recording{}, err
+	}
+
+	recording := Recording{
+		Name:   strings.TrimSuffix(filepath.Base(path), RecordingExtension),
+		Header: header,
+	}
+
+	seconds, ok, err := lastEventTime(f, fi.Size())
+	if err != nil {
+		return Recording{}, errors.Wrap(err, "read recording")
+	}
+	if ok {
+		recording.Duration = time.Duration(seconds * float64(time.Second))
+	} else if elapsed := fi.ModTime().Sub(recording.StartedAt()); elapsed > 0 {
+		// The last event is too long to find its start, so the time the
+		// recording was last written is used instead.
+		recording.Duration = elapsed
+	}
+
+	return recording, nil
+}
+
+// readRecordingHeader reads the header from the first line of a recording.
+func readRecordingHeader(r io.Reader) (RecordingHeader, error) {
+	line, err := bufio.NewReader(io.LimitReader(r, maxRecordingHeaderSize)).ReadBytes('\n')
+	if err != nil {
+		return RecordingHeader{}, errors.New("recording has no header")
+	}
+
+	var header RecordingHeader
+	if err := json.Unmarshal(line, &header); err != nil {
+		return RecordingHeader{}, errors.Wrap(err, "parse recording header")
+	}
+	if header.Version != asciicastVersion {
+		return RecordingHeader{}, errors.Errorf("unsupported recording version %d", header.Version)
+	}
+
+	return header, nil
+}
+
+// lastEventTime returns the time of the last event in a recording, or zero if
+// it has no events. Only the end of the recording is read. It returns false if
+// the start of the last event isn't in the part which is read.
+func lastEventTime(f io.ReaderAt, size int64) (float64, bool, error) {
+	offset := size - recordingTailSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	tail := make([]byte, size-offset)
+	if _, err := f.ReadAt(tail, offset); err != nil && err != io.EOF {
+		return 0, false, err
+	}
+
+	tail = bytes.TrimRight(tail, "\r\n\t ")
+	i := bytes.LastIndexByte(tail, '\n')
+	if i < 0 {
+		// Either the whole recording is its header, or the last event
+		// started before the tail.
+		return 0, offset == 0, nil
+	}
+
+	line := bytes.TrimSpace(tail[i+1:])
+	if !bytes.HasPrefix(line, []byte("[")) {
+		return 0, false, nil
+	}
+
+	end := bytes.IndexByte(line, ',')
+	if end < 0 {
+		return 0, false, nil
+	}
+
+	seconds, err := strconv.ParseFloat(string(bytes.TrimSpace(line[1:end])), 64)
+	if err != nil {
+		return 0, false, nil
+	}
+
+	return seconds, true, nil
+}
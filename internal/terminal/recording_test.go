@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package terminal
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nopWriteCloser struct {
+	bytes.Buffer
+	closed int
+}
+
+func (w *nopWriteCloser) Close() error {
+	w.closed++
+	return nil
+}
+
+func TestRecorder(t *testing.T) {
+	started := time.Unix(1588327200, 0)
+	now := started
+	clock := func() time.Time { return now }
+
+	w := &nopWriteCloser{}
+	header := RecordingHeader{
+		Command: "/bin/sh",
+		Source:  RecordingSource{Namespace: "default", Pod: "web", Container: "app"},
+	}
+
+	r, err := NewRecorder(w, header, clock)
+	require.NoError(t, err)
+
+	now = started.Add(500 * time.Millisecond)
+	r.Resize(120, 40)
+
+	now = started.Add(time.Second)
+	r.Input([]byte("ls\r"))
+
+	// "é" split across two writes is recorded once it is complete.
+	now = started.Add(1500 * time.Millisecond)
+	r.Output([]byte("caf\xc3"))
+	now = started.Add(2 * time.Second)
+	r.Output([]byte("\xa9\r\n"))
+
+	require.NoError(t, r.Close())
+	require.NoError(t, r.Close())
+	assert.Equal(t, 1, w.closed)
+
+	r.Output([]byte("ignored"))
+
+	expected := strings.Join([]string{
+		`{"version":2,"width":80,"height":24,"timestamp":1588327200,"command":"/bin/sh","octant":{"namespace":"default","pod":"web","container":"app"}}`,
+		`[0.5,"r","120x40"]`,
+		`[1,"i","ls\r"]`,
+		`[1.5,"o","caf"]`,
+		`[2,"o","é\r\n"]`,
+		``,
+	}, "\n")
+	assert.Equal(t, expected, w.String())
+}
+
+func TestRecorder_nil(t *testing.T) {
+	var r *Recorder
+	r.Output([]byte("output"))
+	r.Input([]byte("input"))
+	r.Resize(80, 24)
+	assert.NoError(t, r.Close())
+}
+
+func Test_splitPartialRune(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		complete string
+		partial  string
+	}{
+		{name: "ascii", in: "abc", complete: "abc"},
+		{name: "complete rune", in: "caf\xc3\xa9", complete: "caf\xc3\xa9"},
+		{name: "partial rune", in: "caf\xc3", complete: "caf", partial: "\xc3"},
+		{name: "partial four byte rune", in: "a\xf0\x9f\x98", complete: "a", partial: "\xf0\x9f\x98"},
+		{name: "empty", in: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			complete, partial := splitPartialRune([]byte(test.in))
+			assert.Equal(t, test.complete, string(complete))
+			assert.Equal(t, test.partial, string(partial))
+		})
+	}
+}
+
+func TestListRecordings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	older := `{"version":2,"width":80,"height":24,"timestamp":100,"command":"/bin/sh","octant":{"namespace":"default","pod":"web","container":"app"}}` + "\n" +
+		`[0.5,"o","$ "]` + "\n" + `[12.25,"o","exit"]` + "\n"
+	newer := `{"version":2,"width":80,"height":24,"timestamp":200,"octant":{"namespace":"default","pod":"db","container":"db"}}` + "\n"
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "older.cast"), []byte(older), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "newer.cast"), []byte(newer), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "invalid.cast"), []byte("not a recording"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("notes"), 0600))
+
+	recordings, err := ListRecordings(dir)
+	require.NoError(t, err)
+	require.Len(t, recordings, 2)
+
+	assert.Equal(t, "newer", recordings[0].Name)
+	assert.Equal(t, time.Duration(0), recordings[0].Duration)
+
+	assert.Equal(t, "older", recordings[1].Name)
+	assert.Equal(t, "web", recordings[1].Header.Source.Pod)
+	assert.Equal(t, "/bin/sh", recordings[1].Header.Command)
+	assert.Equal(t, 12250*time.Millisecond, recordings[1].Duration)
+	assert.Equal(t, time.Unix(100, 0), recordings[1].StartedAt())
+
+	f, err := OpenRecording(dir, "older")
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Equal(t, older, string(data))
+
+	_, err = OpenRecording(dir, "../older")
+	assert.Error(t, err)
+
+	recordings, err = ListRecordings(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, recordings)
+}
+
+func TestListRecordings_longEvents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	header := `{"version":2,"width":80,"height":24,"timestamp":100,"octant":{"namespace":"default","pod":"web","container":"app"}}` + "\n"
+	long := strings.Repeat("x", 2*1024*1024)
+
+	// The last event is short, so its time is the duration.
+	earlier := header + `[1.5,"o","` + long + `"]` + "\n" + `[3,"o","exit"]` + "\n"
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "earlier.cast"), []byte(earlier), 0600))
+
+	// The start of the last event isn't read, so the duration is from the
+	// time the recording was last written.
+	last := header + `[1.5,"o","$ "]` + "\n" + `[3,"o","` + long + `"]` + "\n"
+	lastPath := filepath.Join(dir, "last.cast")
+	require.NoError(t, ioutil.WriteFile(lastPath, []byte(last), 0600))
+	require.NoError(t, os.Chtimes(lastPath, time.Unix(105, 0), time.Unix(105, 0)))
+
+	recordings, err := ListRecordings(dir)
+	require.NoError(t, err)
+	require.Len(t, recordings, 2)
+
+	durations := map[string]time.Duration{}
+	for _, recording := range recordings {
+		durations[recording.Name] = recording.Duration
+	}
+	assert.Equal(t, map[string]time.Duration{
+		"earlier": 3 * time.Second,
+		"last":    5 * time.Second,
+	}, durations)
+}
+
+func TestCreateRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	recordingDir := filepath.Join(dir, "sessions")
+
+	r, err := CreateRecorder(recordingDir, RecordingSource{Namespace: "default", Pod: "web", Container: "app"}, "/bin/sh")
+	require.NoError(t, err)
+	r.Output([]byte("$ "))
+	require.NoError(t, r.Close())
+
+	recordings, err := ListRecordings(recordingDir)
+	require.NoError(t, err)
+	require.Len(t, recordings, 1)
+	assert.Equal(t, "default/web/app", recordings[0].Header.Title)
+	assert.True(t, strings.HasSuffix(recordings[0].Name, "-default-web-app"))
+}
+
+func TestRecorder_Discard(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	r, err := CreateRecorder(dir, RecordingSource{Namespace: "default", Pod: "web", Container: "app"}, "/bin/sh")
+	require.NoError(t, err)
+	require.NoError(t, r.Discard())
+
+	recordings, err := ListRecordings(dir)
+	require.NoError(t, err)
+	assert.Empty(t, recordings)
+
+	var nilRecorder *Recorder
+	assert.NoError(t, nilRecorder.Discard())
+}
@@ -14,9 +14,12 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -73,6 +76,22 @@ func WithGenericCRD() CRDOption {
 	}
 }
 
+// CreateCSIDriver creates a CSI driver
+func CreateCSIDriver(name string) *storagev1.CSIDriver {
+	return &storagev1.CSIDriver{
+		TypeMeta:   genTypeMeta(gvk.CSIDriver),
+		ObjectMeta: genObjectMeta(name, false),
+	}
+}
+
+// CreateCSINode creates a CSI node
+func CreateCSINode(name string) *storagev1.CSINode {
+	return &storagev1.CSINode{
+		TypeMeta:   genTypeMeta(gvk.CSINode),
+		ObjectMeta: genObjectMeta(name, false),
+	}
+}
+
 // CreateCRD creates a CRD
 func CreateCRD(name string, options ...CRDOption) *apiextv1.CustomResourceDefinition {
 	crd := &apiextv1.CustomResourceDefinition{
@@ -167,6 +186,23 @@ func CreateDeployment(name string, options ...DeploymentOption) *appsv1.Deployme
 	return d
 }
 
+// CreateEndpoints creates endpoints
+func CreateEndpoints(name string) *corev1.Endpoints {
+	return &corev1.Endpoints{
+		TypeMeta:   genTypeMeta(gvk.Endpoints),
+		ObjectMeta: genObjectMeta(name, true),
+	}
+}
+
+// CreateEndpointSlice creates an endpoint slice
+func CreateEndpointSlice(name string) *discoveryv1beta1.EndpointSlice {
+	return &discoveryv1beta1.EndpointSlice{
+		TypeMeta:    genTypeMeta(gvk.EndpointSlice),
+		ObjectMeta:  genObjectMeta(name, true),
+		AddressType: discoveryv1beta1.AddressTypeIPv4,
+	}
+}
+
 // CreateEvent creates a event
 func CreateEvent(name string) *corev1.Event {
 	return &corev1.Event{
@@ -205,6 +241,14 @@ func CreateJob(name string) *batchv1.Job {
 	}
 }
 
+// CreateLimitRange creates a limit range
+func CreateLimitRange(name string) *corev1.LimitRange {
+	return &corev1.LimitRange{
+		TypeMeta:   genTypeMeta(gvk.LimitRange),
+		ObjectMeta: genObjectMeta(name, true),
+	}
+}
+
 // CreateNamespace creates a namespace
 func CreateNamespace(name string) *corev1.Namespace {
 	return &corev1.Namespace{
@@ -261,6 +305,14 @@ func CreatePodMetrics(name string, options ...PodMetricOption) *metricsv1beta1.P
 	return m
 }
 
+// CreatePodDisruptionBudget creates a pod disruption budget
+func CreatePodDisruptionBudget(name string) *policyv1beta1.PodDisruptionBudget {
+	return &policyv1beta1.PodDisruptionBudget{
+		TypeMeta:   genTypeMeta(gvk.PodDisruptionBudget),
+		ObjectMeta: genObjectMeta(name, true),
+	}
+}
+
 // CreateReplicationController creates a replication controller
 func CreateReplicationController(name string) *corev1.ReplicationController {
 	return &corev1.ReplicationController{
@@ -285,6 +337,14 @@ func CreateExtReplicaSet(name string) *extv1beta1.ReplicaSet {
 	}
 }
 
+// CreateResourceQuota creates a resource quota
+func CreateResourceQuota(name string) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		TypeMeta:   genTypeMeta(gvk.ResourceQuota),
+		ObjectMeta: genObjectMeta(name, true),
+	}
+}
+
 // CreateSecret creates a secret
 func CreateSecret(name string, options ...func(*corev1.Secret)) *corev1.Secret {
 	s := &corev1.Secret{
@@ -329,6 +389,15 @@ func CreateStatefulSet(name string) *appsv1.StatefulSet {
 	}
 }
 
+// CreateStorageClass creates a storage class
+func CreateStorageClass(name string) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		TypeMeta:    genTypeMeta(gvk.StorageClass),
+		ObjectMeta:  genObjectMeta(name, false),
+		Provisioner: "kubernetes.io/no-provisioner",
+	}
+}
+
 // CreatePersistentVolumeClaim creates a persistent volume claim
 func CreatePersistentVolumeClaim(name string) *corev1.PersistentVolumeClaim {
 	storageClass := "manual"
@@ -481,6 +550,21 @@ func CreateValidatingWebhookConfiguration(name string) *admissionregistrationv1b
 	}
 }
 
+// CreateVolumeAttachment creates a volume attachment
+func CreateVolumeAttachment(name, persistentVolumeName string) *storagev1.VolumeAttachment {
+	return &storagev1.VolumeAttachment{
+		TypeMeta:   genTypeMeta(gvk.VolumeAttachment),
+		ObjectMeta: genObjectMeta(name, false),
+		Spec: storagev1.VolumeAttachmentSpec{
+			Attacher: "csi.example.com",
+			NodeName: "node",
+			Source: storagev1.VolumeAttachmentSource{
+				PersistentVolumeName: &persistentVolumeName,
+			},
+		},
+	}
+}
+
 func CreateTimestamp() *metav1.Time {
 	return &metav1.Time{
 		Time: Time(),
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package diff
+
+import "strings"
+
+// maxLCSCells bounds the size of the table used to find the longest common
+// subsequence of the lines which differ. Larger changes are shown as every
+// line being removed and added, so large inputs can't hang a diff.
+const maxLCSCells = 1 << 22
+
+// Lines diffs two sets of lines using their longest common subsequence.
+// Lines only in a are prefixed with `- `, lines only in b are prefixed
+// with `+ ` and common lines are prefixed with two spaces.
+func Lines(a, b []string) []string {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	out := make([]string, 0, len(a)+len(b)-prefix-suffix)
+	for _, line := range a[:prefix] {
+		out = append(out, "  "+line)
+	}
+
+	out = append(out, lcsLines(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+
+	for _, line := range a[len(a)-suffix:] {
+		out = append(out, "  "+line)
+	}
+
+	return out
+}
+
+// lcsLines diffs lines using their longest common subsequence.
+func lcsLines(a, b []string) []string {
+	var out []string
+
+	if len(a)*len(b) > maxLCSCells {
+		for _, line := range a {
+			out = append(out, "- "+line)
+		}
+		for _, line := range b {
+			out = append(out, "+ "+line)
+		}
+		return out
+	}
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		out = append(out, "- "+a[i])
+	}
+
+	for ; j < len(b); j++ {
+		out = append(out, "+ "+b[j])
+	}
+
+	return out
+}
+
+// Text diffs two blocks of text line by line.
+func Text(a, b string) string {
+	return strings.Join(Lines(SplitLines(a), SplitLines(b)), "\n")
+}
+
+// Changed returns true if a diff created by Lines contains changes.
+func Changed(lines []string) bool {
+	for _, line := range lines {
+		if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "+ ") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SplitLines splits text into lines. A trailing newline does not create an
+// empty line.
+func SplitLines(s string) []string {
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package diff
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLines(t *testing.T) {
+	cases := []struct {
+		name     string
+		a        []string
+		b        []string
+		expected []string
+	}{
+		{
+			name:     "equal",
+			a:        []string{"a", "b"},
+			b:        []string{"a", "b"},
+			expected: []string{"  a", "  b"},
+		},
+		{
+			name:     "changed line",
+			a:        []string{"a", "b", "c"},
+			b:        []string{"a", "x", "c"},
+			expected: []string{"  a", "- b", "+ x", "  c"},
+		},
+		{
+			name:     "added lines",
+			a:        nil,
+			b:        []string{"a", "b"},
+			expected: []string{"+ a", "+ b"},
+		},
+		{
+			name:     "removed lines",
+			a:        []string{"a", "b"},
+			b:        []string{"a"},
+			expected: []string{"  a", "- b"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := Lines(tc.a, tc.b)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestLines_large(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 3000; i++ {
+		a = append(a, fmt.Sprintf("a%d", i))
+		b = append(b, fmt.Sprintf("b%d", i))
+	}
+	a = append([]string{"first"}, append(a, "last")...)
+	b = append([]string{"first"}, append(b, "last")...)
+
+	actual := Lines(a, b)
+	require.Len(t, actual, 6002)
+	assert.Equal(t, "  first", actual[0])
+	assert.Equal(t, "- a0", actual[1])
+	assert.Equal(t, "+ b0", actual[3001])
+	assert.Equal(t, "  last", actual[6001])
+}
+
+func TestText(t *testing.T) {
+	actual := Text("a: 1\nb: 2\n", "a: 1\nb: 3\n")
+	assert.Equal(t, "  a: 1\n- b: 2\n+ b: 3", actual)
+}
+
+func TestChanged(t *testing.T) {
+	assert.False(t, Changed([]string{"  a", "  b"}))
+	assert.True(t, Changed([]string{"  a", "+ b"}))
+}
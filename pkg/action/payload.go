@@ -108,6 +108,21 @@ func (p Payload) Bool(key string) (bool, error) {
 	return s, nil
 }
 
+// OptionalBool returns a bool from the payload. If the bool does not
+// exist or is null, e.g. from an unchecked checkbox, it returns false.
+func (p Payload) OptionalBool(key string) (bool, error) {
+	if p[key] == nil {
+		return false, nil
+	}
+
+	b, _, err := unstructured.NestedBool(p, key)
+	if err != nil {
+		return false, err
+	}
+
+	return b, nil
+}
+
 // OptionalString returns a string from the payload. If the string
 // does not exist, it returns an empty string.
 func (p Payload) OptionalString(key string) (string, error) {
@@ -87,6 +87,53 @@ func TestPayload_String(t *testing.T) {
 	}
 }
 
+func TestPayload_OptionalBool(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  Payload
+		key      string
+		expected bool
+		isErr    bool
+	}{
+		{
+			name:     "valid",
+			payload:  Payload{"bool": true},
+			key:      "bool",
+			expected: true,
+		},
+		{
+			name:    "not bool",
+			payload: Payload{"bool": "true"},
+			key:     "bool",
+			isErr:   true,
+		},
+		{
+			name:     "key does not exist",
+			payload:  Payload{"bool": true},
+			key:      "invalid",
+			expected: false,
+		},
+		{
+			name:     "null",
+			payload:  Payload{"bool": nil},
+			key:      "bool",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.payload.OptionalBool(test.key)
+			if test.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, got)
+		})
+	}
+}
+
 func TestPayload_StringSlice(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -38,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/modules/overview"
 	"github.com/vmware-tanzu/octant/internal/modules/workloads"
 	"github.com/vmware-tanzu/octant/internal/objectstore"
+	internalOctant "github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
@@ -61,6 +62,7 @@ type Options struct {
 	ClientBurst            int
 	UserAgent              string
 	BuildInfo              config.BuildInfo
+	TerminalRecordingDir   string
 }
 
 type Runner struct {
@@ -255,6 +257,7 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, options Options
 		PortForwarder:      portForwarder,
 		NamespaceInterface: nsClient,
 		FrontendProxy:      frontendProxy,
+		CommandExecutor:    internalOctant.NewExecCommand(clusterClient),
 	}
 
 	pluginManager, err := initPlugin(moduleManager, r.actionManager, pluginDashboardService)
@@ -283,7 +286,8 @@ func (r *Runner) initAPI(ctx context.Context, logger log.Logger, options Options
 		portForwarder,
 		options.Context,
 		restConfigOptions,
-		buildInfo)
+		buildInfo,
+		options.TerminalRecordingDir)
 
 	if err := watchConfigs(ctx, dashConfig, options.KubeConfig); err != nil {
 		return nil, nil, fmt.Errorf("set up config watcher: %w", err)
@@ -333,7 +337,12 @@ func initObjectStore(ctx context.Context, client cluster.ClientInterface) (store
 }
 
 func initPortForwarder(ctx context.Context, client cluster.ClientInterface, appObjectStore store.Store) (portforward.PortForwarder, error) {
-	return portforward.Default(ctx, client, appObjectStore)
+	profilePath := ""
+	if home := plugin.DefaultConfig.Home(); home != "" {
+		profilePath = filepath.Join(plugin.DefaultConfig.ConfigDir(home), portforward.ProfileFile)
+	}
+
+	return portforward.Default(ctx, client, appObjectStore, profilePath)
 }
 
 type moduleOptions struct {
@@ -29,8 +29,9 @@ const (
 	ClusterOverviewNode               = "node"
 	ClusterOverviewPersistentVolume   = "pv"
 
-	Configuration       = "cog"
-	ConfigurationPlugin = "plugin"
+	Configuration                   = "cog"
+	ConfigurationPlugin             = "plugin"
+	ConfigurationTerminalRecordings = "terminal"
 
 	CustomResourceDefinition = "dna"
 )
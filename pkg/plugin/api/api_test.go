@@ -7,6 +7,7 @@ package api_test
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"testing"
 	"time"
@@ -14,8 +15,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/octant"
+	octantFake "github.com/vmware-tanzu/octant/internal/octant/fake"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	portForwardFake "github.com/vmware-tanzu/octant/internal/portforward/fake"
 	"github.com/vmware-tanzu/octant/internal/testutil"
@@ -27,6 +33,7 @@ import (
 type apiMocks struct {
 	objectStore *storeFake.MockStore
 	pf          *portForwardFake.MockPortForwarder
+	exec        *octantFake.MockCommandExecutor
 }
 
 func TestAPI(t *testing.T) {
@@ -48,6 +55,13 @@ func TestAPI(t *testing.T) {
 	}
 	object := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
 
+	watchKey := store.Key{
+		Namespace:  testutil.DefaultNamespace,
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "deployment",
+	}
+
 	pfRequest := api.PortForwardRequest{
 		Namespace: "default",
 		PodName:   "pod",
@@ -178,6 +192,109 @@ func TestAPI(t *testing.T) {
 
 				expected := pfResponse
 
+				assert.Equal(t, expected, got)
+			},
+		},
+		{
+			name: "delete",
+			initFunc: func(t *testing.T, mocks *apiMocks) {
+				mocks.objectStore.EXPECT().
+					Delete(gomock.Any(), gomock.Eq(getKey)).Return(nil)
+			},
+			doFunc: func(t *testing.T, client *api.Client) {
+				clientCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+				defer cancel()
+
+				err := client.Delete(clientCtx, getKey)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "watch",
+			initFunc: func(t *testing.T, mocks *apiMocks) {
+				other := testutil.ToUnstructured(t, testutil.CreateDeployment("other"))
+
+				mocks.objectStore.EXPECT().
+					List(gomock.Any(), gomock.Eq(watchKey)).
+					Return(&unstructured.UnstructuredList{}, false, nil)
+				mocks.objectStore.EXPECT().
+					Watch(gomock.Any(), gomock.Eq(watchKey), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) error {
+						go func() {
+							handler.OnAdd(other)
+							handler.OnAdd(object)
+							handler.OnUpdate(object, object)
+							handler.OnDelete(kcache.DeletedFinalStateUnknown{Key: "default/deployment", Obj: object})
+						}()
+						return nil
+					})
+			},
+			doFunc: func(t *testing.T, client *api.Client) {
+				clientCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+				defer cancel()
+
+				events, err := client.Watch(clientCtx, watchKey)
+				require.NoError(t, err)
+
+				var got []api.WatchEventType
+				for event := range events {
+					assert.Equal(t, object, event.Object)
+					got = append(got, event.Type)
+					if len(got) == 3 {
+						break
+					}
+				}
+
+				expected := []api.WatchEventType{
+					api.WatchEventAdded,
+					api.WatchEventModified,
+					api.WatchEventDeleted,
+				}
+
+				assert.Equal(t, expected, got)
+			},
+		},
+		{
+			name: "exec command",
+			initFunc: func(t *testing.T, mocks *apiMocks) {
+				req := octant.ExecCommandRequest{
+					Namespace:     "default",
+					PodName:       "pod",
+					ContainerName: "app",
+					Command:       []string{"cat", "/healthz"},
+					Timeout:       5 * time.Second,
+				}
+				result := octant.ExecCommandResult{
+					Stdout:   "ok",
+					Stderr:   "warning",
+					ExitCode: 2,
+				}
+
+				mocks.exec.EXPECT().
+					ExecCommand(gomock.Any(), req).
+					Return(result, nil)
+			},
+			doFunc: func(t *testing.T, client *api.Client) {
+				clientCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+				defer cancel()
+
+				req := api.ExecCommandRequest{
+					Namespace:     "default",
+					PodName:       "pod",
+					ContainerName: "app",
+					Command:       []string{"cat", "/healthz"},
+					Timeout:       5 * time.Second,
+				}
+
+				got, err := client.ExecCommand(clientCtx, req)
+				require.NoError(t, err)
+
+				expected := api.ExecCommandResponse{
+					Stdout:   "ok",
+					Stderr:   "warning",
+					ExitCode: 2,
+				}
+
 				assert.Equal(t, expected, got)
 			},
 		},
@@ -190,13 +307,16 @@ func TestAPI(t *testing.T) {
 
 			appObjectStore := storeFake.NewMockStore(controller)
 			pf := portForwardFake.NewMockPortForwarder(controller)
+			commandExecutor := octantFake.NewMockCommandExecutor(controller)
 			tc.initFunc(t, &apiMocks{
 				objectStore: appObjectStore,
-				pf:          pf})
+				pf:          pf,
+				exec:        commandExecutor})
 
 			service := &api.GRPCService{
-				ObjectStore:   appObjectStore,
-				PortForwarder: pf,
+				ObjectStore:     appObjectStore,
+				PortForwarder:   pf,
+				CommandExecutor: commandExecutor,
 			}
 
 			a, err := api.New(service)
@@ -227,3 +347,110 @@ func checkPort(t *testing.T, isListen bool, addr string) {
 
 	require.NoError(t, err)
 }
+
+func TestGRPCService_Watch_slowConsumer(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "apps/v1", Kind: "Deployment"}
+	object := testutil.ToUnstructured(t, testutil.CreateDeployment("deployment"))
+
+	handlers := make(chan kcache.ResourceEventHandler, 1)
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(&unstructured.UnstructuredList{}, false, nil)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) error {
+			handlers <- handler
+			return nil
+		})
+
+	service := &api.GRPCService{ObjectStore: objectStore}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := service.Watch(ctx, key)
+	require.NoError(t, err)
+
+	handler := <-handlers
+
+	sent := make(chan struct{})
+	go func() {
+		// None of the events are read, so the watcher must not block the informer.
+		for i := 0; i < 1000; i++ {
+			handler.OnUpdate(object, object)
+		}
+		close(sent)
+	}()
+
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch blocked the informer")
+	}
+
+	count := 0
+	for range events {
+		count++
+	}
+	assert.Less(t, count, 1000)
+}
+
+func TestGRPCService_Watch_initialList(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	key := store.Key{Namespace: testutil.DefaultNamespace, APIVersion: "apps/v1", Kind: "Deployment"}
+
+	// More objects than the watcher buffers events for.
+	list := &unstructured.UnstructuredList{}
+	for i := 0; i < 300; i++ {
+		deployment := testutil.CreateDeployment(fmt.Sprintf("deployment-%d", i))
+		deployment.UID = types.UID(deployment.Name)
+		deployment.ResourceVersion = "1"
+		list.Items = append(list.Items, *testutil.ToUnstructured(t, deployment))
+	}
+	updated := list.Items[0].DeepCopy()
+	updated.SetResourceVersion("2")
+
+	objectStore := storeFake.NewMockStore(controller)
+	objectStore.EXPECT().
+		List(gomock.Any(), key).
+		Return(list, false, nil)
+	objectStore.EXPECT().
+		Watch(gomock.Any(), key, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, key store.Key, handler kcache.ResourceEventHandler) error {
+			// The object store sends the objects in its cache to a new handler.
+			for i := range list.Items {
+				handler.OnAdd(&list.Items[i])
+			}
+			handler.OnUpdate(&list.Items[0], updated)
+			return nil
+		})
+
+	service := &api.GRPCService{ObjectStore: objectStore}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := service.Watch(ctx, key)
+	require.NoError(t, err)
+
+	for i := range list.Items {
+		event := <-events
+		assert.Equal(t, api.WatchEventAdded, event.Type)
+		assert.Equal(t, list.Items[i].GetName(), event.Object.GetName())
+	}
+
+	event := <-events
+	assert.Equal(t, api.WatchEventModified, event.Type)
+	assert.Equal(t, "2", event.Object.GetResourceVersion())
+
+	cancel()
+	for range events {
+	}
+}
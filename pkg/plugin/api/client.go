@@ -7,6 +7,8 @@ package api
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -156,6 +158,74 @@ func (c *Client) Create(ctx context.Context, object *unstructured.Unstructured)
 
 }
 
+// Delete deletes an object from the cluster.
+func (c *Client) Delete(ctx context.Context, key store.Key) error {
+	client := c.DashboardConnection.Client()
+
+	keyRequest, err := convertFromKey(key)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Delete(ctx, keyRequest)
+	return err
+}
+
+// Watch watches objects which match a key. Objects which exist when the
+// watch starts are sent as added events. The channel is closed when the
+// context is cancelled or the stream ends.
+func (c *Client) Watch(ctx context.Context, key store.Key) (<-chan WatchEvent, error) {
+	client := c.DashboardConnection.Client()
+
+	keyRequest, err := convertFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := client.Watch(ctx, keyRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan WatchEvent, watchEventBufferSize)
+
+	go func() {
+		defer close(events)
+
+		logger := log.From(ctx)
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF || ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				logger.WithErr(err).Errorf("watch %s", key)
+				return
+			}
+
+			object, err := convertToObject(resp.Object)
+			if err != nil {
+				logger.WithErr(err).Errorf("convert watched object")
+				continue
+			}
+
+			event := WatchEvent{
+				Type:   WatchEventType(resp.Type),
+				Object: object,
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
 // PortForward creates a port forward.
 func (c *Client) PortForward(ctx context.Context, req PortForwardRequest) (PortForwardResponse, error) {
 	client := c.DashboardConnection.Client()
@@ -213,3 +283,31 @@ func (c *Client) ForceFrontendUpdate(ctx context.Context) error {
 	_, err := client.ForceFrontendUpdate(ctx, &proto.Empty{})
 	return err
 }
+
+// ExecCommand runs a command in a container and returns its output.
+func (c *Client) ExecCommand(ctx context.Context, req ExecCommandRequest) (ExecCommandResponse, error) {
+	client := c.DashboardConnection.Client()
+
+	// Timeouts are sent in whole seconds, so round up.
+	timeoutSeconds := int64((req.Timeout + time.Second - 1) / time.Second)
+
+	execRequest := &proto.ExecCommandRequest{
+		Namespace:      req.Namespace,
+		PodName:        req.PodName,
+		ContainerName:  req.ContainerName,
+		Command:        req.Command,
+		TimeoutSeconds: timeoutSeconds,
+	}
+
+	resp, err := client.ExecCommand(ctx, execRequest)
+	if err != nil {
+		return ExecCommandResponse{}, err
+	}
+
+	return ExecCommandResponse{
+		Stdout:   string(resp.Stdout),
+		Stderr:   string(resp.Stderr),
+		ExitCode: int(resp.ExitCode),
+		TimedOut: resp.TimedOut,
+	}, nil
+}
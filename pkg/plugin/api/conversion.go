@@ -7,6 +7,7 @@ package api
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
@@ -132,3 +133,21 @@ func convertToPortForwardRequest(in *proto.PortForwardRequest) (*PortForwardRequ
 		Port:      uint16(port),
 	}, nil
 }
+
+func convertToExecCommandRequest(in *proto.ExecCommandRequest) (*ExecCommandRequest, error) {
+	if in == nil {
+		return nil, errors.New("can't convert nil object")
+	}
+
+	if in.TimeoutSeconds < 0 {
+		return nil, errors.Errorf("timeout must not be negative; it was: %d", in.TimeoutSeconds)
+	}
+
+	return &ExecCommandRequest{
+		Namespace:     in.Namespace,
+		PodName:       in.PodName,
+		ContainerName: in.ContainerName,
+		Command:       in.Command,
+		Timeout:       time.Duration(in.TimeoutSeconds) * time.Second,
+	}, nil
+}
@@ -64,6 +64,35 @@ func (mr *MockServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), arg0, arg1)
 }
 
+// Delete mocks base method
+func (m *MockService) Delete(arg0 context.Context, arg1 store.Key) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockServiceMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), arg0, arg1)
+}
+
+// ExecCommand mocks base method
+func (m *MockService) ExecCommand(arg0 context.Context, arg1 api.ExecCommandRequest) (api.ExecCommandResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecCommand", arg0, arg1)
+	ret0, _ := ret[0].(api.ExecCommandResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecCommand indicates an expected call of ExecCommand
+func (mr *MockServiceMockRecorder) ExecCommand(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecCommand", reflect.TypeOf((*MockService)(nil).ExecCommand), arg0, arg1)
+}
+
 // ForceFrontendUpdate mocks base method
 func (m *MockService) ForceFrontendUpdate(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -151,3 +180,18 @@ func (mr *MockServiceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), arg0, arg1)
 }
+
+// Watch mocks base method
+func (m *MockService) Watch(arg0 context.Context, arg1 store.Key) (<-chan api.WatchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1)
+	ret0, _ := ret[0].(<-chan api.WatchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockServiceMockRecorder) Watch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockService)(nil).Watch), arg0, arg1)
+}
@@ -77,6 +77,46 @@ func (mr *MockDashboardClientMockRecorder) Create(arg0, arg1 interface{}, arg2 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDashboardClient)(nil).Create), varargs...)
 }
 
+// Delete mocks base method
+func (m *MockDashboardClient) Delete(arg0 context.Context, arg1 *proto.KeyRequest, arg2 ...grpc.CallOption) (*proto.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(*proto.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockDashboardClientMockRecorder) Delete(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDashboardClient)(nil).Delete), varargs...)
+}
+
+// ExecCommand mocks base method
+func (m *MockDashboardClient) ExecCommand(arg0 context.Context, arg1 *proto.ExecCommandRequest, arg2 ...grpc.CallOption) (*proto.ExecCommandResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecCommand", varargs...)
+	ret0, _ := ret[0].(*proto.ExecCommandResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecCommand indicates an expected call of ExecCommand
+func (mr *MockDashboardClientMockRecorder) ExecCommand(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecCommand", reflect.TypeOf((*MockDashboardClient)(nil).ExecCommand), varargs...)
+}
+
 // ForceFrontendUpdate mocks base method
 func (m *MockDashboardClient) ForceFrontendUpdate(arg0 context.Context, arg1 *proto.Empty, arg2 ...grpc.CallOption) (*proto.Empty, error) {
 	m.ctrl.T.Helper()
@@ -196,3 +236,23 @@ func (mr *MockDashboardClientMockRecorder) Update(arg0, arg1 interface{}, arg2 .
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDashboardClient)(nil).Update), varargs...)
 }
+
+// Watch mocks base method
+func (m *MockDashboardClient) Watch(arg0 context.Context, arg1 *proto.KeyRequest, arg2 ...grpc.CallOption) (proto.Dashboard_WatchClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(proto.Dashboard_WatchClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockDashboardClientMockRecorder) Watch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockDashboardClient)(nil).Watch), varargs...)
+}
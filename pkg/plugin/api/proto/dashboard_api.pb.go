@@ -534,6 +534,187 @@ func (m *NamespacesResponse) GetNamespaces() []string {
 	return nil
 }
 
+type WatchEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Object               []byte   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b9012dddebf2b7c, []int{12}
+}
+
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+}
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetObject() []byte {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type ExecCommandRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName              string   `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string   `protobuf:"bytes,3,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Command              []string `protobuf:"bytes,4,rep,name=command,proto3" json:"command,omitempty"`
+	TimeoutSeconds       int64    `protobuf:"varint,5,opt,name=timeoutSeconds,proto3" json:"timeoutSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecCommandRequest) Reset()         { *m = ExecCommandRequest{} }
+func (m *ExecCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*ExecCommandRequest) ProtoMessage()    {}
+func (*ExecCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b9012dddebf2b7c, []int{13}
+}
+
+func (m *ExecCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecCommandRequest.Unmarshal(m, b)
+}
+func (m *ExecCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *ExecCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecCommandRequest.Merge(m, src)
+}
+func (m *ExecCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_ExecCommandRequest.Size(m)
+}
+func (m *ExecCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecCommandRequest proto.InternalMessageInfo
+
+func (m *ExecCommandRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ExecCommandRequest) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *ExecCommandRequest) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *ExecCommandRequest) GetCommand() []string {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ExecCommandRequest) GetTimeoutSeconds() int64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type ExecCommandResponse struct {
+	Stdout               []byte   `protobuf:"bytes,1,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr               []byte   `protobuf:"bytes,2,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ExitCode             int32    `protobuf:"varint,3,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	TimedOut             bool     `protobuf:"varint,4,opt,name=timedOut,proto3" json:"timedOut,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExecCommandResponse) Reset()         { *m = ExecCommandResponse{} }
+func (m *ExecCommandResponse) String() string { return proto.CompactTextString(m) }
+func (*ExecCommandResponse) ProtoMessage()    {}
+func (*ExecCommandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b9012dddebf2b7c, []int{14}
+}
+
+func (m *ExecCommandResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExecCommandResponse.Unmarshal(m, b)
+}
+func (m *ExecCommandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExecCommandResponse.Marshal(b, m, deterministic)
+}
+func (m *ExecCommandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecCommandResponse.Merge(m, src)
+}
+func (m *ExecCommandResponse) XXX_Size() int {
+	return xxx_messageInfo_ExecCommandResponse.Size(m)
+}
+func (m *ExecCommandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecCommandResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecCommandResponse proto.InternalMessageInfo
+
+func (m *ExecCommandResponse) GetStdout() []byte {
+	if m != nil {
+		return m.Stdout
+	}
+	return nil
+}
+
+func (m *ExecCommandResponse) GetStderr() []byte {
+	if m != nil {
+		return m.Stderr
+	}
+	return nil
+}
+
+func (m *ExecCommandResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ExecCommandResponse) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Empty)(nil), "proto.Empty")
 	proto.RegisterType((*KeyRequest)(nil), "proto.KeyRequest")
@@ -547,46 +728,59 @@ func init() {
 	proto.RegisterType((*PortForwardResponse)(nil), "proto.PortForwardResponse")
 	proto.RegisterType((*CancelPortForwardRequest)(nil), "proto.CancelPortForwardRequest")
 	proto.RegisterType((*NamespacesResponse)(nil), "proto.NamespacesResponse")
+	proto.RegisterType((*WatchEvent)(nil), "proto.WatchEvent")
+	proto.RegisterType((*ExecCommandRequest)(nil), "proto.ExecCommandRequest")
+	proto.RegisterType((*ExecCommandResponse)(nil), "proto.ExecCommandResponse")
 }
 
 func init() { proto.RegisterFile("dashboard_api.proto", fileDescriptor_3b9012dddebf2b7c) }
 
 var fileDescriptor_3b9012dddebf2b7c = []byte{
-	// 536 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x56, 0xd6, 0x3f, 0xf5, 0xb4, 0x9d, 0x98, 0x0b, 0x28, 0x04, 0x54, 0xaa, 0x68, 0x88, 0x5e,
-	0xa0, 0x4c, 0x0c, 0xb8, 0xe0, 0x0e, 0xb6, 0xd2, 0x09, 0x81, 0x2a, 0x14, 0xc4, 0x6e, 0xb8, 0x40,
-	0x4e, 0x72, 0x18, 0x81, 0x34, 0x36, 0xb6, 0xab, 0xa9, 0xaf, 0xc1, 0xbb, 0xf0, 0x1c, 0xbc, 0x12,
-	0x4a, 0xec, 0x34, 0xf5, 0xda, 0x49, 0xbd, 0x6a, 0xce, 0x77, 0x7e, 0x7c, 0xfc, 0x7d, 0x9f, 0x0b,
-	0xc3, 0x84, 0xca, 0x1f, 0x11, 0xa3, 0x22, 0xf9, 0x46, 0x79, 0x1a, 0x70, 0xc1, 0x14, 0x23, 0xad,
-	0xf2, 0xc7, 0x1b, 0x5d, 0x31, 0x76, 0x95, 0xe1, 0x49, 0x19, 0x45, 0xcb, 0xef, 0x27, 0xd7, 0x82,
-	0x72, 0x8e, 0x42, 0xea, 0x32, 0xbf, 0x03, 0xad, 0x77, 0x0b, 0xae, 0x56, 0xfe, 0x5f, 0x07, 0xe0,
-	0x03, 0xae, 0x42, 0xfc, 0xbd, 0x44, 0xa9, 0xc8, 0x23, 0xe8, 0xe6, 0x74, 0x81, 0x92, 0xd3, 0x18,
-	0x5d, 0x67, 0xec, 0x4c, 0xba, 0x61, 0x0d, 0x90, 0x11, 0x00, 0xe5, 0xe9, 0x25, 0x0a, 0x99, 0xb2,
-	0xdc, 0x3d, 0x28, 0xd3, 0x1b, 0x08, 0x21, 0xd0, 0xfc, 0x95, 0xe6, 0x89, 0xdb, 0x28, 0x33, 0xe5,
-	0x77, 0x81, 0x15, 0x03, 0xdc, 0xa6, 0xc6, 0x8a, 0x6f, 0xf2, 0x16, 0x06, 0x19, 0x8d, 0x30, 0xfb,
-	0x8c, 0x19, 0xc6, 0x8a, 0x09, 0xb7, 0x35, 0x76, 0x26, 0xbd, 0xd3, 0x87, 0x81, 0xde, 0x3a, 0xa8,
-	0xb6, 0x0e, 0xce, 0x56, 0x0a, 0xe5, 0x25, 0xcd, 0x96, 0x18, 0xda, 0x1d, 0xfe, 0x04, 0xfa, 0x1f,
-	0x53, 0xa9, 0x42, 0x94, 0x9c, 0xe5, 0x12, 0x89, 0x0b, 0x1d, 0x16, 0xfd, 0xc4, 0x58, 0x49, 0xd7,
-	0x19, 0x37, 0x26, 0xfd, 0xb0, 0x0a, 0xfd, 0x27, 0xd0, 0xbb, 0xc0, 0xba, 0xf0, 0x3e, 0xb4, 0x75,
-	0xa6, 0xbc, 0x5e, 0x3f, 0x34, 0x91, 0xff, 0x14, 0x06, 0x5f, 0x78, 0x42, 0x15, 0x56, 0x54, 0xdc,
-	0x56, 0x78, 0x07, 0x0e, 0xab, 0x42, 0x3d, 0xb2, 0x68, 0x3d, 0x17, 0xb8, 0x5f, 0x6b, 0x55, 0x68,
-	0x5a, 0xff, 0x38, 0x40, 0x3e, 0x31, 0xa1, 0x66, 0x4c, 0x5c, 0x53, 0x91, 0xec, 0x27, 0x83, 0x0b,
-	0x1d, 0xce, 0x92, 0x79, 0xc1, 0xaa, 0xd6, 0xa0, 0x0a, 0xc9, 0x31, 0x0c, 0x62, 0x96, 0x2b, 0x9a,
-	0xe6, 0x28, 0xca, 0xbc, 0x56, 0xc2, 0x06, 0x0b, 0x19, 0x39, 0x13, 0x6a, 0xbe, 0x5c, 0x44, 0x28,
-	0x4a, 0x61, 0x06, 0xe1, 0x06, 0xe2, 0x7f, 0x85, 0xa1, 0xb5, 0x93, 0x61, 0xee, 0x18, 0x06, 0xbc,
-	0x86, 0xdf, 0x4f, 0xcd, 0x62, 0x36, 0x78, 0x63, 0xf8, 0xc1, 0xd6, 0xf0, 0x37, 0xe0, 0x9e, 0xd3,
-	0x3c, 0xc6, 0x6c, 0xc7, 0xb5, 0xf7, 0x3a, 0xc1, 0x7f, 0x09, 0x64, 0x5e, 0x71, 0x21, 0xd7, 0xdb,
-	0x8d, 0x00, 0xd6, 0x0c, 0x69, 0x0f, 0x74, 0xc3, 0x0d, 0xe4, 0xf4, 0x5f, 0x03, 0xba, 0xd3, 0xea,
-	0xc1, 0x90, 0x00, 0x9a, 0x85, 0x7d, 0xc8, 0x91, 0xf6, 0x5a, 0x50, 0x3f, 0x01, 0x6f, 0x68, 0x20,
-	0xcb, 0x5e, 0xcf, 0xa0, 0x71, 0x81, 0x3b, 0xcb, 0x89, 0x81, 0x36, 0x3d, 0xf6, 0x0a, 0xda, 0xda,
-	0x22, 0xe4, 0xae, 0xc9, 0x5a, 0xd6, 0xf2, 0xee, 0xdd, 0x40, 0xeb, 0x36, 0x6d, 0x8f, 0x75, 0x9b,
-	0x65, 0xab, 0x75, 0x9b, 0xed, 0x21, 0x32, 0x85, 0xde, 0x06, 0x97, 0xe4, 0x81, 0xa9, 0xda, 0xe6,
-	0xd7, 0xf3, 0x76, 0xa5, 0xcc, 0x94, 0x33, 0x38, 0xda, 0xd2, 0x85, 0x3c, 0xae, 0x4e, 0xbc, 0x45,
-	0x31, 0xaf, 0x6f, 0x0a, 0xca, 0x3f, 0x13, 0xf2, 0x1a, 0x0e, 0x0b, 0xd6, 0x6a, 0x75, 0x88, 0x95,
-	0xf7, 0xaa, 0xd5, 0x76, 0xc8, 0xf7, 0x1c, 0x86, 0x33, 0x26, 0x62, 0x9c, 0x09, 0x96, 0x2b, 0xcc,
-	0x13, 0xc3, 0x9f, 0xdd, 0x6f, 0x45, 0x51, 0xbb, 0x0c, 0x5e, 0xfc, 0x0f, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0xb5, 0x05, 0x66, 0x08, 0x05, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0x9b, 0xbf, 0x66, 0x92, 0x54, 0x74, 0x43, 0x91, 0x31, 0xa8, 0x54, 0x56, 0x81, 0x20,
+	0xa1, 0x14, 0x0a, 0x48, 0x70, 0x83, 0x26, 0x6d, 0x85, 0x40, 0x05, 0xb9, 0xa2, 0x1c, 0x38, 0xa0,
+	0x8d, 0x3d, 0xb4, 0x86, 0xc4, 0x6b, 0xd6, 0x6b, 0xda, 0x1c, 0x78, 0x09, 0x9e, 0x05, 0x1e, 0x8c,
+	0x37, 0x40, 0xde, 0xf5, 0xfa, 0x27, 0x71, 0xa5, 0x9e, 0x38, 0xd9, 0xf3, 0xcd, 0xcc, 0xee, 0x37,
+	0x3f, 0xdf, 0x42, 0xdf, 0xa3, 0xd1, 0xd9, 0x84, 0x51, 0xee, 0x7d, 0xa6, 0xa1, 0x3f, 0x0c, 0x39,
+	0x13, 0x8c, 0x34, 0xe4, 0xc7, 0xda, 0x3c, 0x65, 0xec, 0x74, 0x8a, 0x3b, 0xd2, 0x9a, 0xc4, 0x5f,
+	0x76, 0xce, 0x39, 0x0d, 0x43, 0xe4, 0x91, 0x0a, 0xb3, 0x5b, 0xd0, 0xd8, 0x9f, 0x85, 0x62, 0x6e,
+	0xff, 0x31, 0x00, 0xde, 0xe0, 0xdc, 0xc1, 0xef, 0x31, 0x46, 0x82, 0xdc, 0x86, 0x76, 0x40, 0x67,
+	0x18, 0x85, 0xd4, 0x45, 0xd3, 0xd8, 0x32, 0x06, 0x6d, 0x27, 0x07, 0xc8, 0x26, 0x00, 0x0d, 0xfd,
+	0x13, 0xe4, 0x91, 0xcf, 0x02, 0x73, 0x45, 0xba, 0x0b, 0x08, 0x21, 0x50, 0xff, 0xe6, 0x07, 0x9e,
+	0x59, 0x93, 0x1e, 0xf9, 0x9f, 0x60, 0xc9, 0x01, 0x66, 0x5d, 0x61, 0xc9, 0x3f, 0x79, 0x05, 0xbd,
+	0x29, 0x9d, 0xe0, 0xf4, 0x18, 0xa7, 0xe8, 0x0a, 0xc6, 0xcd, 0xc6, 0x96, 0x31, 0xe8, 0xec, 0xde,
+	0x1a, 0x2a, 0xd6, 0x43, 0xcd, 0x7a, 0xb8, 0x37, 0x17, 0x18, 0x9d, 0xd0, 0x69, 0x8c, 0x4e, 0x39,
+	0xc3, 0x1e, 0x40, 0xf7, 0xad, 0x1f, 0x09, 0x07, 0xa3, 0x90, 0x05, 0x11, 0x12, 0x13, 0x5a, 0x6c,
+	0xf2, 0x15, 0x5d, 0x11, 0x99, 0xc6, 0x56, 0x6d, 0xd0, 0x75, 0xb4, 0x69, 0xdf, 0x85, 0xce, 0x21,
+	0xe6, 0x81, 0x37, 0xa0, 0xa9, 0x3c, 0xb2, 0xbc, 0xae, 0x93, 0x5a, 0xf6, 0x7d, 0xe8, 0x7d, 0x08,
+	0x3d, 0x2a, 0x50, 0xb7, 0xe2, 0xb2, 0xc0, 0x6b, 0xb0, 0xa6, 0x03, 0xd5, 0x91, 0x49, 0xea, 0x88,
+	0xe3, 0xd5, 0x52, 0x75, 0x60, 0x9a, 0xfa, 0xcb, 0x00, 0xf2, 0x9e, 0x71, 0x71, 0xc0, 0xf8, 0x39,
+	0xe5, 0xde, 0xd5, 0xc6, 0x60, 0x42, 0x2b, 0x64, 0xde, 0x51, 0xd2, 0x55, 0x35, 0x03, 0x6d, 0x92,
+	0x6d, 0xe8, 0xb9, 0x2c, 0x10, 0xd4, 0x0f, 0x90, 0x4b, 0xbf, 0x9a, 0x44, 0x19, 0x4c, 0xc6, 0x18,
+	0x32, 0x2e, 0x8e, 0xe2, 0xd9, 0x04, 0xb9, 0x1c, 0x4c, 0xcf, 0x29, 0x20, 0xf6, 0x27, 0xe8, 0x97,
+	0x38, 0xa5, 0x9d, 0xdb, 0x86, 0x5e, 0x98, 0xc3, 0xaf, 0xc7, 0x29, 0xb1, 0x32, 0xb8, 0x70, 0xf8,
+	0xca, 0xd2, 0xe1, 0x2f, 0xc1, 0x1c, 0xd1, 0xc0, 0xc5, 0x69, 0x45, 0xd9, 0x57, 0xba, 0xc1, 0x7e,
+	0x0a, 0xe4, 0x48, 0xf7, 0x22, 0xca, 0xd8, 0x6d, 0x02, 0x64, 0x1d, 0x52, 0x3b, 0xd0, 0x76, 0x0a,
+	0x88, 0xfd, 0x1c, 0xe0, 0x23, 0x15, 0xee, 0xd9, 0xfe, 0x0f, 0x0c, 0x44, 0xb2, 0x95, 0x62, 0x1e,
+	0xea, 0xde, 0xca, 0xff, 0xc2, 0xd4, 0x56, 0x4a, 0x53, 0xfb, 0x6d, 0x00, 0xd9, 0xbf, 0x40, 0x77,
+	0xc4, 0x66, 0x33, 0x1a, 0xfc, 0xa7, 0x19, 0x99, 0xd0, 0x72, 0xd5, 0x7d, 0x66, 0x5d, 0xd6, 0xa2,
+	0x4d, 0x72, 0x0f, 0xd6, 0x84, 0x3f, 0x43, 0x16, 0x8b, 0x63, 0x74, 0x59, 0xe0, 0x45, 0x52, 0x3d,
+	0x35, 0x67, 0x01, 0xb5, 0x7f, 0x42, 0xbf, 0xc4, 0x3a, 0xdf, 0xff, 0x48, 0x78, 0x2c, 0xce, 0x76,
+	0x53, 0x59, 0x29, 0x8e, 0x9c, 0xeb, 0xea, 0x95, 0x45, 0x2c, 0x58, 0xc5, 0x0b, 0x5f, 0x8c, 0x98,
+	0xa7, 0x98, 0x36, 0x9c, 0xcc, 0x4e, 0x7c, 0xc9, 0xa5, 0xde, 0xbb, 0x58, 0xc8, 0x35, 0x5a, 0x75,
+	0x32, 0x7b, 0xf7, 0x6f, 0x1d, 0xda, 0x63, 0xfd, 0x40, 0x91, 0x21, 0xd4, 0x13, 0xb9, 0x92, 0x75,
+	0xa5, 0xed, 0x61, 0xfe, 0xe4, 0x58, 0xfd, 0x14, 0x2a, 0xc9, 0xf9, 0x21, 0xd4, 0x0e, 0xb1, 0x32,
+	0x9c, 0xa4, 0x50, 0x51, 0xd3, 0xcf, 0xa0, 0xa9, 0x24, 0x49, 0xae, 0xa7, 0xde, 0x92, 0x94, 0xad,
+	0x8d, 0x05, 0x34, 0x4f, 0x53, 0x72, 0xcc, 0xd2, 0x4a, 0x32, 0xb6, 0x36, 0x16, 0xd0, 0x34, 0xed,
+	0x01, 0x34, 0xc7, 0x38, 0x45, 0x81, 0x55, 0xf4, 0xba, 0x29, 0x24, 0x5f, 0x57, 0xb2, 0x03, 0x0d,
+	0xb9, 0x74, 0x55, 0x91, 0x1a, 0xca, 0xb7, 0xf2, 0x91, 0x41, 0xc6, 0xd0, 0x29, 0xe8, 0x82, 0xdc,
+	0x4c, 0x63, 0x96, 0xb5, 0x62, 0x59, 0x55, 0xae, 0x94, 0xe1, 0x1e, 0xac, 0x2f, 0x69, 0x8c, 0xdc,
+	0xd1, 0xd5, 0x5c, 0xa2, 0xbe, 0x05, 0xea, 0x2f, 0x60, 0x2d, 0x99, 0x48, 0xae, 0x34, 0x52, 0xf2,
+	0x5b, 0x9a, 0x5a, 0x85, 0x14, 0x1f, 0x43, 0xff, 0x80, 0x71, 0x17, 0x0f, 0x38, 0x0b, 0x04, 0x06,
+	0x5e, 0x3a, 0x9b, 0x72, 0x7e, 0xf9, 0xb6, 0x31, 0x74, 0x0a, 0xcb, 0x9a, 0xd5, 0xbd, 0x2c, 0x3b,
+	0xcb, 0xaa, 0x72, 0xa9, 0x8b, 0x27, 0x4d, 0xe9, 0x7a, 0xf2, 0x6f, 0x00, 0x00, 0x18, 0x7a, 0xc1,
+	0x1a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -605,10 +799,13 @@ type DashboardClient interface {
 	Get(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
+	Delete(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*Empty, error)
+	Watch(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (Dashboard_WatchClient, error)
 	PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error)
 	CancelPortForward(ctx context.Context, in *CancelPortForwardRequest, opts ...grpc.CallOption) (*Empty, error)
 	ListNamespaces(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NamespacesResponse, error)
 	ForceFrontendUpdate(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	ExecCommand(ctx context.Context, in *ExecCommandRequest, opts ...grpc.CallOption) (*ExecCommandResponse, error)
 }
 
 type dashboardClient struct {
@@ -655,6 +852,47 @@ func (c *dashboardClient) Create(ctx context.Context, in *CreateRequest, opts ..
 	return out, nil
 }
 
+func (c *dashboardClient) Delete(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.Dashboard/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) Watch(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (Dashboard_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[0], "/proto.Dashboard/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dashboardWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dashboard_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type dashboardWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *dashboardWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *dashboardClient) PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error) {
 	out := new(PortForwardResponse)
 	err := c.cc.Invoke(ctx, "/proto.Dashboard/PortForward", in, out, opts...)
@@ -691,16 +929,28 @@ func (c *dashboardClient) ForceFrontendUpdate(ctx context.Context, in *Empty, op
 	return out, nil
 }
 
+func (c *dashboardClient) ExecCommand(ctx context.Context, in *ExecCommandRequest, opts ...grpc.CallOption) (*ExecCommandResponse, error) {
+	out := new(ExecCommandResponse)
+	err := c.cc.Invoke(ctx, "/proto.Dashboard/ExecCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DashboardServer is the server API for Dashboard service.
 type DashboardServer interface {
 	List(context.Context, *KeyRequest) (*ListResponse, error)
 	Get(context.Context, *KeyRequest) (*GetResponse, error)
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
+	Delete(context.Context, *KeyRequest) (*Empty, error)
+	Watch(*KeyRequest, Dashboard_WatchServer) error
 	PortForward(context.Context, *PortForwardRequest) (*PortForwardResponse, error)
 	CancelPortForward(context.Context, *CancelPortForwardRequest) (*Empty, error)
 	ListNamespaces(context.Context, *Empty) (*NamespacesResponse, error)
 	ForceFrontendUpdate(context.Context, *Empty) (*Empty, error)
+	ExecCommand(context.Context, *ExecCommandRequest) (*ExecCommandResponse, error)
 }
 
 // UnimplementedDashboardServer can be embedded to have forward compatible implementations.
@@ -719,6 +969,12 @@ func (*UnimplementedDashboardServer) Update(ctx context.Context, req *UpdateRequ
 func (*UnimplementedDashboardServer) Create(ctx context.Context, req *CreateRequest) (*CreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (*UnimplementedDashboardServer) Delete(ctx context.Context, req *KeyRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedDashboardServer) Watch(req *KeyRequest, srv Dashboard_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (*UnimplementedDashboardServer) PortForward(ctx context.Context, req *PortForwardRequest) (*PortForwardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PortForward not implemented")
 }
@@ -731,6 +987,9 @@ func (*UnimplementedDashboardServer) ListNamespaces(ctx context.Context, req *Em
 func (*UnimplementedDashboardServer) ForceFrontendUpdate(ctx context.Context, req *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceFrontendUpdate not implemented")
 }
+func (*UnimplementedDashboardServer) ExecCommand(ctx context.Context, req *ExecCommandRequest) (*ExecCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
+}
 
 func RegisterDashboardServer(s *grpc.Server, srv DashboardServer) {
 	s.RegisterService(&_Dashboard_serviceDesc, srv)
@@ -808,6 +1067,45 @@ func _Dashboard_Create_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dashboard_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dashboard/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).Delete(ctx, req.(*KeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(KeyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DashboardServer).Watch(m, &dashboardWatchServer{stream})
+}
+
+type Dashboard_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type dashboardWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *dashboardWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Dashboard_PortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PortForwardRequest)
 	if err := dec(in); err != nil {
@@ -880,6 +1178,24 @@ func _Dashboard_ForceFrontendUpdate_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dashboard_ExecCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).ExecCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Dashboard/ExecCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).ExecCommand(ctx, req.(*ExecCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Dashboard_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Dashboard",
 	HandlerType: (*DashboardServer)(nil),
@@ -900,6 +1216,10 @@ var _Dashboard_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _Dashboard_Create_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _Dashboard_Delete_Handler,
+		},
 		{
 			MethodName: "PortForward",
 			Handler:    _Dashboard_PortForward_Handler,
@@ -916,7 +1236,17 @@ var _Dashboard_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceFrontendUpdate",
 			Handler:    _Dashboard_ForceFrontendUpdate_Handler,
 		},
+		{
+			MethodName: "ExecCommand",
+			Handler:    _Dashboard_ExecCommand_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Dashboard_Watch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "dashboard_api.proto",
 }
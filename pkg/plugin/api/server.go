@@ -8,12 +8,18 @@ package api
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kcache "k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/internal/gvk"
+	"github.com/vmware-tanzu/octant/internal/octant"
 	"github.com/vmware-tanzu/octant/internal/portforward"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api/proto"
 	"github.com/vmware-tanzu/octant/pkg/store"
@@ -38,6 +44,48 @@ type NamespacesResponse struct {
 	Namespaces []string
 }
 
+// ExecCommandRequest is a request to run a command in a container.
+type ExecCommandRequest struct {
+	Namespace     string
+	PodName       string
+	ContainerName string
+	Command       []string
+	// Timeout is how long the command can run. The dashboard's default
+	// is used if it is zero.
+	Timeout time.Duration
+}
+
+// ExecCommandResponse is the captured output of a command.
+type ExecCommandResponse struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	TimedOut bool
+}
+
+// WatchEventType is the type of change in a watch event.
+type WatchEventType string
+
+const (
+	// WatchEventAdded is an event for an object which was added, or which
+	// existed when the watch started.
+	WatchEventAdded WatchEventType = "ADDED"
+	// WatchEventModified is an event for an object which was updated.
+	WatchEventModified WatchEventType = "MODIFIED"
+	// WatchEventDeleted is an event for an object which was deleted.
+	WatchEventDeleted WatchEventType = "DELETED"
+)
+
+// watchEventBufferSize is the number of watch events which are buffered
+// for a watcher.
+const watchEventBufferSize = 100
+
+// WatchEvent is a change to a watched object.
+type WatchEvent struct {
+	Type   WatchEventType
+	Object *unstructured.Unstructured
+}
+
 // Service is the dashboard service.
 type Service interface {
 	List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error)
@@ -47,7 +95,10 @@ type Service interface {
 	ListNamespaces(ctx context.Context) (NamespacesResponse, error)
 	Update(ctx context.Context, object *unstructured.Unstructured) error
 	Create(ctx context.Context, object *unstructured.Unstructured) error
+	Delete(ctx context.Context, key store.Key) error
+	Watch(ctx context.Context, key store.Key) (<-chan WatchEvent, error)
 	ForceFrontendUpdate(ctx context.Context) error
+	ExecCommand(ctx context.Context, req ExecCommandRequest) (ExecCommandResponse, error)
 }
 
 // FrontendUpdateController can control the frontend. ie. the web gui
@@ -75,6 +126,7 @@ type GRPCService struct {
 	PortForwarder      portforward.PortForwarder
 	FrontendProxy      FrontendProxy
 	NamespaceInterface cluster.NamespaceInterface
+	CommandExecutor    octant.CommandExecutor
 }
 
 var _ Service = (*GRPCService)(nil)
@@ -107,6 +159,205 @@ func (s *GRPCService) Create(ctx context.Context, object *unstructured.Unstructu
 	return s.ObjectStore.Create(ctx, object)
 }
 
+// Delete deletes an object.
+func (s *GRPCService) Delete(ctx context.Context, key store.Key) error {
+	return s.ObjectStore.Delete(ctx, key)
+}
+
+// Watch watches objects which match a key. Objects which exist when the watch
+// starts are listed from the object store and sent as added events before
+// any change. The object store removes the watch's handler when the context
+// is done. The channel is closed when the context is cancelled, or when the
+// buffer of changes fills because events aren't read fast enough.
+func (s *GRPCService) Watch(ctx context.Context, key store.Key) (<-chan WatchEvent, error) {
+	list, _, err := s.ObjectStore.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	w := newWatcher(key, list)
+
+	handler := kcache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			w.send(WatchEventAdded, obj)
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			w.send(WatchEventModified, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			w.send(WatchEventDeleted, obj)
+		},
+	}
+
+	if err := s.ObjectStore.Watch(ctx, key, handler); err != nil {
+		return nil, err
+	}
+
+	out := make(chan WatchEvent)
+	go w.run(ctx, w.startLive(), out)
+
+	return out, nil
+}
+
+// watcher sends events for objects which match a key. The objects listed when
+// the watch starts, and events sent while the watch is being added to the
+// object store, are queued until they are read. Later events are buffered.
+// Sending never blocks the informer: if the buffer is full, the watcher
+// closes its channel instead.
+type watcher struct {
+	key store.Key
+	// listed holds the resource version of each listed object by UID, so the
+	// object store's add events for them aren't sent again.
+	listed map[types.UID]string
+
+	mu      sync.Mutex
+	live    bool
+	initial []WatchEvent
+	events  chan WatchEvent
+	closed  bool
+}
+
+func newWatcher(key store.Key, list *unstructured.UnstructuredList) *watcher {
+	w := &watcher{
+		key:    key,
+		listed: make(map[types.UID]string),
+		events: make(chan WatchEvent, watchEventBufferSize),
+	}
+
+	if list != nil {
+		for i := range list.Items {
+			object := &list.Items[i]
+			if !w.matches(object) {
+				continue
+			}
+
+			w.listed[object.GetUID()] = object.GetResourceVersion()
+			w.initial = append(w.initial, WatchEvent{Type: WatchEventAdded, Object: object.DeepCopy()})
+		}
+	}
+
+	return w
+}
+
+func (w *watcher) send(eventType WatchEventType, obj interface{}) {
+	if tombstone, ok := obj.(kcache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	object, ok := obj.(*unstructured.Unstructured)
+	if !ok || !w.matches(object) {
+		return
+	}
+
+	if eventType == WatchEventAdded && w.wasListed(object) {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return
+	}
+
+	event := WatchEvent{Type: eventType, Object: object.DeepCopy()}
+	if !w.live {
+		w.initial = append(w.initial, event)
+		return
+	}
+
+	select {
+	case w.events <- event:
+	default:
+		w.closeLocked()
+	}
+}
+
+// wasListed returns true if the object was sent as it is from the list.
+func (w *watcher) wasListed(object *unstructured.Unstructured) bool {
+	resourceVersion, ok := w.listed[object.GetUID()]
+	return ok && resourceVersion == object.GetResourceVersion()
+}
+
+// startLive buffers later events and returns the queued events.
+func (w *watcher) startLive() []WatchEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.live = true
+	initial := w.initial
+	w.initial = nil
+
+	return initial
+}
+
+// run sends the queued events and then the buffered events to out until the
+// context is done or the buffer overflows.
+func (w *watcher) run(ctx context.Context, initial []WatchEvent, out chan<- WatchEvent) {
+	defer close(out)
+	defer w.close()
+
+	for _, event := range initial {
+		select {
+		case out <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	for {
+		select {
+		case event, ok := <-w.events:
+			if !ok {
+				return
+			}
+
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *watcher) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closeLocked()
+}
+
+func (w *watcher) closeLocked() {
+	if w.closed {
+		return
+	}
+
+	w.closed = true
+	close(w.events)
+}
+
+func (w *watcher) matches(object *unstructured.Unstructured) bool {
+	if w.key.Namespace != "" && object.GetNamespace() != w.key.Namespace {
+		return false
+	}
+
+	if w.key.Name != "" && object.GetName() != w.key.Name {
+		return false
+	}
+
+	if w.key.Selector != nil {
+		selector := labels.SelectorFromSet(*w.key.Selector)
+		if !selector.Matches(labels.Set(object.GetLabels())) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // PortForward creates a port forward.
 func (s *GRPCService) PortForward(ctx context.Context, req PortForwardRequest) (PortForwardResponse, error) {
 	pfResponse, err := s.PortForwarder.Create(ctx, nil, gvk.Pod, req.PodName, req.Namespace, req.Port)
@@ -144,6 +395,31 @@ func (s *GRPCService) ForceFrontendUpdate(ctx context.Context) error {
 	return s.FrontendProxy.ForceFrontendUpdate()
 }
 
+// ExecCommand runs a command in a container.
+func (s *GRPCService) ExecCommand(ctx context.Context, req ExecCommandRequest) (ExecCommandResponse, error) {
+	if s.CommandExecutor == nil {
+		return ExecCommandResponse{}, errors.New("exec command is not supported")
+	}
+
+	result, err := s.CommandExecutor.ExecCommand(ctx, octant.ExecCommandRequest{
+		Namespace:     req.Namespace,
+		PodName:       req.PodName,
+		ContainerName: req.ContainerName,
+		Command:       req.Command,
+		Timeout:       req.Timeout,
+	})
+	if err != nil {
+		return ExecCommandResponse{}, err
+	}
+
+	return ExecCommandResponse{
+		Stdout:   result.Stdout,
+		Stderr:   result.Stderr,
+		ExitCode: result.ExitCode,
+		TimedOut: result.TimedOut,
+	}, nil
+}
+
 func NewGRPCServer(service Service) *grpcServer {
 	return &grpcServer{
 		service: service,
@@ -246,6 +522,55 @@ func (c *grpcServer) Create(ctx context.Context, in *proto.CreateRequest) (*prot
 	return &proto.CreateResponse{}, nil
 }
 
+// Delete deletes an object.
+func (c *grpcServer) Delete(ctx context.Context, in *proto.KeyRequest) (*proto.Empty, error) {
+	key, err := convertToKey(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.service.Delete(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &proto.Empty{}, nil
+}
+
+// Watch streams changes to objects.
+func (c *grpcServer) Watch(in *proto.KeyRequest, stream proto.Dashboard_WatchServer) error {
+	key, err := convertToKey(in)
+	if err != nil {
+		return err
+	}
+
+	events, err := c.service.Watch(stream.Context(), key)
+	if err != nil {
+		return err
+	}
+
+	for event := range events {
+		data, err := convertFromObject(event.Object)
+		if err != nil {
+			return err
+		}
+
+		out := &proto.WatchEvent{
+			Type:   string(event.Type),
+			Object: data,
+		}
+
+		if err := stream.Send(out); err != nil {
+			return err
+		}
+	}
+
+	if stream.Context().Err() == nil {
+		return errors.Errorf("watch %s stopped: events were not read fast enough", key)
+	}
+
+	return nil
+}
+
 // PortForward creates a port forward.
 func (c *grpcServer) PortForward(ctx context.Context, in *proto.PortForwardRequest) (*proto.PortForwardResponse, error) {
 	req, err := convertToPortForwardRequest(in)
@@ -297,3 +622,25 @@ func (c *grpcServer) ForceFrontendUpdate(ctx context.Context, _ *proto.Empty) (*
 
 	return &proto.Empty{}, nil
 }
+
+// ExecCommand runs a command in a container.
+func (c *grpcServer) ExecCommand(ctx context.Context, in *proto.ExecCommandRequest) (*proto.ExecCommandResponse, error) {
+	req, err := convertToExecCommandRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	execResp, err := c.service.ExecCommand(ctx, *req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ExecCommandResponse{
+		Stdout:   []byte(execResp.Stdout),
+		Stderr:   []byte(execResp.Stderr),
+		ExitCode: int32(execResp.ExitCode),
+		TimedOut: execResp.TimedOut,
+	}
+
+	return resp, nil
+}
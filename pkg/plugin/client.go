@@ -13,6 +13,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -37,6 +38,8 @@ type Capabilities struct {
 	IsModule bool `json:",omitempty"`
 	// ActionNames is a list of action names this plugin handles
 	ActionNames []string `json:",omitempty"`
+	// SupportsObjectRelations are the GVKs the plugin will find related objects for.
+	SupportsObjectRelations []schema.GroupVersionKind `json:",omitempty"`
 }
 
 // HasPrinterSupport returns true if this plugin supports the supplied GVK.
@@ -58,6 +61,12 @@ func (c Capabilities) HasObjectStatusSupport(gvk schema.GroupVersionKind) bool {
 	return includesGVK(gvk, c.SupportsObjectStatus)
 }
 
+// HasObjectRelationsSupport returns true if this plugins supports finding related objects for
+// the supplied GVK.
+func (c Capabilities) HasObjectRelationsSupport(gvk schema.GroupVersionKind) bool {
+	return includesGVK(gvk, c.SupportsObjectRelations)
+}
+
 // PrintResponse is a printer response from the plugin. The dashboard
 // will use this to the add the plugin's output to a summary view.
 type PrintResponse struct {
@@ -82,6 +91,12 @@ type ObjectStatusResponse struct {
 	ObjectStatus component.PodSummary
 }
 
+// RelationsResponse is an object relations response from a plugin.
+type RelationsResponse struct {
+	// Keys are the keys of objects related to an object.
+	Keys []store.Key
+}
+
 // Metadata is plugin metadata.
 type Metadata struct {
 	Name         string
@@ -96,6 +111,7 @@ type Service interface {
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
+	Relations(ctx context.Context, object runtime.Object) (RelationsResponse, error)
 	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
 }
 
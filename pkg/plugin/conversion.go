@@ -12,6 +12,7 @@ import (
 
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -21,13 +22,14 @@ func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabili
 	}
 
 	c := Capabilities{
-		SupportsPrinterStatus: convertToGroupVersionKindList(in.SupportsPrinterStatus),
-		SupportsPrinterConfig: convertToGroupVersionKindList(in.SupportsPrinterConfig),
-		SupportsPrinterItems:  convertToGroupVersionKindList(in.SupportsPrinterItems),
-		SupportsObjectStatus:  convertToGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsTab:           convertToGroupVersionKindList(in.SupportsTab),
-		IsModule:              in.IsModule,
-		ActionNames:           in.ActionNames,
+		SupportsPrinterStatus:   convertToGroupVersionKindList(in.SupportsPrinterStatus),
+		SupportsPrinterConfig:   convertToGroupVersionKindList(in.SupportsPrinterConfig),
+		SupportsPrinterItems:    convertToGroupVersionKindList(in.SupportsPrinterItems),
+		SupportsObjectStatus:    convertToGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsTab:             convertToGroupVersionKindList(in.SupportsTab),
+		IsModule:                in.IsModule,
+		ActionNames:             in.ActionNames,
+		SupportsObjectRelations: convertToGroupVersionKindList(in.SupportsObjectRelations),
 	}
 
 	return c
@@ -35,13 +37,14 @@ func convertToCapabilities(in *dashboard.RegisterResponse_Capabilities) Capabili
 
 func convertFromCapabilities(in Capabilities) dashboard.RegisterResponse_Capabilities {
 	c := dashboard.RegisterResponse_Capabilities{
-		SupportsPrinterStatus: convertFromGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsPrinterConfig: convertFromGroupVersionKindList(in.SupportsPrinterConfig),
-		SupportsPrinterItems:  convertFromGroupVersionKindList(in.SupportsPrinterItems),
-		SupportsObjectStatus:  convertFromGroupVersionKindList(in.SupportsObjectStatus),
-		SupportsTab:           convertFromGroupVersionKindList(in.SupportsTab),
-		IsModule:              in.IsModule,
-		ActionNames:           in.ActionNames,
+		SupportsPrinterStatus:   convertFromGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsPrinterConfig:   convertFromGroupVersionKindList(in.SupportsPrinterConfig),
+		SupportsPrinterItems:    convertFromGroupVersionKindList(in.SupportsPrinterItems),
+		SupportsObjectStatus:    convertFromGroupVersionKindList(in.SupportsObjectStatus),
+		SupportsTab:             convertFromGroupVersionKindList(in.SupportsTab),
+		IsModule:                in.IsModule,
+		ActionNames:             in.ActionNames,
+		SupportsObjectRelations: convertFromGroupVersionKindList(in.SupportsObjectRelations),
 	}
 
 	return c
@@ -174,3 +177,35 @@ func convertFromSummarySection(in component.SummarySection) (*dashboard.PrintRes
 		Component: data,
 	}, nil
 }
+
+func convertToRelationsKeys(in []*dashboard.RelationsResponse_Key) []store.Key {
+	var list []store.Key
+	for i := range in {
+		if in[i] == nil {
+			continue
+		}
+
+		list = append(list, store.Key{
+			Namespace:  in[i].Namespace,
+			APIVersion: in[i].ApiVersion,
+			Kind:       in[i].Kind,
+			Name:       in[i].Name,
+		})
+	}
+
+	return list
+}
+
+func convertFromRelationsKeys(in []store.Key) []*dashboard.RelationsResponse_Key {
+	var list []*dashboard.RelationsResponse_Key
+	for i := range in {
+		list = append(list, &dashboard.RelationsResponse_Key{
+			Namespace:  in[i].Namespace,
+			ApiVersion: in[i].APIVersion,
+			Kind:       in[i].Kind,
+			Name:       in[i].Name,
+		})
+	}
+
+	return list
+}
@@ -346,6 +346,13 @@ func (m *NavigationResponse_Navigation) GetIconName() string {
 	return ""
 }
 
+func (m *NavigationResponse_Navigation) GetIconSource() string {
+	if m != nil {
+		return m.IconSource
+	}
+	return ""
+}
+
 type RegisterRequest struct {
 	DashboardAPIAddress  string   `protobuf:"bytes,1,opt,name=dashboardAPIAddress,proto3" json:"dashboardAPIAddress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -496,16 +503,17 @@ func (m *RegisterResponse_GroupVersionKind) GetKind() string {
 }
 
 type RegisterResponse_Capabilities struct {
-	SupportsPrinterConfig []*RegisterResponse_GroupVersionKind `protobuf:"bytes,1,rep,name=supportsPrinterConfig,proto3" json:"supportsPrinterConfig,omitempty"`
-	SupportsPrinterStatus []*RegisterResponse_GroupVersionKind `protobuf:"bytes,2,rep,name=supportsPrinterStatus,proto3" json:"supportsPrinterStatus,omitempty"`
-	SupportsPrinterItems  []*RegisterResponse_GroupVersionKind `protobuf:"bytes,3,rep,name=supportsPrinterItems,proto3" json:"supportsPrinterItems,omitempty"`
-	SupportsObjectStatus  []*RegisterResponse_GroupVersionKind `protobuf:"bytes,4,rep,name=supportsObjectStatus,proto3" json:"supportsObjectStatus,omitempty"`
-	SupportsTab           []*RegisterResponse_GroupVersionKind `protobuf:"bytes,5,rep,name=supportsTab,proto3" json:"supportsTab,omitempty"`
-	IsModule              bool                                 `protobuf:"varint,6,opt,name=isModule,proto3" json:"isModule,omitempty"`
-	ActionNames           []string                             `protobuf:"bytes,7,rep,name=action_names,json=actionNames,proto3" json:"action_names,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                             `json:"-"`
-	XXX_unrecognized      []byte                               `json:"-"`
-	XXX_sizecache         int32                                `json:"-"`
+	SupportsPrinterConfig   []*RegisterResponse_GroupVersionKind `protobuf:"bytes,1,rep,name=supportsPrinterConfig,proto3" json:"supportsPrinterConfig,omitempty"`
+	SupportsPrinterStatus   []*RegisterResponse_GroupVersionKind `protobuf:"bytes,2,rep,name=supportsPrinterStatus,proto3" json:"supportsPrinterStatus,omitempty"`
+	SupportsPrinterItems    []*RegisterResponse_GroupVersionKind `protobuf:"bytes,3,rep,name=supportsPrinterItems,proto3" json:"supportsPrinterItems,omitempty"`
+	SupportsObjectStatus    []*RegisterResponse_GroupVersionKind `protobuf:"bytes,4,rep,name=supportsObjectStatus,proto3" json:"supportsObjectStatus,omitempty"`
+	SupportsTab             []*RegisterResponse_GroupVersionKind `protobuf:"bytes,5,rep,name=supportsTab,proto3" json:"supportsTab,omitempty"`
+	IsModule                bool                                 `protobuf:"varint,6,opt,name=isModule,proto3" json:"isModule,omitempty"`
+	ActionNames             []string                             `protobuf:"bytes,7,rep,name=action_names,json=actionNames,proto3" json:"action_names,omitempty"`
+	SupportsObjectRelations []*RegisterResponse_GroupVersionKind `protobuf:"bytes,8,rep,name=supportsObjectRelations,proto3" json:"supportsObjectRelations,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                             `json:"-"`
+	XXX_unrecognized        []byte                               `json:"-"`
+	XXX_sizecache           int32                                `json:"-"`
 }
 
 func (m *RegisterResponse_Capabilities) Reset()         { *m = RegisterResponse_Capabilities{} }
@@ -582,6 +590,13 @@ func (m *RegisterResponse_Capabilities) GetActionNames() []string {
 	return nil
 }
 
+func (m *RegisterResponse_Capabilities) GetSupportsObjectRelations() []*RegisterResponse_GroupVersionKind {
+	if m != nil {
+		return m.SupportsObjectRelations
+	}
+	return nil
+}
+
 type ObjectRequest struct {
 	Object               []byte   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -809,6 +824,108 @@ func (m *ObjectStatusResponse) GetObjectStatus() []byte {
 	return nil
 }
 
+type RelationsResponse struct {
+	Keys                 []*RelationsResponse_Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *RelationsResponse) Reset()         { *m = RelationsResponse{} }
+func (m *RelationsResponse) String() string { return proto.CompactTextString(m) }
+func (*RelationsResponse) ProtoMessage()    {}
+func (*RelationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{13}
+}
+
+func (m *RelationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationsResponse.Unmarshal(m, b)
+}
+func (m *RelationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationsResponse.Marshal(b, m, deterministic)
+}
+func (m *RelationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationsResponse.Merge(m, src)
+}
+func (m *RelationsResponse) XXX_Size() int {
+	return xxx_messageInfo_RelationsResponse.Size(m)
+}
+func (m *RelationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationsResponse proto.InternalMessageInfo
+
+func (m *RelationsResponse) GetKeys() []*RelationsResponse_Key {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type RelationsResponse_Key struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApiVersion           string   `protobuf:"bytes,2,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind                 string   `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelationsResponse_Key) Reset()         { *m = RelationsResponse_Key{} }
+func (m *RelationsResponse_Key) String() string { return proto.CompactTextString(m) }
+func (*RelationsResponse_Key) ProtoMessage()    {}
+func (*RelationsResponse_Key) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{13, 0}
+}
+
+func (m *RelationsResponse_Key) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelationsResponse_Key.Unmarshal(m, b)
+}
+func (m *RelationsResponse_Key) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelationsResponse_Key.Marshal(b, m, deterministic)
+}
+func (m *RelationsResponse_Key) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelationsResponse_Key.Merge(m, src)
+}
+func (m *RelationsResponse_Key) XXX_Size() int {
+	return xxx_messageInfo_RelationsResponse_Key.Size(m)
+}
+func (m *RelationsResponse_Key) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelationsResponse_Key.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelationsResponse_Key proto.InternalMessageInfo
+
+func (m *RelationsResponse_Key) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *RelationsResponse_Key) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *RelationsResponse_Key) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *RelationsResponse_Key) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type WatchRequest struct {
 	WatchID              string   `protobuf:"bytes,1,opt,name=watchID,proto3" json:"watchID,omitempty"`
 	Object               []byte   `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
@@ -821,7 +938,7 @@ func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
 func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
 func (*WatchRequest) ProtoMessage()    {}
 func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9b97678da3a35dfb, []int{13}
+	return fileDescriptor_9b97678da3a35dfb, []int{14}
 }
 
 func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
@@ -874,77 +991,85 @@ func init() {
 	proto.RegisterType((*PrintResponse_SummaryItem)(nil), "dashboard.PrintResponse.SummaryItem")
 	proto.RegisterType((*PrintTabResponse)(nil), "dashboard.PrintTabResponse")
 	proto.RegisterType((*ObjectStatusResponse)(nil), "dashboard.ObjectStatusResponse")
+	proto.RegisterType((*RelationsResponse)(nil), "dashboard.RelationsResponse")
+	proto.RegisterType((*RelationsResponse_Key)(nil), "dashboard.RelationsResponse.Key")
 	proto.RegisterType((*WatchRequest)(nil), "dashboard.WatchRequest")
 }
 
 func init() { proto.RegisterFile("dashboard.proto", fileDescriptor_9b97678da3a35dfb) }
 
 var fileDescriptor_9b97678da3a35dfb = []byte{
-	// 878 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdb, 0x6e, 0xf3, 0x44,
-	0x10, 0x56, 0xce, 0xc9, 0xc4, 0x3f, 0x09, 0xdb, 0xf0, 0x63, 0xdc, 0xd2, 0x04, 0xab, 0x12, 0x45,
-	0x42, 0x11, 0x2a, 0x42, 0x42, 0x50, 0xa1, 0x46, 0x29, 0xa2, 0x11, 0xd0, 0x46, 0x6e, 0x29, 0x97,
-	0x65, 0x63, 0x2f, 0xc9, 0x82, 0xe3, 0x35, 0xde, 0x75, 0x51, 0x9e, 0x85, 0x2b, 0x6e, 0x90, 0x78,
-	0x05, 0x1e, 0x88, 0xe7, 0x40, 0x5e, 0xaf, 0x9d, 0x75, 0x9a, 0x44, 0xb4, 0x70, 0xe7, 0xf9, 0x76,
-	0xe6, 0x9b, 0xd9, 0x39, 0xec, 0x18, 0x3a, 0x1e, 0xe6, 0x8b, 0x19, 0xc3, 0x91, 0x37, 0x0c, 0x23,
-	0x26, 0x18, 0x6a, 0xe5, 0x80, 0xdd, 0x80, 0xda, 0x97, 0xcb, 0x50, 0xac, 0xec, 0x13, 0x78, 0x63,
-	0xcc, 0x02, 0x41, 0x02, 0xe1, 0x90, 0x5f, 0x62, 0xc2, 0x05, 0x42, 0x50, 0x0d, 0xb1, 0x58, 0x98,
-	0xa5, 0x41, 0xe9, 0xb4, 0xe5, 0xc8, 0x6f, 0xfb, 0x1c, 0x3a, 0xb9, 0x16, 0x0f, 0x59, 0xc0, 0x09,
-	0xfa, 0x00, 0xba, 0x6e, 0x0a, 0x3d, 0x44, 0x0a, 0x93, 0x26, 0x86, 0xd3, 0x71, 0x8b, 0xaa, 0xf6,
-	0x14, 0x0e, 0xae, 0x70, 0xe0, 0xf9, 0x64, 0xe4, 0x0a, 0xca, 0x82, 0xcc, 0x51, 0x1f, 0xda, 0x58,
-	0x02, 0x0f, 0x01, 0x5e, 0x12, 0xe5, 0x0f, 0x52, 0xe8, 0x1a, 0x2f, 0x09, 0x32, 0xa1, 0x11, 0xe2,
-	0x95, 0xcf, 0xb0, 0x67, 0x96, 0x25, 0x73, 0x26, 0xda, 0xaf, 0xa1, 0x57, 0x64, 0x54, 0x9e, 0x0e,
-	0xe0, 0xcd, 0x6b, 0xfc, 0x48, 0xe7, 0x58, 0xf3, 0x63, 0xff, 0x56, 0x06, 0xa4, 0xa3, 0xea, 0x02,
-	0x57, 0x00, 0x41, 0x8e, 0x4a, 0xef, 0xed, 0xb3, 0xd3, 0xe1, 0x3a, 0x67, 0x4f, 0x4d, 0x74, 0x48,
-	0xb3, 0xb5, 0xfe, 0x2a, 0x01, 0xac, 0x8f, 0x50, 0x0f, 0x6a, 0x82, 0x0a, 0x3f, 0xbb, 0x51, 0x2a,
-	0xe4, 0x69, 0x2d, 0xaf, 0xd3, 0x8a, 0x2e, 0xa1, 0xe9, 0x2e, 0xa8, 0xef, 0x45, 0x24, 0x30, 0x2b,
-	0x83, 0xca, 0xb3, 0x02, 0xc8, 0x2d, 0xd1, 0x21, 0xb4, 0xa8, 0x9b, 0x65, 0xb1, 0x2a, 0xe9, 0x9b,
-	0x09, 0x20, 0x73, 0xd8, 0x87, 0xb6, 0x3c, 0xe4, 0x2c, 0x8e, 0x5c, 0x62, 0xd6, 0xd2, 0x24, 0x27,
-	0xd0, 0xad, 0x44, 0xec, 0x31, 0x74, 0x1c, 0x32, 0xa7, 0x5c, 0x90, 0x28, 0x2b, 0xcc, 0x47, 0x70,
-	0x90, 0x47, 0x31, 0x9a, 0x4e, 0x46, 0x9e, 0x17, 0x11, 0xce, 0xd5, 0x75, 0xb6, 0x1d, 0xd9, 0x7f,
-	0xd4, 0xa1, 0xbb, 0x66, 0x51, 0x09, 0x3e, 0x06, 0x08, 0xfd, 0x78, 0x4e, 0x65, 0x20, 0x59, 0x79,
-	0xd7, 0x08, 0x1a, 0x40, 0xdb, 0x23, 0xdc, 0x8d, 0x68, 0x28, 0x2b, 0x90, 0x26, 0x46, 0x87, 0xd0,
-	0x37, 0x60, 0xb8, 0x38, 0xc4, 0x33, 0xea, 0x53, 0x41, 0x09, 0x37, 0x2b, 0x4f, 0x8a, 0xb4, 0xe9,
-	0x74, 0x38, 0xd6, 0xf4, 0x9d, 0x82, 0xb5, 0x75, 0x0f, 0xdd, 0xaf, 0x22, 0x16, 0x87, 0xf7, 0x24,
-	0xe2, 0x94, 0x05, 0x5f, 0xd3, 0xc0, 0x4b, 0x6a, 0x35, 0x4f, 0xb0, 0xac, 0x56, 0x52, 0x48, 0x1a,
-	0xef, 0x31, 0x55, 0x52, 0x51, 0x65, 0x62, 0x52, 0xc5, 0x9f, 0x69, 0xe0, 0xc9, 0x48, 0x5a, 0x8e,
-	0xfc, 0xb6, 0xfe, 0xac, 0x82, 0xa1, 0xbb, 0x45, 0x33, 0x78, 0x8b, 0xc7, 0x61, 0xc8, 0x22, 0xc1,
-	0xa7, 0x11, 0x0d, 0x04, 0x89, 0xc6, 0x2c, 0xf8, 0x91, 0xce, 0xcd, 0x92, 0xac, 0xf1, 0x87, 0xfb,
-	0xe2, 0xdf, 0x8c, 0xd0, 0xd9, 0x4e, 0xb5, 0xc5, 0xc7, 0xad, 0xc0, 0x22, 0xe6, 0x66, 0xf9, 0x7f,
-	0xf0, 0x91, 0x52, 0xa1, 0x1f, 0xa0, 0xb7, 0x71, 0x30, 0x11, 0x64, 0xc9, 0x55, 0xab, 0x3e, 0xcf,
-	0xc5, 0x56, 0x26, 0xdd, 0xc3, 0xcd, 0xec, 0x27, 0xe2, 0x0a, 0x75, 0x89, 0xea, 0x7f, 0xf1, 0xa0,
-	0x33, 0xa1, 0x6b, 0x68, 0x67, 0xf8, 0x1d, 0x9e, 0x99, 0xb5, 0x17, 0x10, 0xeb, 0x04, 0xc8, 0x82,
-	0x26, 0xe5, 0xdf, 0x32, 0x2f, 0xf6, 0x89, 0x59, 0x1f, 0x94, 0x4e, 0x9b, 0x4e, 0x2e, 0xa3, 0xf7,
-	0xc0, 0xd0, 0x1e, 0x34, 0x6e, 0x36, 0x06, 0x95, 0xa4, 0xa3, 0xd7, 0x2f, 0x1a, 0xb7, 0xdf, 0x87,
-	0x57, 0x69, 0x78, 0xd9, 0xac, 0xbd, 0x86, 0x3a, 0x93, 0x80, 0x7a, 0x3c, 0x95, 0x64, 0xff, 0x5d,
-	0x82, 0x57, 0x32, 0x55, 0xf9, 0x38, 0x9d, 0x43, 0xdd, 0xd5, 0xdb, 0xe8, 0x44, 0xbb, 0x44, 0x41,
-	0x73, 0x78, 0x1b, 0x2f, 0x97, 0x38, 0x5a, 0x25, 0x29, 0x76, 0x94, 0x4d, 0x62, 0xcd, 0xf5, 0x06,
-	0xf9, 0x97, 0xd6, 0xa9, 0x4d, 0x32, 0x26, 0x54, 0x95, 0x3e, 0x09, 0x32, 0x15, 0xac, 0x31, 0xb4,
-	0x35, 0xe5, 0xe4, 0x2a, 0x0b, 0x82, 0x3d, 0x12, 0xa9, 0x61, 0x52, 0x12, 0x3a, 0x82, 0x96, 0xcb,
-	0x96, 0x21, 0x0b, 0x48, 0x20, 0xd4, 0x43, 0xbe, 0x06, 0xec, 0x2f, 0xa0, 0x2b, 0xfd, 0xdf, 0xe1,
-	0x59, 0x7e, 0x55, 0x04, 0x55, 0x6d, 0x25, 0xc8, 0xef, 0x84, 0xdd, 0xc7, 0x2b, 0x16, 0x67, 0x14,
-	0x4a, 0xb2, 0x3f, 0x83, 0x9e, 0x5e, 0xf0, 0x9c, 0xc3, 0x06, 0x83, 0xe9, 0x2d, 0x95, 0xa6, 0xb7,
-	0x80, 0xd9, 0x17, 0x60, 0x7c, 0x8f, 0x85, 0xbb, 0xc8, 0x8a, 0x61, 0x42, 0xe3, 0xd7, 0x44, 0x9e,
-	0x5c, 0x2a, 0xd7, 0x99, 0xa8, 0x95, 0xa9, 0xac, 0x97, 0xe9, 0xec, 0xf7, 0x1a, 0xd4, 0xa7, 0xf2,
-	0x49, 0x43, 0x17, 0xd0, 0x50, 0x3b, 0x12, 0xbd, 0xa3, 0x25, 0xb7, 0xb8, 0x5d, 0x2d, 0x6b, 0xdb,
-	0x91, 0x0a, 0xf9, 0x06, 0x0c, 0x7d, 0xab, 0xa1, 0x63, 0x4d, 0x77, 0xcb, 0x02, 0xb5, 0xfa, 0x3b,
-	0xcf, 0x15, 0xe1, 0xa4, 0xb0, 0x97, 0x8e, 0x76, 0xec, 0x96, 0x94, 0xec, 0xdd, 0xbd, 0x9b, 0x07,
-	0x8d, 0xa1, 0x99, 0x4d, 0x0a, 0xb2, 0xb6, 0x8e, 0x4f, 0x4a, 0x73, 0xb8, 0x67, 0xb4, 0xd0, 0xe7,
-	0x50, 0x93, 0xb5, 0x46, 0xa6, 0xa6, 0x55, 0x98, 0x07, 0xcb, 0xdc, 0xd5, 0x97, 0x68, 0x02, 0x46,
-	0x61, 0xb2, 0x77, 0x73, 0xf4, 0x9f, 0x9c, 0x6c, 0xf4, 0xc6, 0x08, 0x9a, 0x59, 0xcf, 0xed, 0xa1,
-	0x39, 0xdc, 0x0c, 0x45, 0x6f, 0xd1, 0x4f, 0xa0, 0x29, 0x5b, 0x67, 0xe4, 0x79, 0xe8, 0x6d, 0x4d,
-	0x51, 0xef, 0x27, 0xab, 0xab, 0x1d, 0xc8, 0xdf, 0x2d, 0xf4, 0x29, 0xb4, 0xa5, 0xc6, 0x77, 0xa1,
-	0x87, 0x05, 0x79, 0x89, 0xe5, 0x25, 0xf1, 0xc9, 0xb3, 0x2c, 0x67, 0x75, 0xf9, 0xf7, 0xf7, 0xf1,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x4a, 0x08, 0xcc, 0x10, 0x0a, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x56, 0xfe, 0x93, 0x93, 0x94, 0x4d, 0x67, 0x97, 0xd6, 0x78, 0x97, 0x6e, 0xb0, 0x2a, 0xb1,
+	0x48, 0x28, 0x42, 0x05, 0x24, 0x04, 0x15, 0x6a, 0x94, 0x45, 0x34, 0x5a, 0xd8, 0xae, 0xbc, 0x65,
+	0xb9, 0x2c, 0x13, 0x7b, 0x9a, 0x0c, 0xeb, 0x78, 0x8c, 0x67, 0x5c, 0x94, 0x97, 0x40, 0xe2, 0x9a,
+	0xf7, 0xe0, 0x82, 0x07, 0xea, 0x73, 0x20, 0x8f, 0x67, 0xec, 0x71, 0xd6, 0x89, 0xd8, 0xa5, 0x77,
+	0x3e, 0xdf, 0x9c, 0xf3, 0x9d, 0x33, 0xe7, 0x6f, 0x0c, 0x7b, 0x3e, 0xe6, 0xcb, 0x39, 0xc3, 0xb1,
+	0x3f, 0x8e, 0x62, 0x26, 0x18, 0xea, 0xe5, 0x80, 0xd3, 0x81, 0xd6, 0x77, 0xab, 0x48, 0xac, 0x9d,
+	0xc7, 0xf0, 0xde, 0x94, 0x85, 0x82, 0x84, 0xc2, 0x25, 0xbf, 0x25, 0x84, 0x0b, 0x84, 0xa0, 0x19,
+	0x61, 0xb1, 0xb4, 0x6a, 0xa3, 0xda, 0x49, 0xcf, 0x95, 0xdf, 0xce, 0x53, 0xd8, 0xcb, 0xb5, 0x78,
+	0xc4, 0x42, 0x4e, 0xd0, 0x27, 0x30, 0xf4, 0x32, 0xe8, 0x55, 0xac, 0x30, 0x69, 0x32, 0x70, 0xf7,
+	0xbc, 0xb2, 0xaa, 0x73, 0x01, 0xfb, 0xcf, 0x71, 0xe8, 0x07, 0x64, 0xe2, 0x09, 0xca, 0x42, 0xed,
+	0xe8, 0x18, 0xfa, 0x58, 0x02, 0xaf, 0x42, 0xbc, 0x22, 0xca, 0x1f, 0x64, 0xd0, 0x39, 0x5e, 0x11,
+	0x64, 0x41, 0x27, 0xc2, 0xeb, 0x80, 0x61, 0xdf, 0xaa, 0x4b, 0x66, 0x2d, 0x3a, 0x0f, 0xe0, 0xa0,
+	0xcc, 0xa8, 0x3c, 0xed, 0xc3, 0xfd, 0x73, 0xfc, 0x86, 0x2e, 0xb0, 0xe1, 0xc7, 0xf9, 0xab, 0x0e,
+	0xc8, 0x44, 0xd5, 0x05, 0x9e, 0x03, 0x84, 0x39, 0x2a, 0xbd, 0xf7, 0x9f, 0x9c, 0x8c, 0x8b, 0x9c,
+	0xdd, 0x34, 0x31, 0x21, 0xc3, 0xd6, 0xfe, 0xa7, 0x06, 0x50, 0x1c, 0xa1, 0x03, 0x68, 0x09, 0x2a,
+	0x02, 0x7d, 0xa3, 0x4c, 0xc8, 0xd3, 0x5a, 0x2f, 0xd2, 0x8a, 0x4e, 0xa1, 0xeb, 0x2d, 0x69, 0xe0,
+	0xc7, 0x24, 0xb4, 0x1a, 0xa3, 0xc6, 0xad, 0x02, 0xc8, 0x2d, 0xd1, 0x21, 0xf4, 0xa8, 0xa7, 0xb3,
+	0xd8, 0x94, 0xf4, 0x5d, 0xea, 0xa9, 0x1c, 0x1e, 0x43, 0x5f, 0x1e, 0x72, 0x96, 0xc4, 0x1e, 0xb1,
+	0x5a, 0x59, 0x92, 0x53, 0xe8, 0x52, 0x22, 0xce, 0x14, 0xf6, 0x5c, 0xb2, 0xa0, 0x5c, 0x90, 0x58,
+	0x17, 0xe6, 0x33, 0xd8, 0xcf, 0xa3, 0x98, 0x5c, 0xcc, 0x26, 0xbe, 0x1f, 0x13, 0xce, 0xd5, 0x75,
+	0xaa, 0x8e, 0x9c, 0x3f, 0x3a, 0x30, 0x2c, 0x58, 0x54, 0x82, 0x1f, 0x01, 0x44, 0x41, 0xb2, 0xa0,
+	0x32, 0x10, 0x5d, 0xde, 0x02, 0x41, 0x23, 0xe8, 0xfb, 0x84, 0x7b, 0x31, 0x8d, 0x64, 0x05, 0xb2,
+	0xc4, 0x98, 0x10, 0xfa, 0x01, 0x06, 0x1e, 0x8e, 0xf0, 0x9c, 0x06, 0x54, 0x50, 0xc2, 0xad, 0xc6,
+	0x8d, 0x22, 0x6d, 0x3a, 0x1d, 0x4f, 0x0d, 0x7d, 0xb7, 0x64, 0x6d, 0x5f, 0xc1, 0xf0, 0xfb, 0x98,
+	0x25, 0xd1, 0x15, 0x89, 0x39, 0x65, 0xe1, 0x19, 0x0d, 0xfd, 0xb4, 0x56, 0x8b, 0x14, 0xd3, 0xb5,
+	0x92, 0x42, 0xda, 0x78, 0x6f, 0x32, 0x25, 0x15, 0x95, 0x16, 0xd3, 0x2a, 0x5e, 0xd3, 0xd0, 0x97,
+	0x91, 0xf4, 0x5c, 0xf9, 0x6d, 0xff, 0xd9, 0x82, 0x81, 0xe9, 0x16, 0xcd, 0xe1, 0x7d, 0x9e, 0x44,
+	0x11, 0x8b, 0x05, 0xbf, 0x88, 0x69, 0x28, 0x48, 0x3c, 0x65, 0xe1, 0x6b, 0xba, 0xb0, 0x6a, 0xb2,
+	0xc6, 0x9f, 0xee, 0x8a, 0x7f, 0x33, 0x42, 0xb7, 0x9a, 0xaa, 0xc2, 0xc7, 0xa5, 0xc0, 0x22, 0xe1,
+	0x56, 0xfd, 0x1d, 0xf8, 0xc8, 0xa8, 0xd0, 0x2f, 0x70, 0xb0, 0x71, 0x30, 0x13, 0x64, 0xc5, 0xad,
+	0xc6, 0x1d, 0x5c, 0x54, 0x32, 0x99, 0x1e, 0x5e, 0xcc, 0x7f, 0x25, 0x9e, 0x50, 0x97, 0x68, 0xfe,
+	0x1f, 0x0f, 0x26, 0x13, 0x3a, 0x87, 0xbe, 0xc6, 0x5f, 0xe2, 0xb9, 0xd5, 0xba, 0x03, 0xb1, 0x49,
+	0x80, 0x6c, 0xe8, 0x52, 0xfe, 0x23, 0xf3, 0x93, 0x80, 0x58, 0xed, 0x51, 0xed, 0xa4, 0xeb, 0xe6,
+	0x32, 0xfa, 0x08, 0x06, 0xc6, 0x42, 0xe3, 0x56, 0x67, 0xd4, 0x48, 0x3b, 0xba, 0xd8, 0x68, 0x1c,
+	0xbd, 0x86, 0x87, 0xe5, 0x30, 0x5d, 0x12, 0xc8, 0x79, 0xe6, 0x56, 0xf7, 0x0e, 0xa1, 0x6d, 0x23,
+	0x73, 0x3e, 0x86, 0x7b, 0x1a, 0xca, 0x66, 0xfa, 0x01, 0xb4, 0x99, 0x04, 0xd4, 0x92, 0x56, 0x92,
+	0xf3, 0xb6, 0x06, 0xf7, 0x64, 0x49, 0xf2, 0xb1, 0x7d, 0x0a, 0x6d, 0xcf, 0x6c, 0xd7, 0xc7, 0x46,
+	0x44, 0x25, 0xcd, 0xf1, 0x65, 0xb2, 0x5a, 0xe1, 0x78, 0x9d, 0x96, 0xd2, 0x55, 0x36, 0xa9, 0x35,
+	0x37, 0x1b, 0xf1, 0x3f, 0x5a, 0x67, 0x36, 0xe9, 0x38, 0x52, 0xd5, 0x62, 0x69, 0x90, 0x99, 0x60,
+	0x4f, 0xa1, 0x6f, 0x28, 0xa7, 0x57, 0x59, 0x12, 0xec, 0x93, 0x58, 0x0d, 0xad, 0x92, 0xd0, 0x11,
+	0xf4, 0x3c, 0xb6, 0x8a, 0x58, 0x48, 0x42, 0xa1, 0x1e, 0x8c, 0x02, 0x70, 0xbe, 0x85, 0xa1, 0xf4,
+	0xff, 0x12, 0xcf, 0xf3, 0xab, 0x22, 0x68, 0x1a, 0x4f, 0x8f, 0xfc, 0x4e, 0xd9, 0x03, 0xbc, 0x66,
+	0x89, 0xa6, 0x50, 0x92, 0xf3, 0x35, 0x1c, 0x98, 0x8d, 0x95, 0x73, 0x38, 0x30, 0x60, 0x66, 0xeb,
+	0x66, 0xe9, 0x2d, 0x61, 0xce, 0xdf, 0x35, 0xb8, 0x9f, 0xd7, 0x26, 0xb7, 0xfc, 0x02, 0x9a, 0xd7,
+	0x64, 0xcd, 0x55, 0x9a, 0x47, 0xa5, 0xc2, 0x6f, 0xe8, 0x8e, 0xcf, 0xc8, 0xda, 0x95, 0xda, 0xf6,
+	0x35, 0x34, 0xce, 0xc8, 0x3a, 0xbd, 0xac, 0x6c, 0xb2, 0x08, 0x7b, 0x3a, 0xfe, 0x02, 0x48, 0x57,
+	0x2f, 0x8e, 0xe8, 0x55, 0x69, 0x87, 0x19, 0x48, 0xd5, 0x1a, 0xcb, 0x93, 0xd1, 0x2c, 0x92, 0xe1,
+	0x3c, 0x83, 0xc1, 0xcf, 0x58, 0x78, 0x4b, 0xdd, 0x45, 0x16, 0x74, 0x7e, 0x4f, 0xe5, 0xd9, 0xa9,
+	0xf2, 0xa9, 0x45, 0xa3, 0xbf, 0xea, 0x66, 0x7f, 0x3d, 0x79, 0xdb, 0x82, 0xf6, 0x85, 0xdc, 0xf9,
+	0xe8, 0x19, 0x74, 0xd4, 0x4f, 0x04, 0xfa, 0xc0, 0xb8, 0x6c, 0xf9, 0xf7, 0xc3, 0xb6, 0xab, 0x8e,
+	0x54, 0xc6, 0x5e, 0xc0, 0xc0, 0x7c, 0xf6, 0xd1, 0x23, 0x43, 0xb7, 0xe2, 0x0f, 0xc3, 0x3e, 0xde,
+	0x7a, 0xae, 0x08, 0x67, 0xa5, 0x87, 0xfb, 0x68, 0xcb, 0xe3, 0x9b, 0x91, 0x7d, 0xb8, 0xf3, 0x69,
+	0x46, 0x53, 0xe8, 0xea, 0x79, 0x45, 0x76, 0xe5, 0x10, 0x67, 0x34, 0x87, 0x3b, 0x06, 0x1c, 0x7d,
+	0x03, 0x2d, 0xd9, 0xa4, 0xc8, 0x32, 0xb4, 0x4a, 0x83, 0x6c, 0x5b, 0xdb, 0x06, 0x0a, 0xcd, 0x60,
+	0x50, 0x5a, 0x7d, 0xdb, 0x39, 0x8e, 0x6f, 0x9c, 0x6c, 0x34, 0xf5, 0x04, 0xba, 0x7a, 0x58, 0x76,
+	0xd0, 0x1c, 0x6e, 0x86, 0x62, 0xce, 0xd6, 0x14, 0x7a, 0x79, 0x1b, 0xef, 0xe0, 0x38, 0xda, 0xd5,
+	0xf6, 0xe8, 0x4b, 0xe8, 0xca, 0xfe, 0x9b, 0xf8, 0x3e, 0x7a, 0x68, 0x68, 0x9a, 0x4d, 0x69, 0x0f,
+	0x8d, 0x03, 0xf9, 0x53, 0x8b, 0xbe, 0x82, 0xbe, 0xd4, 0xf8, 0x29, 0xf2, 0xb1, 0x20, 0x77, 0xb1,
+	0x3c, 0x25, 0x01, 0xb9, 0x95, 0xe5, 0xbc, 0x2d, 0xff, 0xb1, 0x3f, 0xff, 0x77, 0x00, 0xeb, 0x9e,
+	0x21, 0x22, 0x76, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
-var _ grpc.ClientConn
+var _ grpc.ClientConnInterface
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+const _ = grpc.SupportPackageIsVersion6
 
 // PluginClient is the client API for Plugin service.
 //
@@ -957,16 +1082,17 @@ type PluginClient interface {
 	Print(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintResponse, error)
 	ObjectStatus(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*ObjectStatusResponse, error)
 	PrintTab(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*PrintTabResponse, error)
+	Relations(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*RelationsResponse, error)
 	WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchUpdate(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 	WatchDelete(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type pluginClient struct {
-	cc *grpc.ClientConn
+	cc grpc.ClientConnInterface
 }
 
-func NewPluginClient(cc *grpc.ClientConn) PluginClient {
+func NewPluginClient(cc grpc.ClientConnInterface) PluginClient {
 	return &pluginClient{cc}
 }
 
@@ -1033,6 +1159,15 @@ func (c *pluginClient) PrintTab(ctx context.Context, in *ObjectRequest, opts ...
 	return out, nil
 }
 
+func (c *pluginClient) Relations(ctx context.Context, in *ObjectRequest, opts ...grpc.CallOption) (*RelationsResponse, error) {
+	out := new(RelationsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Plugin/Relations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pluginClient) WatchAdd(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/dashboard.Plugin/WatchAdd", in, out, opts...)
@@ -1069,6 +1204,7 @@ type PluginServer interface {
 	Print(context.Context, *ObjectRequest) (*PrintResponse, error)
 	ObjectStatus(context.Context, *ObjectRequest) (*ObjectStatusResponse, error)
 	PrintTab(context.Context, *ObjectRequest) (*PrintTabResponse, error)
+	Relations(context.Context, *ObjectRequest) (*RelationsResponse, error)
 	WatchAdd(context.Context, *WatchRequest) (*Empty, error)
 	WatchUpdate(context.Context, *WatchRequest) (*Empty, error)
 	WatchDelete(context.Context, *WatchRequest) (*Empty, error)
@@ -1099,6 +1235,9 @@ func (*UnimplementedPluginServer) ObjectStatus(ctx context.Context, req *ObjectR
 func (*UnimplementedPluginServer) PrintTab(ctx context.Context, req *ObjectRequest) (*PrintTabResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrintTab not implemented")
 }
+func (*UnimplementedPluginServer) Relations(ctx context.Context, req *ObjectRequest) (*RelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Relations not implemented")
+}
 func (*UnimplementedPluginServer) WatchAdd(ctx context.Context, req *WatchRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WatchAdd not implemented")
 }
@@ -1239,6 +1378,24 @@ func _Plugin_PrintTab_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_Relations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).Relations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Plugin/Relations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).Relations(ctx, req.(*ObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Plugin_WatchAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WatchRequest)
 	if err := dec(in); err != nil {
@@ -1325,6 +1482,10 @@ var _Plugin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PrintTab",
 			Handler:    _Plugin_PrintTab_Handler,
 		},
+		{
+			MethodName: "Relations",
+			Handler:    _Plugin_Relations_Handler,
+		},
 		{
 			MethodName: "WatchAdd",
 			Handler:    _Plugin_WatchAdd_Handler,
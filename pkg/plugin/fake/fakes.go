@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/vmware-tanzu/octant/pkg/plugin (interfaces: Runners,ManagerStore,ClientFactory,ModuleService,Service,Broker)
+// Source: github.com/vmware-tanzu/octant/pkg/plugin (interfaces: Runners,ManagerStore,ClientFactory,ModuleService,Service,Broker,JSPlugin)
 
 // Package fake is a generated GoMock package.
 package fake
@@ -71,6 +71,21 @@ func (mr *MockRunnersMockRecorder) Print(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockRunners)(nil).Print), arg0)
 }
 
+// Relations mocks base method
+func (m *MockRunners) Relations(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan plugin.RelationsResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relations", arg0)
+	ret0, _ := ret[0].(plugin.DefaultRunner)
+	ret1, _ := ret[1].(chan plugin.RelationsResponse)
+	return ret0, ret1
+}
+
+// Relations indicates an expected call of Relations
+func (mr *MockRunnersMockRecorder) Relations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockRunners)(nil).Relations), arg0)
+}
+
 // Tab mocks base method
 func (m *MockRunners) Tab(arg0 plugin.ManagerStore) (plugin.DefaultRunner, chan component.Tab) {
 	m.ctrl.T.Helper()
@@ -211,6 +226,18 @@ func (mr *MockManagerStoreMockRecorder) NamesJS() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamesJS", reflect.TypeOf((*MockManagerStore)(nil).NamesJS))
 }
 
+// Remove mocks base method
+func (m *MockManagerStore) Remove(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Remove", arg0)
+}
+
+// Remove indicates an expected call of Remove
+func (mr *MockManagerStoreMockRecorder) Remove(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockManagerStore)(nil).Remove), arg0)
+}
+
 // RemoveJS mocks base method
 func (m *MockManagerStore) RemoveJS(arg0 string) {
 	m.ctrl.T.Helper()
@@ -275,17 +302,17 @@ func (m *MockClientFactory) EXPECT() *MockClientFactoryMockRecorder {
 }
 
 // Init mocks base method
-func (m *MockClientFactory) Init(arg0 context.Context, arg1 string) plugin.Client {
+func (m *MockClientFactory) Init(arg0 context.Context, arg1 string, arg2 *plugin.LogTail) plugin.Client {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Init", arg0, arg1)
+	ret := m.ctrl.Call(m, "Init", arg0, arg1, arg2)
 	ret0, _ := ret[0].(plugin.Client)
 	return ret0
 }
 
 // Init indicates an expected call of Init
-func (mr *MockClientFactoryMockRecorder) Init(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockClientFactoryMockRecorder) Init(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockClientFactory)(nil).Init), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockClientFactory)(nil).Init), arg0, arg1, arg2)
 }
 
 // MockModuleService is a mock of ModuleService interface
@@ -415,6 +442,21 @@ func (mr *MockModuleServiceMockRecorder) Register(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockModuleService)(nil).Register), arg0, arg1)
 }
 
+// Relations mocks base method
+func (m *MockModuleService) Relations(arg0 context.Context, arg1 runtime.Object) (plugin.RelationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relations", arg0, arg1)
+	ret0, _ := ret[0].(plugin.RelationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relations indicates an expected call of Relations
+func (mr *MockModuleServiceMockRecorder) Relations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockModuleService)(nil).Relations), arg0, arg1)
+}
+
 // MockService is a mock of Service interface
 type MockService struct {
 	ctrl     *gomock.Controller
@@ -512,6 +554,21 @@ func (mr *MockServiceMockRecorder) Register(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockService)(nil).Register), arg0, arg1)
 }
 
+// Relations mocks base method
+func (m *MockService) Relations(arg0 context.Context, arg1 runtime.Object) (plugin.RelationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relations", arg0, arg1)
+	ret0, _ := ret[0].(plugin.RelationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relations indicates an expected call of Relations
+func (mr *MockServiceMockRecorder) Relations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockService)(nil).Relations), arg0, arg1)
+}
+
 // MockBroker is a mock of Broker interface
 type MockBroker struct {
 	ctrl     *gomock.Controller
@@ -560,3 +617,199 @@ func (mr *MockBrokerMockRecorder) NextId() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextId", reflect.TypeOf((*MockBroker)(nil).NextId))
 }
+
+// MockJSPlugin is a mock of JSPlugin interface
+type MockJSPlugin struct {
+	ctrl     *gomock.Controller
+	recorder *MockJSPluginMockRecorder
+}
+
+// MockJSPluginMockRecorder is the mock recorder for MockJSPlugin
+type MockJSPluginMockRecorder struct {
+	mock *MockJSPlugin
+}
+
+// NewMockJSPlugin creates a new mock instance
+func NewMockJSPlugin(ctrl *gomock.Controller) *MockJSPlugin {
+	mock := &MockJSPlugin{ctrl: ctrl}
+	mock.recorder = &MockJSPluginMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJSPlugin) EXPECT() *MockJSPluginMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method
+func (m *MockJSPlugin) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close
+func (mr *MockJSPluginMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockJSPlugin)(nil).Close))
+}
+
+// Content mocks base method
+func (m *MockJSPlugin) Content(arg0 context.Context, arg1 string) (component.ContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Content", arg0, arg1)
+	ret0, _ := ret[0].(component.ContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Content indicates an expected call of Content
+func (mr *MockJSPluginMockRecorder) Content(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Content", reflect.TypeOf((*MockJSPlugin)(nil).Content), arg0, arg1)
+}
+
+// HandleAction mocks base method
+func (m *MockJSPlugin) HandleAction(arg0 context.Context, arg1 string, arg2 action.Payload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleAction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleAction indicates an expected call of HandleAction
+func (mr *MockJSPluginMockRecorder) HandleAction(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAction", reflect.TypeOf((*MockJSPlugin)(nil).HandleAction), arg0, arg1, arg2)
+}
+
+// Metadata mocks base method
+func (m *MockJSPlugin) Metadata() *plugin.Metadata {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Metadata")
+	ret0, _ := ret[0].(*plugin.Metadata)
+	return ret0
+}
+
+// Metadata indicates an expected call of Metadata
+func (mr *MockJSPluginMockRecorder) Metadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*MockJSPlugin)(nil).Metadata))
+}
+
+// Navigation mocks base method
+func (m *MockJSPlugin) Navigation(arg0 context.Context) (navigation.Navigation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Navigation", arg0)
+	ret0, _ := ret[0].(navigation.Navigation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Navigation indicates an expected call of Navigation
+func (mr *MockJSPluginMockRecorder) Navigation(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Navigation", reflect.TypeOf((*MockJSPlugin)(nil).Navigation), arg0)
+}
+
+// ObjectStatus mocks base method
+func (m *MockJSPlugin) ObjectStatus(arg0 context.Context, arg1 runtime.Object) (plugin.ObjectStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStatus", arg0, arg1)
+	ret0, _ := ret[0].(plugin.ObjectStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectStatus indicates an expected call of ObjectStatus
+func (mr *MockJSPluginMockRecorder) ObjectStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStatus", reflect.TypeOf((*MockJSPlugin)(nil).ObjectStatus), arg0, arg1)
+}
+
+// PluginPath mocks base method
+func (m *MockJSPlugin) PluginPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PluginPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// PluginPath indicates an expected call of PluginPath
+func (mr *MockJSPluginMockRecorder) PluginPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PluginPath", reflect.TypeOf((*MockJSPlugin)(nil).PluginPath))
+}
+
+// Print mocks base method
+func (m *MockJSPlugin) Print(arg0 context.Context, arg1 runtime.Object) (plugin.PrintResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Print", arg0, arg1)
+	ret0, _ := ret[0].(plugin.PrintResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Print indicates an expected call of Print
+func (mr *MockJSPluginMockRecorder) Print(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockJSPlugin)(nil).Print), arg0, arg1)
+}
+
+// PrintTab mocks base method
+func (m *MockJSPlugin) PrintTab(arg0 context.Context, arg1 runtime.Object) (plugin.TabResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintTab", arg0, arg1)
+	ret0, _ := ret[0].(plugin.TabResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrintTab indicates an expected call of PrintTab
+func (mr *MockJSPluginMockRecorder) PrintTab(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintTab", reflect.TypeOf((*MockJSPlugin)(nil).PrintTab), arg0, arg1)
+}
+
+// Register mocks base method
+func (m *MockJSPlugin) Register(arg0 context.Context, arg1 string) (plugin.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0, arg1)
+	ret0, _ := ret[0].(plugin.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register
+func (mr *MockJSPluginMockRecorder) Register(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockJSPlugin)(nil).Register), arg0, arg1)
+}
+
+// Relations mocks base method
+func (m *MockJSPlugin) Relations(arg0 context.Context, arg1 runtime.Object) (plugin.RelationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relations", arg0, arg1)
+	ret0, _ := ret[0].(plugin.RelationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relations indicates an expected call of Relations
+func (mr *MockJSPluginMockRecorder) Relations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockJSPlugin)(nil).Relations), arg0, arg1)
+}
+
+// Stats mocks base method
+func (m *MockJSPlugin) Stats() plugin.JSPluginStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(plugin.JSPluginStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats
+func (mr *MockJSPluginMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockJSPlugin)(nil).Stats))
+}
@@ -69,6 +69,49 @@ func (mr *MockManagerInterfaceMockRecorder) Print(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MockManagerInterface)(nil).Print), arg0, arg1)
 }
 
+// Relations mocks base method
+func (m *MockManagerInterface) Relations(arg0 context.Context, arg1 runtime.Object) ([]store.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relations", arg0, arg1)
+	ret0, _ := ret[0].([]store.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relations indicates an expected call of Relations
+func (mr *MockManagerInterfaceMockRecorder) Relations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockManagerInterface)(nil).Relations), arg0, arg1)
+}
+
+// SetEnabled mocks base method
+func (m *MockManagerInterface) SetEnabled(arg0 context.Context, arg1 string, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEnabled", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEnabled indicates an expected call of SetEnabled
+func (mr *MockManagerInterfaceMockRecorder) SetEnabled(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEnabled", reflect.TypeOf((*MockManagerInterface)(nil).SetEnabled), arg0, arg1, arg2)
+}
+
+// Statuses mocks base method
+func (m *MockManagerInterface) Statuses() []plugin.PluginStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Statuses")
+	ret0, _ := ret[0].([]plugin.PluginStatus)
+	return ret0
+}
+
+// Statuses indicates an expected call of Statuses
+func (mr *MockManagerInterfaceMockRecorder) Statuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Statuses", reflect.TypeOf((*MockManagerInterface)(nil).Statuses))
+}
+
 // Store mocks base method
 func (m *MockManagerInterface) Store() plugin.ManagerStore {
 	m.ctrl.T.Helper()
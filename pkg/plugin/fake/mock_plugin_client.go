@@ -177,6 +177,26 @@ func (mr *MockPluginClientMockRecorder) PrintTab(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintTab", reflect.TypeOf((*MockPluginClient)(nil).PrintTab), varargs...)
 }
 
+// Relations mocks base method
+func (m *MockPluginClient) Relations(ctx context.Context, in *dashboard.ObjectRequest, opts ...grpc.CallOption) (*dashboard.RelationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Relations", varargs...)
+	ret0, _ := ret[0].(*dashboard.RelationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relations indicates an expected call of Relations
+func (mr *MockPluginClientMockRecorder) Relations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockPluginClient)(nil).Relations), varargs...)
+}
+
 // WatchAdd mocks base method
 func (m *MockPluginClient) WatchAdd(ctx context.Context, in *dashboard.WatchRequest, opts ...grpc.CallOption) (*dashboard.Empty, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +385,21 @@ func (mr *MockPluginServerMockRecorder) PrintTab(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintTab", reflect.TypeOf((*MockPluginServer)(nil).PrintTab), arg0, arg1)
 }
 
+// Relations mocks base method
+func (m *MockPluginServer) Relations(arg0 context.Context, arg1 *dashboard.ObjectRequest) (*dashboard.RelationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relations", arg0, arg1)
+	ret0, _ := ret[0].(*dashboard.RelationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relations indicates an expected call of Relations
+func (mr *MockPluginServerMockRecorder) Relations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relations", reflect.TypeOf((*MockPluginServer)(nil).Relations), arg0, arg1)
+}
+
 // WatchAdd mocks base method
 func (m *MockPluginServer) WatchAdd(arg0 context.Context, arg1 *dashboard.WatchRequest) (*dashboard.Empty, error) {
 	m.ctrl.T.Helper()
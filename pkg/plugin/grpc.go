@@ -197,6 +197,35 @@ func (c *GRPCClient) ObjectStatus(ctx context.Context, object runtime.Object) (O
 	return osr, nil
 }
 
+// Relations gets the keys of objects related to an object.
+func (c *GRPCClient) Relations(ctx context.Context, object runtime.Object) (RelationsResponse, error) {
+	var rr RelationsResponse
+
+	err := c.run(func() error {
+		in, err := createObjectRequest(object)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.client.Relations(ctx, in, grpc.WaitForReady(true))
+		if err != nil {
+			return errors.Wrap(err, "grpc client relations")
+		}
+
+		rr = RelationsResponse{
+			Keys: convertToRelationsKeys(resp.Keys),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return RelationsResponse{}, err
+	}
+
+	return rr, nil
+}
+
 // Print prints an object.
 func (c *GRPCClient) Print(ctx context.Context, object runtime.Object) (PrintResponse, error) {
 	var pr PrintResponse
@@ -442,6 +471,25 @@ func (s *GRPCServer) ObjectStatus(ctx context.Context, objectRequest *dashboard.
 	return out, nil
 }
 
+// Relations finds the keys of objects related to an object.
+func (s *GRPCServer) Relations(ctx context.Context, objectRequest *dashboard.ObjectRequest) (*dashboard.RelationsResponse, error) {
+	u, err := decodeObjectRequest(objectRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	rr, err := s.Impl.Relations(ctx, u)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc server relations")
+	}
+
+	out := &dashboard.RelationsResponse{
+		Keys: convertFromRelationsKeys(rr.Keys),
+	}
+
+	return out, nil
+}
+
 func decodeObjectRequest(req *dashboard.ObjectRequest) (*unstructured.Unstructured, error) {
 	m := map[string]interface{}{}
 
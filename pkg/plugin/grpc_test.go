@@ -24,6 +24,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/dashboard"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 	"github.com/vmware-tanzu/octant/pkg/view/flexlayout"
 )
@@ -142,11 +143,12 @@ func Test_GRPCClient_Register(t *testing.T) {
 			PluginName:  "my-plugin",
 			Description: "description",
 			Capabilities: &dashboard.RegisterResponse_Capabilities{
-				SupportsPrinterConfig: inGVKs,
-				SupportsPrinterStatus: inGVKs,
-				SupportsPrinterItems:  inGVKs,
-				SupportsObjectStatus:  inGVKs,
-				SupportsTab:           inGVKs,
+				SupportsPrinterConfig:   inGVKs,
+				SupportsPrinterStatus:   inGVKs,
+				SupportsPrinterItems:    inGVKs,
+				SupportsObjectStatus:    inGVKs,
+				SupportsTab:             inGVKs,
+				SupportsObjectRelations: inGVKs,
 			},
 		}
 
@@ -164,11 +166,12 @@ func Test_GRPCClient_Register(t *testing.T) {
 			Name:        "my-plugin",
 			Description: "description",
 			Capabilities: plugin.Capabilities{
-				SupportsPrinterConfig: outGVKs,
-				SupportsPrinterStatus: outGVKs,
-				SupportsPrinterItems:  outGVKs,
-				SupportsObjectStatus:  outGVKs,
-				SupportsTab:           outGVKs,
+				SupportsPrinterConfig:   outGVKs,
+				SupportsPrinterStatus:   outGVKs,
+				SupportsPrinterItems:    outGVKs,
+				SupportsObjectStatus:    outGVKs,
+				SupportsTab:             outGVKs,
+				SupportsObjectRelations: outGVKs,
 			},
 		}
 		assert.Equal(t, expected, got)
@@ -314,6 +317,39 @@ func Test_GRPCClient_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCClient_Relations(t *testing.T) {
+	testWithGRPCClient(t, func(mocks *grpcClientMocks) {
+		object := testutil.CreatePod("pod")
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+		objectRequest := &dashboard.ObjectRequest{
+			Object: objectData,
+		}
+
+		relationsResponse := &dashboard.RelationsResponse{
+			Keys: []*dashboard.RelationsResponse_Key{
+				{Namespace: "default", ApiVersion: "v1", Kind: "ConfigMap", Name: "config"},
+			},
+		}
+
+		mocks.protoClient.EXPECT().Relations(gomock.Any(), gomock.Eq(objectRequest), grpc.WaitForReady(true)).Return(relationsResponse, nil)
+
+		client := mocks.genClient()
+		ctx := context.Background()
+		got, err := client.Relations(ctx, object)
+		require.NoError(t, err)
+
+		expected := plugin.RelationsResponse{
+			Keys: []store.Key{
+				{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "config"},
+			},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func Test_GRPCServer_Content(t *testing.T) {
 	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
 		server := mocks.genModuleServer()
@@ -368,11 +404,12 @@ func Test_GRPCServer_Register(t *testing.T) {
 			Name:        "my-plugin",
 			Description: "description",
 			Capabilities: plugin.Capabilities{
-				SupportsPrinterConfig: inGVKs,
-				SupportsPrinterStatus: inGVKs,
-				SupportsPrinterItems:  inGVKs,
-				SupportsObjectStatus:  inGVKs,
-				SupportsTab:           inGVKs,
+				SupportsPrinterConfig:   inGVKs,
+				SupportsPrinterStatus:   inGVKs,
+				SupportsPrinterItems:    inGVKs,
+				SupportsObjectStatus:    inGVKs,
+				SupportsTab:             inGVKs,
+				SupportsObjectRelations: inGVKs,
 			},
 		}
 
@@ -393,11 +430,12 @@ func Test_GRPCServer_Register(t *testing.T) {
 			PluginName:  "my-plugin",
 			Description: "description",
 			Capabilities: &dashboard.RegisterResponse_Capabilities{
-				SupportsPrinterConfig: outGVKs,
-				SupportsPrinterStatus: outGVKs,
-				SupportsPrinterItems:  outGVKs,
-				SupportsObjectStatus:  outGVKs,
-				SupportsTab:           outGVKs,
+				SupportsPrinterConfig:   outGVKs,
+				SupportsPrinterStatus:   outGVKs,
+				SupportsPrinterItems:    outGVKs,
+				SupportsObjectStatus:    outGVKs,
+				SupportsTab:             outGVKs,
+				SupportsObjectRelations: outGVKs,
 			},
 		}
 
@@ -503,6 +541,44 @@ func Test_GRPCServer_ObjectStatus(t *testing.T) {
 	})
 }
 
+func Test_GRPCServer_Relations(t *testing.T) {
+	testWithGRPCServer(t, func(mocks *grpcServerMocks) {
+		object := testutil.CreatePod("pod")
+
+		rr := plugin.RelationsResponse{
+			Keys: []store.Key{
+				{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "config"},
+			},
+		}
+
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+		require.NoError(t, err)
+		u := &unstructured.Unstructured{Object: m}
+
+		mocks.service.EXPECT().Relations(gomock.Any(), gomock.Eq(u)).Return(rr, nil)
+
+		objectData, err := json.Marshal(object)
+		require.NoError(t, err)
+		objectRequest := &dashboard.ObjectRequest{
+			Object: objectData,
+		}
+
+		ctx := context.Background()
+
+		server := mocks.genServer()
+		got, err := server.Relations(ctx, objectRequest)
+		require.NoError(t, err)
+
+		expected := &dashboard.RelationsResponse{
+			Keys: []*dashboard.RelationsResponse_Key{
+				{Namespace: "default", ApiVersion: "v1", Kind: "ConfigMap", Name: "config"},
+			},
+		}
+
+		assert.Equal(t, expected, got)
+	})
+}
+
 func encodeComponent(t *testing.T, view component.Component) []byte {
 	data, err := json.Marshal(view)
 	require.NoError(t, err)
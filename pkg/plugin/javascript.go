@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/dop251/goja_nodejs/console"
 	"github.com/dop251/goja_nodejs/eventloop"
 	"github.com/dop251/goja_nodejs/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -22,6 +25,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/action"
 	"github.com/vmware-tanzu/octant/pkg/log"
 	"github.com/vmware-tanzu/octant/pkg/navigation"
+	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 	"github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
@@ -45,12 +49,18 @@ type JSPlugin interface {
 	Print(ctx context.Context, object runtime.Object) (PrintResponse, error)
 	PrintTab(ctx context.Context, object runtime.Object) (TabResponse, error)
 	ObjectStatus(ctx context.Context, object runtime.Object) (ObjectStatusResponse, error)
+	Relations(ctx context.Context, object runtime.Object) (RelationsResponse, error)
 	HandleAction(ctx context.Context, actionName string, payload action.Payload) error
 	Content(ctx context.Context, contentPath string) (component.ContentResponse, error)
+	Stats() JSPluginStats
 }
 
 type jsPlugin struct {
 	loop *eventloop.EventLoop
+	vm   *goja.Runtime
+
+	limits jsLimits
+	stats  *jsPluginStats
 
 	metadata    *Metadata
 	pluginClass *goja.Object
@@ -61,85 +71,113 @@ type jsPlugin struct {
 	metadataExtractor pluginMetadataExtractor
 
 	objectStore store.Store
+	dashboard   api.Service
 	mu          sync.Mutex
 	ctx         context.Context
+	cancel      context.CancelFunc
 	logger      log.Logger
 }
 
 var _ JSPlugin = (*jsPlugin)(nil)
 
-// NewJSPlugin creates a new instances of a JavaScript plugin.
-func NewJSPlugin(ctx context.Context, objectStore store.Store, pluginPath string, prf pluginRuntimeFactory, pce pluginClassExtractor, pme pluginMetadataExtractor) (*jsPlugin, error) {
+// NewJSPlugin creates a new instances of a JavaScript plugin. The plugin
+// uses dashboard for the parts of the dashboard API which aren't backed by
+// the object store.
+func NewJSPlugin(ctx context.Context, objectStore store.Store, dashboard api.Service, pluginPath string, prf pluginRuntimeFactory, pce pluginClassExtractor, pme pluginMetadataExtractor) (*jsPlugin, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
 	loop, err := prf(ctx, pluginPath)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("initializing runtime: %w", err)
 	}
 
 	var pluginClass *goja.Object
 	var metadata *Metadata
 
-	errCh := make(chan error)
-
+	vmCh := make(chan *goja.Runtime, 1)
 	loop.RunOnLoop(func(vm *goja.Runtime) {
-		var err error
+		vmCh <- vm
+	})
+	vm := <-vmCh
+
+	limits := defaultJSLimits
 
+	err = runJS(loop, vm, limits, func(vm *goja.Runtime) error {
 		buf, err := ioutil.ReadFile(pluginPath)
 		if err != nil {
-			errCh <- fmt.Errorf("reading script: %w", err)
+			return fmt.Errorf("reading script: %w", err)
 		}
-		program, err := goja.Compile(pluginPath, string(buf), false)
+		program, err := compileJS(olog.From(ctx), pluginPath, string(buf), limits.instructions > 0)
 		if err != nil {
-			errCh <- fmt.Errorf("compiling: %w", err)
+			return fmt.Errorf("compiling: %w", err)
 		}
 		_, err = vm.RunProgram(program)
 		if err != nil {
-			errCh <- fmt.Errorf("script execution: %w", err)
+			return fmt.Errorf("script execution: %w", err)
 		}
 
-		vm.Set("httpClient", createHTTPClientObject(vm, pluginClass))
+		vm.Set("httpClient", createHTTPClientObject(ctx, vm, loop, pluginClass))
 
 		gc := &dashboardClient{
 			objectStore: objectStore,
+			dashboard:   dashboard,
 			vm:          vm,
+			loop:        loop,
 			ctx:         ctx,
 		}
 		vm.Set("dashboardClient", createClientObject(gc))
 
 		pluginClass, err = pce(vm)
 		if err != nil {
-			errCh <- fmt.Errorf("loading pluginClass: %w", err)
+			return fmt.Errorf("loading pluginClass: %w", err)
 		}
 
 		metadata, err = pme(vm, pluginClass)
 		if err != nil {
-			errCh <- fmt.Errorf("loading metadata: %w", err)
+			return fmt.Errorf("loading metadata: %w", err)
 		}
-		errCh <- nil
-
+		return nil
 	})
-
-	err = <-errCh
 	if err != nil {
+		cancel()
+		loop.Stop()
 		return nil, err
 	}
 
 	plugin := &jsPlugin{
 		loop:              loop,
+		vm:                vm,
+		limits:            limits,
+		stats:             newJSPluginStats(),
 		pluginClass:       pluginClass,
 		metadata:          metadata,
 		runtimeFactory:    prf,
 		classExtractor:    pce,
 		metadataExtractor: pme,
 		pluginPath:        pluginPath,
+		objectStore:       objectStore,
+		dashboard:         dashboard,
 		ctx:               ctx,
+		cancel:            cancel,
 	}
 
 	return plugin, nil
 }
 
-// Close closes the dashboard client connection.
+// Close stops the plugin's runtime, its watches and its HTTP requests, and
+// closes the dashboard client connection. Running JavaScript is interrupted
+// so a plugin which never returns can still be closed.
 func (t *jsPlugin) Close() {
+	t.cancel()
+	t.vm.Interrupt("plugin closed")
 	t.loop.Stop()
+
+	if closer, ok := t.dashboard.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			olog.From(t.ctx).WithErr(err).Errorf("close dashboard client for plugin %s", t.pluginPath)
+		}
+	}
 }
 
 // PluginPath returns the pluginPath.
@@ -152,41 +190,32 @@ func (t *jsPlugin) Navigation(_ context.Context) (navigation.Navigation, error)
 	defer t.mu.Unlock()
 
 	nav := navigation.Navigation{}
-	errCh := make(chan error)
-
-	t.loop.RunOnLoop(func(vm *goja.Runtime) {
+	err := t.call("navigationHandler", func(vm *goja.Runtime) error {
 		handler, err := vm.RunString("_concretePlugin.navigationHandler")
 		if err != nil {
-			errCh <- fmt.Errorf("unable to load navigationHandler from plugin: %w", err)
-			return
+			return fmt.Errorf("unable to load navigationHandler from plugin: %w", err)
 		}
 
 		cHandler, ok := goja.AssertFunction(handler)
 		if !ok {
-			errCh <- fmt.Errorf("navigationHandler is not callable")
-			return
+			return fmt.Errorf("navigationHandler is not callable")
 		}
 
 		s, err := cHandler(t.pluginClass)
 		if err != nil {
-			errCh <- fmt.Errorf("calling navigationHandler: %w", err)
-			return
+			return fmt.Errorf("calling navigationHandler: %w", err)
 		}
 
 		jsonNav, err := json.Marshal(s.Export())
 		if err != nil {
-			errCh <- fmt.Errorf("unable to marshal navigation json: %w", err)
-			return
+			return fmt.Errorf("unable to marshal navigation json: %w", err)
 		}
 
 		if err := json.Unmarshal(jsonNav, &nav); err != nil {
-			errCh <- fmt.Errorf("unable to unmarshal navigation json: %w", err)
-			return
+			return fmt.Errorf("unable to unmarshal navigation json: %w", err)
 		}
-		errCh <- nil
+		return nil
 	})
-
-	err := <-errCh
 	if err != nil {
 		return nav, err
 	}
@@ -199,67 +228,55 @@ func (t *jsPlugin) Content(_ context.Context, contentPath string) (component.Con
 	defer t.mu.Unlock()
 
 	cr := component.ContentResponse{}
-	errCh := make(chan error)
-
-	t.loop.RunOnLoop(func(vm *goja.Runtime) {
+	err := t.call("contentHandler", func(vm *goja.Runtime) error {
 		handler, err := vm.RunString("_concretePlugin.contentHandler")
 		if err != nil {
-			errCh <- fmt.Errorf("unable to load contentHandler from plugin: %w", err)
-			return
+			return fmt.Errorf("unable to load contentHandler from plugin: %w", err)
 		}
 
 		cHandler, ok := goja.AssertFunction(handler)
 		if !ok {
-			errCh <- fmt.Errorf("contentHandler is not callable")
-			return
+			return fmt.Errorf("contentHandler is not callable")
 		}
 		obj := vm.NewObject()
 		if err := obj.Set("contentPath", vm.ToValue(contentPath)); err != nil {
-			errCh <- fmt.Errorf("unable to set contentPath: %w", err)
-			return
+			return fmt.Errorf("unable to set contentPath: %w", err)
 		}
 		s, err := cHandler(t.pluginClass, obj)
 		if err != nil {
-			errCh <- fmt.Errorf("calling contentHandler: %w", err)
-			return
+			return fmt.Errorf("calling contentHandler: %w", err)
 		}
 
 		pluginResp := s.ToObject(vm)
 		if pluginResp == nil {
-			errCh <- fmt.Errorf("empty contentResponse")
-			return
+			return fmt.Errorf("empty contentResponse")
 		}
 
 		content := pluginResp.Get("content")
 		if content == goja.Undefined() {
-			errCh <- fmt.Errorf("unable to get content from contentResponse")
-			return
+			return fmt.Errorf("unable to get content from contentResponse")
 		}
 
 		contentObj, ok := content.Export().(map[string]interface{})
 		if !ok {
-			errCh <- fmt.Errorf("unable to get content as map from contentResponse")
-			return
+			return fmt.Errorf("unable to get content as map from contentResponse")
 		}
 
 		rawTitle, ok := contentObj["title"]
 		if ok {
 			titles, ok := rawTitle.([]interface{})
 			if !ok {
-				errCh <- fmt.Errorf("unable to get title array from content")
-				return
+				return fmt.Errorf("unable to get title array from content")
 			}
 			for i, c := range titles {
 				realTitle, err := extractComponent(fmt.Sprintf("title[%d]", i), c)
 				if err != nil {
-					errCh <- fmt.Errorf("unable to extract title: %w", err)
-					return
+					return fmt.Errorf("unable to extract title: %w", err)
 				}
 
 				title, ok := realTitle.(component.TitleComponent)
 				if !ok {
-					errCh <- fmt.Errorf("unable to convert component to TitleComponent")
-					return
+					return fmt.Errorf("unable to convert component to TitleComponent")
 				}
 				cr.Title = append(cr.Title, title)
 			}
@@ -267,21 +284,18 @@ func (t *jsPlugin) Content(_ context.Context, contentPath string) (component.Con
 
 		rawComponents, ok := contentObj["viewComponents"]
 		if !ok {
-			errCh <- fmt.Errorf("unable to get viewComponents from content")
-			return
+			return fmt.Errorf("unable to get viewComponents from content")
 		}
 
 		components, ok := rawComponents.([]interface{})
 		if !ok {
-			errCh <- fmt.Errorf("unable to get viewComponents list")
-			return
+			return fmt.Errorf("unable to get viewComponents list")
 		}
 
 		for i, c := range components {
 			realComponent, err := extractComponent(fmt.Sprintf("viewComponent[%d]", i), c)
 			if err != nil {
-				errCh <- fmt.Errorf("unable to extract component: %w", err)
-				return
+				return fmt.Errorf("unable to extract component: %w", err)
 			}
 			cr.Add(realComponent)
 		}
@@ -290,22 +304,19 @@ func (t *jsPlugin) Content(_ context.Context, contentPath string) (component.Con
 		if ok {
 			realButtonGroup, err := extractComponent("buttonGroup", rawButtonGroup)
 			if err != nil {
-				errCh <- fmt.Errorf("unable to extract buttonGroup: %w", err)
-				return
+				return fmt.Errorf("unable to extract buttonGroup: %w", err)
 			}
 
 			buttonGroup, ok := realButtonGroup.(*component.ButtonGroup)
 			if !ok {
-				errCh <- fmt.Errorf("unable to convert extracted component to buttonGroup")
-				return
+				return fmt.Errorf("unable to convert extracted component to buttonGroup")
 			}
 
 			cr.ButtonGroup = buttonGroup
 		}
-		errCh <- nil
+		return nil
 	})
-
-	if err := <-errCh; err != nil {
+	if err != nil {
 		return cr, err
 	}
 	return cr, nil
@@ -391,23 +402,54 @@ func (t *jsPlugin) ObjectStatus(_ context.Context, object runtime.Object) (Objec
 	}, nil
 }
 
-func (t *jsPlugin) HandleAction(_ context.Context, actionPath string, payload action.Payload) error {
+func (t *jsPlugin) Relations(_ context.Context, object runtime.Object) (RelationsResponse, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	errCh := make(chan error)
+	relationsResponse, err := t.objectRequestCall("relationsHandler", object)
+	if err != nil {
+		return RelationsResponse{}, err
+	}
+
+	keys := relationsResponse.Get("keys")
+	if keys == nil || goja.IsUndefined(keys) {
+		return RelationsResponse{}, fmt.Errorf("keys property not found")
+	}
+
+	jsonKeys, err := json.Marshal(keys.Export())
+	if err != nil {
+		return RelationsResponse{}, fmt.Errorf("unable to marshal keys: %w", err)
+	}
+
+	var storeKeys []store.Key
+	if err := json.Unmarshal(jsonKeys, &storeKeys); err != nil {
+		return RelationsResponse{}, fmt.Errorf("unable to unmarshal keys: %w", err)
+	}
+
+	return RelationsResponse{
+		Keys: storeKeys,
+	}, nil
+}
+
+func (t *jsPlugin) HandleAction(ctx context.Context, actionPath string, payload action.Payload) error {
+	return t.handleAction(ctx, nil, actionPath, payload)
+}
 
-	t.loop.RunOnLoop(func(vm *goja.Runtime) {
+// handleAction calls the plugin's action handler. The handler can send alerts
+// with the request's sendAlert function. If alerter is nil, alerts are logged.
+func (t *jsPlugin) handleAction(_ context.Context, alerter action.Alerter, actionPath string, payload action.Payload) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.call("actionHandler", func(vm *goja.Runtime) error {
 		handler, err := vm.RunString("_concretePlugin.actionHandler")
 		if err != nil {
-			errCh <- fmt.Errorf("unable to load actionHandler from plugin: %w", err)
-			return
+			return fmt.Errorf("unable to load actionHandler from plugin: %w", err)
 		}
 
 		cHandler, ok := goja.AssertFunction(handler)
 		if !ok {
-			errCh <- fmt.Errorf("actionHandler is not callable")
-			return
+			return fmt.Errorf("actionHandler is not callable")
 		}
 
 		var pl map[string]interface{}
@@ -415,37 +457,62 @@ func (t *jsPlugin) HandleAction(_ context.Context, actionPath string, payload ac
 
 		obj := vm.NewObject()
 		if err := obj.Set("actionName", vm.ToValue(actionPath)); err != nil {
-			errCh <- fmt.Errorf("unable to set actionName: %w", err)
-			return
+			return fmt.Errorf("unable to set actionName: %w", err)
 		}
 		if err := obj.Set("payload", pl); err != nil {
-			errCh <- fmt.Errorf("unable to set payload: %w", err)
-			return
+			return fmt.Errorf("unable to set payload: %w", err)
+		}
+		if err := obj.Set("sendAlert", t.sendAlert(vm, alerter)); err != nil {
+			return fmt.Errorf("unable to set sendAlert: %w", err)
 		}
 
 		s, err := cHandler(t.pluginClass, obj)
 		if err != nil {
-			errCh <- fmt.Errorf("calling actionHandler: %w", err)
-			return
+			return fmt.Errorf("calling actionHandler: %w", err)
 		}
 
 		if s != goja.Undefined() {
 			if jsErr := s.ToObject(vm); jsErr != nil {
 				errStr := jsErr.Get("error")
 				if errStr != goja.Undefined() {
-					errCh <- fmt.Errorf("%s actionHandler: %q", t.pluginPath, jsErr.Get("error"))
-					return
+					return fmt.Errorf("%s actionHandler: %q", t.pluginPath, jsErr.Get("error"))
 				}
 			}
 		}
-		errCh <- nil
+		return nil
 	})
+}
 
-	if err := <-errCh; err != nil {
-		return err
-	}
+// sendAlert creates a function which sends an alert. It is called with the
+// alert's type, its message, and optionally, its expiration in seconds.
+func (t *jsPlugin) sendAlert(vm *goja.Runtime, alerter action.Alerter) func(goja.FunctionCall) goja.Value {
+	return func(c goja.FunctionCall) goja.Value {
+		alertType := action.AlertType(strings.ToUpper(c.Argument(0).String()))
+		switch alertType {
+		case action.AlertTypeError, action.AlertTypeWarning, action.AlertTypeInfo, action.AlertTypeSuccess:
+		default:
+			return vm.NewTypeError(fmt.Errorf("sendAlert: invalid alert type %q", alertType))
+		}
 
-	return nil
+		message := c.Argument(1).String()
+		if message == "" {
+			return vm.NewTypeError(fmt.Errorf("sendAlert: empty message"))
+		}
+
+		expiration := action.DefaultAlertExpiration
+		if v := c.Argument(2); !goja.IsUndefined(v) && !goja.IsNull(v) {
+			expiration = time.Duration(v.ToFloat() * float64(time.Second))
+		}
+
+		alert := action.CreateAlert(alertType, message, expiration)
+		if alerter == nil {
+			olog.From(t.ctx).With("plugin", t.pluginPath, "alert", alert).Infof("plugin alert")
+			return goja.Undefined()
+		}
+
+		alerter.SendAlert(alert)
+		return goja.Undefined()
+	}
 }
 
 func (t *jsPlugin) Print(_ context.Context, object runtime.Object) (PrintResponse, error) {
@@ -500,42 +567,35 @@ func (t *jsPlugin) Print(_ context.Context, object runtime.Object) (PrintRespons
 }
 
 func (t *jsPlugin) objectRequestCall(handlerName string, object runtime.Object) (*goja.Object, error) {
-	errCh := make(chan error)
 	var response *goja.Object
 
-	t.loop.RunOnLoop(func(vm *goja.Runtime) {
+	err := t.call(handlerName, func(vm *goja.Runtime) error {
 		handler, err := vm.RunString(fmt.Sprintf("_concretePlugin.%s", handlerName))
 		if err != nil {
-			errCh <- fmt.Errorf("unable to load %s from plugin: %w", handlerName, err)
-			return
+			return fmt.Errorf("unable to load %s from plugin: %w", handlerName, err)
 		}
 
 		cHandler, ok := goja.AssertFunction(handler)
 		if !ok {
-			errCh <- fmt.Errorf("%s is not callable", handlerName)
-			return
+			return fmt.Errorf("%s is not callable", handlerName)
 		}
 
 		obj := vm.NewObject()
 		if err := obj.Set("object", vm.ToValue(object)); err != nil {
-			errCh <- fmt.Errorf("unable to set object: %w", err)
-			return
+			return fmt.Errorf("unable to set object: %w", err)
 		}
 		s, err := cHandler(t.pluginClass, obj)
 		if err != nil {
-			errCh <- err
-			return
+			return err
 		}
 
 		response = s.ToObject(vm)
 		if response == nil {
-			errCh <- fmt.Errorf("no status found")
-			return
+			return fmt.Errorf("no status found")
 		}
-		errCh <- nil
+		return nil
 	})
-
-	if err := <-errCh; err != nil {
+	if err != nil {
 		return nil, err
 	}
 
@@ -748,6 +808,12 @@ func ExtractMetadata(vm *goja.Runtime, pluginValue goja.Value) (*Metadata, error
 					return nil, fmt.Errorf("extractGvks: %w", err)
 				}
 				metadata.Capabilities.SupportsTab = append(metadata.Capabilities.SupportsTab, GVKs...)
+			case "supportObjectRelations":
+				GVKs, err := extractGvk(k, v)
+				if err != nil {
+					return nil, fmt.Errorf("extractGvks: %w", err)
+				}
+				metadata.Capabilities.SupportsObjectRelations = append(metadata.Capabilities.SupportsObjectRelations, GVKs...)
 			case "actionNames":
 				actions, err := extractActions(v)
 				if err != nil {
@@ -823,10 +889,31 @@ func (l logPrinter) Error(msg string) {
 
 type dashboardClient struct {
 	objectStore store.Store
+	dashboard   api.Service
 	vm          *goja.Runtime
+	loop        *eventloop.EventLoop
 	ctx         context.Context
 }
 
+// jsPortForwardRequest is a port forward request from a plugin.
+type jsPortForwardRequest struct {
+	Namespace     string `json:"namespace"`
+	PodName       string `json:"podName"`
+	ContainerName string `json:"containerName"`
+	Port          uint16 `json:"port"`
+}
+
+// jsExecCommandRequest is an exec command request from a plugin. Command is
+// either a list of arguments or a string which is run with /bin/sh. Timeout
+// is in seconds.
+type jsExecCommandRequest struct {
+	Namespace     string      `json:"namespace"`
+	PodName       string      `json:"podName"`
+	ContainerName string      `json:"containerName"`
+	Command       interface{} `json:"command"`
+	Timeout       float64     `json:"timeout"`
+}
+
 func (d *dashboardClient) Delete(c goja.FunctionCall) goja.Value {
 	var key store.Key
 	obj := c.Argument(0).ToObject(d.vm)
@@ -875,7 +962,41 @@ func (d *dashboardClient) List(c goja.FunctionCall) goja.Value {
 	return d.vm.ToValue(items)
 }
 
+// Create creates an object. It is called with an object, or with a namespace
+// and YAML which may contain multiple objects to create or update.
 func (d *dashboardClient) Create(c goja.FunctionCall) goja.Value {
+	if u, ok := d.objectArgument(c.Argument(0)); ok {
+		if v := d.requireAPI("Create"); v != nil {
+			return v
+		}
+
+		if err := d.dashboard.Create(d.ctx, u); err != nil {
+			return d.vm.NewGoError(fmt.Errorf("dashboardClient.Create: %w", err))
+		}
+		return goja.Undefined()
+	}
+
+	return d.createOrUpdateFromYAML(c)
+}
+
+// Update updates an object. It is called with an object, or with a namespace
+// and YAML which may contain multiple objects to create or update.
+func (d *dashboardClient) Update(c goja.FunctionCall) goja.Value {
+	if u, ok := d.objectArgument(c.Argument(0)); ok {
+		if v := d.requireAPI("Update"); v != nil {
+			return v
+		}
+
+		if err := d.dashboard.Update(d.ctx, u); err != nil {
+			return d.vm.NewGoError(fmt.Errorf("dashboardClient.Update: %w", err))
+		}
+		return goja.Undefined()
+	}
+
+	return d.createOrUpdateFromYAML(c)
+}
+
+func (d *dashboardClient) createOrUpdateFromYAML(c goja.FunctionCall) goja.Value {
 	namespace := c.Argument(0).String()
 	update := c.Argument(1).String()
 
@@ -895,35 +1016,277 @@ func (d *dashboardClient) Create(c goja.FunctionCall) goja.Value {
 	return d.vm.ToValue(results)
 }
 
-func createClientObject(d *dashboardClient) goja.Value {
-	obj := d.vm.NewObject()
-	if err := obj.Set("Get", d.Get); err != nil {
-		return d.vm.NewGoError(err)
+// Watch calls a handler with an event when an object matching a key is added,
+// modified, or deleted. It returns a function which stops the watch.
+func (d *dashboardClient) Watch(c goja.FunctionCall) goja.Value {
+	if v := d.requireAPI("Watch"); v != nil {
+		return v
 	}
-	if err := obj.Set("List", d.List); err != nil {
-		return d.vm.NewGoError(err)
+
+	var key store.Key
+	obj := c.Argument(0).ToObject(d.vm)
+	if err := d.vm.ExportTo(obj, &key); err != nil {
+		return d.vm.NewTypeError(fmt.Errorf("dashboardClient.Watch: %w", err))
 	}
-	if err := obj.Set("Create", d.Create); err != nil {
-		return d.vm.NewGoError(err)
+
+	handler, ok := goja.AssertFunction(c.Argument(1))
+	if !ok {
+		return d.vm.NewTypeError(fmt.Errorf("dashboardClient.Watch: handler is not callable"))
 	}
-	if err := obj.Set("Update", d.Create); err != nil {
-		return d.vm.NewGoError(err)
+
+	ctx, cancel := context.WithCancel(d.ctx)
+
+	events, err := d.dashboard.Watch(ctx, key)
+	if err != nil {
+		cancel()
+		return d.vm.NewGoError(fmt.Errorf("dashboardClient.Watch: %w", err))
 	}
-	if err := obj.Set("Delete", d.Delete); err != nil {
-		return d.vm.NewGoError(err)
+
+	go func() {
+		logger := olog.From(ctx)
+
+		for event := range events {
+			if event.Object == nil {
+				continue
+			}
+
+			event := event
+			d.loop.RunOnLoop(func(vm *goja.Runtime) {
+				if ctx.Err() != nil {
+					return
+				}
+
+				value := vm.ToValue(map[string]interface{}{
+					"type":   string(event.Type),
+					"object": event.Object.Object,
+				})
+				if _, err := handler(goja.Undefined(), value); err != nil {
+					logger.WithErr(err).Errorf("dashboardClient.Watch handler for %s", key)
+				}
+			})
+		}
+	}()
+
+	return d.vm.ToValue(func(goja.FunctionCall) goja.Value {
+		cancel()
+		return goja.Undefined()
+	})
+}
+
+// PortForward creates a port forward to a pod.
+func (d *dashboardClient) PortForward(c goja.FunctionCall) goja.Value {
+	if v := d.requireAPI("PortForward"); v != nil {
+		return v
+	}
+
+	var req jsPortForwardRequest
+	obj := c.Argument(0).ToObject(d.vm)
+	if err := d.vm.ExportTo(obj, &req); err != nil {
+		return d.vm.NewTypeError(fmt.Errorf("dashboardClient.PortForward: %w", err))
+	}
+
+	resp, err := d.dashboard.PortForward(d.ctx, api.PortForwardRequest{
+		Namespace:     req.Namespace,
+		PodName:       req.PodName,
+		ContainerName: req.ContainerName,
+		Port:          req.Port,
+	})
+	if err != nil {
+		return d.vm.NewGoError(fmt.Errorf("dashboardClient.PortForward: %w", err))
+	}
+
+	return d.vm.ToValue(map[string]interface{}{
+		"id":   resp.ID,
+		"port": resp.Port,
+	})
+}
+
+// CancelPortForward cancels a port forward.
+func (d *dashboardClient) CancelPortForward(c goja.FunctionCall) goja.Value {
+	if v := d.requireAPI("CancelPortForward"); v != nil {
+		return v
+	}
+
+	id := c.Argument(0).String()
+	if id == "" {
+		return d.vm.NewTypeError(fmt.Errorf("dashboardClient.CancelPortForward: empty id"))
+	}
+
+	d.dashboard.CancelPortForward(d.ctx, id)
+	return goja.Undefined()
+}
+
+// ListNamespaces lists the names of the cluster's namespaces.
+func (d *dashboardClient) ListNamespaces(_ goja.FunctionCall) goja.Value {
+	if v := d.requireAPI("ListNamespaces"); v != nil {
+		return v
+	}
+
+	resp, err := d.dashboard.ListNamespaces(d.ctx)
+	if err != nil {
+		return d.vm.NewGoError(fmt.Errorf("dashboardClient.ListNamespaces: %w", err))
+	}
+
+	namespaces := make([]interface{}, len(resp.Namespaces))
+	for i := range resp.Namespaces {
+		namespaces[i] = resp.Namespaces[i]
+	}
+
+	return d.vm.ToValue(namespaces)
+}
+
+// ForceFrontendUpdate forces the frontend to update.
+func (d *dashboardClient) ForceFrontendUpdate(_ goja.FunctionCall) goja.Value {
+	if v := d.requireAPI("ForceFrontendUpdate"); v != nil {
+		return v
+	}
+
+	if err := d.dashboard.ForceFrontendUpdate(d.ctx); err != nil {
+		return d.vm.NewGoError(fmt.Errorf("dashboardClient.ForceFrontendUpdate: %w", err))
+	}
+
+	return goja.Undefined()
+}
+
+// ExecCommand runs a command in a container and returns its output and
+// exit code.
+func (d *dashboardClient) ExecCommand(c goja.FunctionCall) goja.Value {
+	if v := d.requireAPI("ExecCommand"); v != nil {
+		return v
+	}
+
+	var req jsExecCommandRequest
+	obj := c.Argument(0).ToObject(d.vm)
+	if err := d.vm.ExportTo(obj, &req); err != nil {
+		return d.vm.NewTypeError(fmt.Errorf("dashboardClient.ExecCommand: %w", err))
+	}
+
+	var command []string
+	switch v := req.Command.(type) {
+	case string:
+		command = []string{"/bin/sh", "-c", v}
+	case []interface{}:
+		for i := range v {
+			command = append(command, fmt.Sprintf("%v", v[i]))
+		}
+	default:
+		return d.vm.NewTypeError(fmt.Errorf("dashboardClient.ExecCommand: command must be a string or a list of strings"))
+	}
+
+	resp, err := d.dashboard.ExecCommand(d.ctx, api.ExecCommandRequest{
+		Namespace:     req.Namespace,
+		PodName:       req.PodName,
+		ContainerName: req.ContainerName,
+		Command:       command,
+		Timeout:       time.Duration(req.Timeout * float64(time.Second)),
+	})
+	if err != nil {
+		return d.vm.NewGoError(fmt.Errorf("dashboardClient.ExecCommand: %w", err))
+	}
+
+	return d.vm.ToValue(map[string]interface{}{
+		"stdout":   resp.Stdout,
+		"stderr":   resp.Stderr,
+		"exitCode": resp.ExitCode,
+		"timedOut": resp.TimedOut,
+	})
+}
+
+// requireAPI returns an error value if the dashboard API isn't available.
+func (d *dashboardClient) requireAPI(name string) goja.Value {
+	if d.dashboard == nil {
+		return d.vm.NewGoError(fmt.Errorf("dashboardClient.%s: dashboard API is not available", name))
+	}
+
+	return nil
+}
+
+// objectArgument converts a JavaScript object to an unstructured object. It
+// returns false if the value is not an object.
+func (d *dashboardClient) objectArgument(v goja.Value) (*unstructured.Unstructured, bool) {
+	obj, ok := v.(*goja.Object)
+	if !ok {
+		return nil, false
+	}
+
+	data, err := json.Marshal(obj.Export())
+	if err != nil {
+		return nil, false
+	}
+
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, false
+	}
+
+	return &unstructured.Unstructured{Object: m}, true
+}
+
+func createClientObject(d *dashboardClient) goja.Value {
+	obj := d.vm.NewObject()
+
+	funcs := []struct {
+		name string
+		fn   func(goja.FunctionCall) goja.Value
+	}{
+		{name: "Get", fn: d.Get},
+		{name: "List", fn: d.List},
+		{name: "Create", fn: d.Create},
+		{name: "Update", fn: d.Update},
+		{name: "Delete", fn: d.Delete},
+		{name: "Watch", fn: d.Watch},
+		{name: "PortForward", fn: d.PortForward},
+		{name: "CancelPortForward", fn: d.CancelPortForward},
+		{name: "ListNamespaces", fn: d.ListNamespaces},
+		{name: "ForceFrontendUpdate", fn: d.ForceFrontendUpdate},
+		{name: "ExecCommand", fn: d.ExecCommand},
+	}
+
+	for _, f := range funcs {
+		if err := obj.Set(f.name, f.fn); err != nil {
+			return d.vm.NewGoError(err)
+		}
 	}
 	return obj
 }
 
+const httpClientTimeout = 10 * time.Second
+
 type httpClient struct {
+	ctx  context.Context
 	vm   *goja.Runtime
+	loop *eventloop.EventLoop
 	this *goja.Object
 }
 
-func createHTTPClientObject(vm *goja.Runtime, this *goja.Object) goja.Value {
+// httpResponse is the response a plugin receives from fetch and post.
+type httpResponse struct {
+	Status     int
+	StatusText string
+	Headers    map[string]string
+	Body       string
+}
+
+func (r httpResponse) toValue(vm *goja.Runtime) goja.Value {
+	headers := make(map[string]interface{}, len(r.Headers))
+	for k, v := range r.Headers {
+		headers[k] = v
+	}
+
+	return vm.ToValue(map[string]interface{}{
+		"status":     r.Status,
+		"statusText": r.StatusText,
+		"headers":    headers,
+		"body":       r.Body,
+	})
+}
+
+func createHTTPClientObject(ctx context.Context, vm *goja.Runtime, loop *eventloop.EventLoop, this *goja.Object) goja.Value {
 	client := vm.NewObject()
 	h := &httpClient{
+		ctx:  ctx,
 		vm:   vm,
+		loop: loop,
 		this: this,
 	}
 	if err := client.Set("get", h.get); err != nil {
@@ -935,6 +1298,9 @@ func createHTTPClientObject(vm *goja.Runtime, this *goja.Object) goja.Value {
 	if err := client.Set("post", h.post); err != nil {
 		return vm.NewTypeError(fmt.Errorf("httpClient.Set.post: %w", err))
 	}
+	if err := client.Set("fetch", h.fetch); err != nil {
+		return vm.NewTypeError(fmt.Errorf("httpClient.Set.fetch: %w", err))
+	}
 	return client
 }
 
@@ -954,7 +1320,7 @@ func (h *httpClient) _get(c goja.FunctionCall) (goja.Callable, []byte, error) {
 		return nil, nil, fmt.Errorf("bad callback function")
 	}
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := &http.Client{Timeout: httpClientTimeout}
 	r, err := client.Get(urlArg)
 	if err != nil {
 		return nil, nil, fmt.Errorf("get: %w", err)
@@ -996,6 +1362,178 @@ func (h *httpClient) getJSON(c goja.FunctionCall) goja.Value {
 	return cr
 }
 
-func (h *httpClient) post(_ goja.FunctionCall) goja.Value {
-	return h.vm.NewGoError(fmt.Errorf("not implemented"))
+// post sends a POST request and calls a callback with the response. It is
+// called with a url, a body, and a callback. Bodies which are objects are
+// sent as JSON.
+func (h *httpClient) post(c goja.FunctionCall) goja.Value {
+	if len(c.Arguments) != 3 {
+		return h.vm.NewTypeError(fmt.Errorf("post: invalid arguments"))
+	}
+
+	urlArg := c.Argument(0).String()
+	if urlArg == "" {
+		return h.vm.NewTypeError(fmt.Errorf("post: empty url"))
+	}
+
+	callback, ok := goja.AssertFunction(c.Argument(2))
+	if !ok {
+		return h.vm.NewTypeError(fmt.Errorf("post: bad callback function"))
+	}
+
+	body, contentType, err := requestBody(c.Argument(1))
+	if err != nil {
+		return h.vm.NewTypeError(fmt.Errorf("post: %w", err))
+	}
+
+	headers := map[string]string{}
+	if contentType != "" {
+		headers["Content-Type"] = contentType
+	}
+
+	resp, err := h.do(h.ctx, http.MethodPost, urlArg, headers, body)
+	if err != nil {
+		return h.vm.NewGoError(fmt.Errorf("post: %w", err))
+	}
+
+	cr, err := callback(h.this, resp.toValue(h.vm))
+	if err != nil {
+		return h.vm.NewTypeError(fmt.Errorf("post: %w", err))
+	}
+	return cr
+}
+
+// fetch sends a request without blocking the plugin. It is called with a
+// url, optional options with a method, headers, and a body, and a callback.
+// The callback is called on the event loop with an error message, or null,
+// and the response.
+func (h *httpClient) fetch(c goja.FunctionCall) goja.Value {
+	urlArg := c.Argument(0).String()
+	if urlArg == "" {
+		return h.vm.NewTypeError(fmt.Errorf("fetch: empty url"))
+	}
+
+	optionsArg := goja.Undefined()
+	callbackArg := c.Argument(1)
+	if len(c.Arguments) > 2 {
+		optionsArg = c.Argument(1)
+		callbackArg = c.Argument(2)
+	}
+
+	callback, ok := goja.AssertFunction(callbackArg)
+	if !ok {
+		return h.vm.NewTypeError(fmt.Errorf("fetch: bad callback function"))
+	}
+
+	method := http.MethodGet
+	headers := map[string]string{}
+	var body []byte
+
+	if options, ok := optionsArg.(*goja.Object); ok {
+		if v := options.Get("method"); v != nil && !goja.IsUndefined(v) {
+			method = strings.ToUpper(v.String())
+		}
+
+		if v := options.Get("headers"); v != nil && !goja.IsUndefined(v) {
+			if err := h.vm.ExportTo(v, &headers); err != nil {
+				return h.vm.NewTypeError(fmt.Errorf("fetch: headers: %w", err))
+			}
+		}
+
+		if v := options.Get("body"); v != nil {
+			b, contentType, err := requestBody(v)
+			if err != nil {
+				return h.vm.NewTypeError(fmt.Errorf("fetch: %w", err))
+			}
+			body = b
+
+			if _, ok := headers["Content-Type"]; !ok && contentType != "" {
+				headers["Content-Type"] = contentType
+			}
+		}
+	}
+
+	go func() {
+		resp, err := h.do(h.ctx, method, urlArg, headers, body)
+
+		h.loop.RunOnLoop(func(vm *goja.Runtime) {
+			if h.ctx.Err() != nil {
+				return
+			}
+
+			errValue := goja.Null()
+			respValue := goja.Undefined()
+			if err != nil {
+				errValue = vm.ToValue(err.Error())
+			} else {
+				respValue = resp.toValue(vm)
+			}
+
+			if _, err := callback(goja.Undefined(), errValue, respValue); err != nil {
+				olog.From(h.ctx).WithErr(err).Errorf("httpClient.fetch callback for %s", urlArg)
+			}
+		})
+	}()
+
+	return goja.Undefined()
+}
+
+func (h *httpClient) do(ctx context.Context, method, url string, headers map[string]string, body []byte) (httpResponse, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return httpResponse{}, err
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	client := &http.Client{Timeout: httpClientTimeout}
+	r, err := client.Do(req)
+	if err != nil {
+		return httpResponse{}, err
+	}
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return httpResponse{}, err
+	}
+
+	resp := httpResponse{
+		Status:     r.StatusCode,
+		StatusText: r.Status,
+		Headers:    map[string]string{},
+		Body:       string(data),
+	}
+	for k := range r.Header {
+		resp.Headers[k] = r.Header.Get(k)
+	}
+
+	return resp, nil
+}
+
+// requestBody converts a request body to bytes. Strings are sent as is, and
+// objects are sent as JSON.
+func requestBody(v goja.Value) ([]byte, string, error) {
+	if v == nil || goja.IsUndefined(v) || goja.IsNull(v) {
+		return nil, "", nil
+	}
+
+	if _, ok := v.(*goja.Object); !ok {
+		return []byte(v.String()), "", nil
+	}
+
+	data, err := json.Marshal(v.Export())
+	if err != nil {
+		return nil, "", fmt.Errorf("encode body: %w", err)
+	}
+
+	return data, "application/json", nil
 }
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja/ast"
+	"github.com/dop251/goja/file"
+	"github.com/dop251/goja/parser"
+
+	"github.com/vmware-tanzu/octant/pkg/log"
+)
+
+const (
+	jsStepsName          = "__octantSteps"
+	jsStepLimitName      = "__octantStepLimit"
+	jsStepExceededName   = "__octantStepLimitExceeded"
+	jsStepCheck          = "if(++" + jsStepsName + ">" + jsStepLimitName + ")" + jsStepExceededName + "();"
+	jsStepCheckStatement = ";" + jsStepCheck
+
+	jsSpace = " \t\n\r"
+)
+
+var jsASTPackage = reflect.TypeOf(ast.Program{}).PkgPath()
+
+// jsInsert is text inserted into a script at a byte offset.
+type jsInsert struct {
+	offset int
+	text   string
+}
+
+// instrumentJS counts the steps a script takes. goja doesn't count the
+// instructions it runs, so a step check is added to the start of every
+// function body and loop iteration. The check calls the step limit exceeded
+// function once the step count passes the step limit.
+func instrumentJS(name, src string) (string, error) {
+	program, err := parser.ParseFile(nil, name, src, 0)
+	if err != nil {
+		return "", err
+	}
+
+	var inserts []jsInsert
+	var loopErr error
+	addLoop := func(body ast.Statement) {
+		insert, err := jsLoopInsert(src, body)
+		if err != nil {
+			loopErr = err
+			return
+		}
+		inserts = append(inserts, insert)
+	}
+
+	walkJS(reflect.ValueOf(program), make(map[interface{}]bool), func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.FunctionLiteral:
+			if body, ok := n.Body.(*ast.BlockStatement); ok {
+				inserts = append(inserts, jsInsert{offset: jsFunctionBodyStart(src, body), text: jsStepCheckStatement})
+			}
+		case *ast.ForStatement:
+			addLoop(n.Body)
+		case *ast.ForInStatement:
+			addLoop(n.Body)
+		case *ast.WhileStatement:
+			addLoop(n.Body)
+		case *ast.DoWhileStatement:
+			addLoop(n.Body)
+		}
+	})
+
+	if loopErr != nil {
+		return "", loopErr
+	}
+
+	sort.SliceStable(inserts, func(i, j int) bool {
+		return inserts[i].offset < inserts[j].offset
+	})
+
+	var sb strings.Builder
+	last := 0
+	for _, insert := range inserts {
+		sb.WriteString(src[last:insert.offset])
+		sb.WriteString(insert.text)
+		last = insert.offset
+	}
+	sb.WriteString(src[last:])
+
+	return sb.String(), nil
+}
+
+// compileJS compiles a script. If instrument is true, the script is
+// instrumented to count its steps first. A script which can't be
+// instrumented is compiled as it is, so it only has a timeout.
+func compileJS(logger log.Logger, name, src string, instrument bool) (*goja.Program, error) {
+	if instrument {
+		instrumented, err := instrumentJS(name, src)
+		if err == nil {
+			var program *goja.Program
+			program, err = goja.Compile(name, instrumented, false)
+			if err == nil {
+				return program, nil
+			}
+		}
+		logger.WithErr(err).With("plugin", name).
+			Warnf("unable to instrument plugin; its calls have no instruction budget")
+	}
+
+	return goja.Compile(name, src, false)
+}
+
+// jsOffset converts a parser index to a byte offset in the source.
+func jsOffset(idx file.Idx) int {
+	return int(idx) - 1
+}
+
+// jsFunctionBodyStart returns where the step check goes in a function body.
+// It goes after the body's directive prologue so "use strict" still applies.
+func jsFunctionBodyStart(src string, body *ast.BlockStatement) int {
+	offset := jsOffset(body.LeftBrace) + 1
+	for _, stmt := range body.List {
+		es, ok := stmt.(*ast.ExpressionStatement)
+		if !ok {
+			break
+		}
+		lit, ok := es.Expression.(*ast.StringLiteral)
+		if !ok || strings.HasSuffix(strings.TrimRight(src[:jsOffset(lit.Idx)], jsSpace), "(") {
+			break
+		}
+		offset = jsOffset(lit.Idx) + len(lit.Literal)
+	}
+	return offset
+}
+
+// jsLoopInsert makes the loop body the else branch of a step check. This
+// works for bodies which aren't blocks without having to find where they end.
+func jsLoopInsert(src string, body ast.Statement) (jsInsert, error) {
+	offset, ok := jsStart(src, body)
+	if !ok {
+		return jsInsert{}, fmt.Errorf("find start of %T loop body", body)
+	}
+
+	return jsInsert{offset: offset, text: " " + jsStepCheck + "else "}, nil
+}
+
+// jsStart returns the byte offset where a node starts. The parser doesn't
+// record where some statements start, puts the start of a postfix
+// expression like a++ at its operator, and drops parentheses, so starts are
+// found from the node's leftmost child and the source before it.
+func jsStart(src string, node ast.Node) (int, bool) {
+	switch n := node.(type) {
+	case *ast.ExpressionStatement:
+		return jsStart(src, n.Expression)
+	case *ast.IfStatement:
+		return jsKeywordBefore(src, n.Test, "if")
+	case *ast.WhileStatement:
+		return jsKeywordBefore(src, n.Test, "while")
+	case *ast.DoWhileStatement:
+		return jsKeywordBefore(src, n.Body, "do")
+	case *ast.SwitchStatement:
+		return jsKeywordBefore(src, n.Discriminant, "switch")
+	case *ast.WithStatement:
+		return jsKeywordBefore(src, n.Object, "with")
+	case *ast.UnaryExpression:
+		if n.Postfix {
+			return jsStart(src, n.Operand)
+		}
+	case *ast.AssignExpression:
+		return jsStart(src, n.Left)
+	case *ast.BinaryExpression:
+		return jsStart(src, n.Left)
+	case *ast.BracketExpression:
+		return jsStart(src, n.Left)
+	case *ast.CallExpression:
+		return jsStart(src, n.Callee)
+	case *ast.ConditionalExpression:
+		return jsStart(src, n.Test)
+	case *ast.DotExpression:
+		return jsStart(src, n.Left)
+	case *ast.SequenceExpression:
+		return jsStart(src, n.Sequence[0])
+	}
+
+	if node.Idx0() == 0 {
+		return 0, false
+	}
+
+	return len(strings.TrimRight(src[:jsOffset(node.Idx0())], "("+jsSpace)), true
+}
+
+// jsKeywordBefore returns the byte offset of a keyword which comes before a
+// node, e.g. the if before the test of an if statement.
+func jsKeywordBefore(src string, node ast.Node, keyword string) (int, bool) {
+	offset, ok := jsStart(src, node)
+	if !ok {
+		return 0, false
+	}
+
+	before := strings.TrimRight(src[:offset], "("+jsSpace)
+	if !strings.HasSuffix(before, keyword) {
+		return 0, false
+	}
+
+	return len(before) - len(keyword), true
+}
+
+// walkJS calls visit for every node in a parsed script. Nodes can be
+// referenced more than once, e.g. from a declaration list, so visited nodes
+// are skipped. Pointers outside the ast package, e.g. to the source file,
+// aren't followed.
+func walkJS(v reflect.Value, seen map[interface{}]bool, visit func(node ast.Node)) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || v.Type().Elem().PkgPath() != jsASTPackage {
+			return
+		}
+		key := v.Interface()
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		if node, ok := key.(ast.Node); ok {
+			visit(node)
+		}
+		walkJS(v.Elem(), seen, visit)
+	case reflect.Interface:
+		if !v.IsNil() {
+			walkJS(v.Elem(), seen, visit)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			walkJS(v.Field(i), seen, visit)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkJS(v.Index(i), seen, visit)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"math"
+	"testing"
+
+	"github.com/dop251/goja"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+func Test_instrumentJS(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      string
+		expected interface{}
+		steps    int64
+	}{
+		{
+			name:     "function",
+			src:      `function f(a) { return a + 1; } f(1);`,
+			expected: int64(2),
+			steps:    1,
+		},
+		{
+			name:     "strict function",
+			src:      `function f() { "use strict"; return this === undefined; } f();`,
+			expected: true,
+			steps:    1,
+		},
+		{
+			name:     "loop without block",
+			src:      `var n = 0; for (var i = 0; i < 3; i++) n += i; n;`,
+			expected: int64(3),
+			steps:    3,
+		},
+		{
+			name:     "nested loops without blocks",
+			src:      `var n = 0, i = 0; while (i++ < 2) for (var k in {a: 1, b: 2}) (n++); n;`,
+			expected: int64(4),
+			steps:    6,
+		},
+		{
+			name:     "do while",
+			src:      `var n = 0; do n++; while (n < 3); n;`,
+			expected: int64(3),
+			steps:    3,
+		},
+		{
+			name:     "dangling else",
+			src:      `var n = 0; if (true) while (n < 2) n++; else n = 10; n;`,
+			expected: int64(2),
+			steps:    2,
+		},
+		{
+			name:     "if body",
+			src:      `var n = 0; for (var i = 0; i < 3; i++) if (i) n++; n;`,
+			expected: int64(2),
+			steps:    3,
+		},
+		{
+			name:     "switch body",
+			src:      `var n = 0; for (var i = 0; i < 2; i++) switch (i) { case 1: n++; } n;`,
+			expected: int64(1),
+			steps:    2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			src, err := instrumentJS("test.js", tc.src)
+			require.NoError(t, err)
+
+			vm := goja.New()
+			vm.Set(jsStepsName, 0)
+			vm.Set(jsStepLimitName, math.Inf(1))
+
+			got, err := vm.RunString(src)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got.Export())
+			assert.Equal(t, tc.steps, vm.Get(jsStepsName).ToInteger())
+		})
+	}
+}
+
+func Test_compileJS(t *testing.T) {
+	src := `var n = 0; while (n < 2) n++; n;`
+
+	program, err := compileJS(log.NopLogger(), "test.js", src, true)
+	require.NoError(t, err)
+
+	vm := goja.New()
+	vm.Set(jsStepsName, 0)
+	vm.Set(jsStepLimitName, 1)
+	vm.Set(jsStepExceededName, func() {
+		vm.Interrupt(ErrJSPluginInstructionBudget)
+	})
+
+	_, err = vm.RunProgram(program)
+	require.Error(t, err)
+
+	program, err = compileJS(log.NopLogger(), "test.js", src, false)
+	require.NoError(t, err)
+
+	got, err := goja.New().RunProgram(program)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), got.Export())
+
+	_, err = compileJS(log.NopLogger(), "test.js", `function (`, true)
+	require.Error(t, err)
+}
+
+func Test_instrumentJS_invalid(t *testing.T) {
+	_, err := instrumentJS("test.js", `function (`)
+	require.Error(t, err)
+}
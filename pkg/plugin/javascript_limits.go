@@ -0,0 +1,304 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/eventloop"
+
+	olog "github.com/vmware-tanzu/octant/internal/log"
+)
+
+const (
+	// DefaultJSPluginCallTimeout is how long a call into a JavaScript plugin
+	// runs before it is interrupted.
+	DefaultJSPluginCallTimeout = 10 * time.Second
+
+	// DefaultJSPluginInstructionBudget is how many steps a call into a
+	// JavaScript plugin can take before it is interrupted. A step is a
+	// function call or a loop iteration.
+	DefaultJSPluginInstructionBudget = 10000000
+
+	// jsInterruptGracePeriod is how long an interrupted call has to return.
+	jsInterruptGracePeriod = time.Second
+
+	// jsPluginUnhealthyTimeouts is the number of consecutive interrupted
+	// calls after which a plugin is unhealthy.
+	jsPluginUnhealthyTimeouts = 3
+
+	// jsPluginUnhealthyRetryInterval is how often an unhealthy plugin is
+	// called to check if it has recovered.
+	jsPluginUnhealthyRetryInterval = time.Minute
+
+	// jsPluginLatencyWindow is the number of recent calls used to find the
+	// latency percentile.
+	jsPluginLatencyWindow = 100
+)
+
+var (
+	// ErrJSPluginTimeout is returned when a call into a JavaScript plugin is
+	// interrupted because it ran longer than its timeout.
+	ErrJSPluginTimeout = errors.New("javascript plugin call timed out")
+
+	// ErrJSPluginInstructionBudget is returned when a call into a JavaScript
+	// plugin is interrupted because it took more steps than its instruction
+	// budget.
+	ErrJSPluginInstructionBudget = errors.New("javascript plugin call exceeded its instruction budget")
+
+	// ErrJSPluginUnhealthy is returned instead of calling a JavaScript plugin
+	// which is unhealthy.
+	ErrJSPluginUnhealthy = errors.New("javascript plugin is unhealthy")
+)
+
+// jsLimits are the limits for a call into a JavaScript plugin. A zero
+// limit is not enforced.
+// The instruction budget is only enforced for scripts instrumented with
+// instrumentJS.
+type jsLimits struct {
+	timeout      time.Duration
+	instructions int64
+}
+
+var defaultJSLimits = jsLimits{
+	timeout:      DefaultJSPluginCallTimeout,
+	instructions: DefaultJSPluginInstructionBudget,
+}
+
+// runJS runs fn on the loop and waits for it to return. If fn runs longer
+// than the timeout or takes more steps than the instruction budget, the
+// runtime is interrupted and an error is returned. Callbacks which run
+// outside of a call, e.g. timers, have no instruction budget.
+func runJS(loop *eventloop.EventLoop, vm *goja.Runtime, limits jsLimits, fn func(vm *goja.Runtime) error) error {
+	errCh := make(chan error, 1)
+
+	loop.RunOnLoop(func(vm *goja.Runtime) {
+		vm.ClearInterrupt()
+
+		exceeded := false
+		vm.Set(jsStepsName, 0)
+		vm.Set(jsStepLimitName, jsStepLimit(limits.instructions))
+		vm.Set(jsStepExceededName, func() {
+			exceeded = true
+			vm.Interrupt(ErrJSPluginInstructionBudget)
+		})
+
+		err := fn(vm)
+		vm.Set(jsStepLimitName, math.Inf(1))
+		if exceeded {
+			vm.ClearInterrupt()
+			err = ErrJSPluginInstructionBudget
+		}
+
+		errCh <- err
+	})
+
+	var timeout <-chan time.Time
+	if limits.timeout > 0 {
+		timer := time.NewTimer(limits.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-timeout:
+		return interruptJS(loop, vm, errCh, ErrJSPluginTimeout)
+	}
+}
+
+// jsStepLimit returns the step limit for an instruction budget. A zero
+// budget has no limit.
+func jsStepLimit(instructions int64) float64 {
+	if instructions <= 0 {
+		return math.Inf(1)
+	}
+	return float64(instructions)
+}
+
+// interruptJS interrupts the runtime and waits for the running call to
+// return. The interrupt is cleared on the loop afterwards so it doesn't
+// affect callbacks which are queued behind the call.
+func interruptJS(loop *eventloop.EventLoop, vm *goja.Runtime, errCh <-chan error, reason error) error {
+	select {
+	case err := <-errCh:
+		return err
+	default:
+	}
+
+	vm.Interrupt(reason)
+
+	select {
+	case <-errCh:
+	case <-time.After(jsInterruptGracePeriod):
+	}
+
+	loop.RunOnLoop(func(vm *goja.Runtime) {
+		vm.ClearInterrupt()
+	})
+
+	return reason
+}
+
+// isJSInterrupt returns true if err is from a call which was interrupted.
+func isJSInterrupt(err error) bool {
+	return errors.Is(err, ErrJSPluginTimeout) || errors.Is(err, ErrJSPluginInstructionBudget)
+}
+
+// JSPluginStats are statistics for calls into a JavaScript plugin.
+type JSPluginStats struct {
+	// Calls is the number of calls into the plugin.
+	Calls int
+	// Errors is the number of calls which returned an error, including
+	// interrupted calls.
+	Errors int
+	// Timeouts is the number of calls which were interrupted because they ran
+	// too long or took too many steps.
+	Timeouts int
+	// P95Latency is the 95th percentile latency of recent calls.
+	P95Latency time.Duration
+	// Healthy is false if the plugin's recent calls keep being interrupted.
+	Healthy bool
+}
+
+// jsPluginStats records calls into a JavaScript plugin and tracks its health.
+type jsPluginStats struct {
+	mu sync.Mutex
+
+	calls    int
+	errors   int
+	timeouts int
+
+	consecutiveTimeouts int
+	unhealthy           bool
+	lastAttempt         time.Time
+
+	latencies []time.Duration
+	next      int
+}
+
+func newJSPluginStats() *jsPluginStats {
+	return &jsPluginStats{
+		latencies: make([]time.Duration, 0, jsPluginLatencyWindow),
+	}
+}
+
+// allow returns true if the plugin can be called. An unhealthy plugin is
+// called once per retry interval to check if it has recovered.
+func (s *jsPluginStats) allow(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.unhealthy {
+		return true
+	}
+
+	if now.Sub(s.lastAttempt) < jsPluginUnhealthyRetryInterval {
+		return false
+	}
+
+	s.lastAttempt = now
+	return true
+}
+
+// record records a call. It returns true if the call made the plugin unhealthy.
+func (s *jsPluginStats) record(now time.Time, latency time.Duration, err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	if err != nil {
+		s.errors++
+	}
+
+	if len(s.latencies) < jsPluginLatencyWindow {
+		s.latencies = append(s.latencies, latency)
+	} else {
+		s.latencies[s.next] = latency
+		s.next = (s.next + 1) % jsPluginLatencyWindow
+	}
+
+	if !isJSInterrupt(err) {
+		s.consecutiveTimeouts = 0
+		s.unhealthy = false
+		return false
+	}
+
+	s.timeouts++
+	s.consecutiveTimeouts++
+	s.lastAttempt = now
+
+	if s.unhealthy || s.consecutiveTimeouts < jsPluginUnhealthyTimeouts {
+		return false
+	}
+
+	s.unhealthy = true
+	return true
+}
+
+func (s *jsPluginStats) snapshot() JSPluginStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return JSPluginStats{
+		Calls:      s.calls,
+		Errors:     s.errors,
+		Timeouts:   s.timeouts,
+		P95Latency: percentile(s.latencies, 0.95),
+		Healthy:    !s.unhealthy,
+	}
+}
+
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// call runs fn on the plugin's loop within the plugin's limits and records
+// it in the plugin's stats. An unhealthy plugin isn't called.
+func (t *jsPlugin) call(name string, fn func(vm *goja.Runtime) error) error {
+	now := time.Now()
+	if !t.stats.allow(now) {
+		return fmt.Errorf("%s %s: %w", t.pluginPath, name, ErrJSPluginUnhealthy)
+	}
+
+	err := runJS(t.loop, t.vm, t.limits, fn)
+	if t.stats.record(time.Now(), time.Since(now), err) {
+		olog.From(t.ctx).With("plugin", t.pluginPath).
+			Errorf("plugin is unhealthy after %d interrupted calls", jsPluginUnhealthyTimeouts)
+	}
+
+	if isJSInterrupt(err) {
+		return fmt.Errorf("%s %s: %w", t.pluginPath, name, err)
+	}
+
+	return err
+}
+
+// Stats returns statistics for calls into the plugin.
+func (t *jsPlugin) Stats() JSPluginStats {
+	return t.stats.snapshot()
+}
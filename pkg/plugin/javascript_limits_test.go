@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_jsPluginStats(t *testing.T) {
+	s := newJSPluginStats()
+	now := time.Now()
+
+	for i := 1; i <= 20; i++ {
+		s.record(now, time.Duration(i)*time.Millisecond, nil)
+	}
+	s.record(now, time.Second, assert.AnError)
+
+	got := s.snapshot()
+	assert.Equal(t, JSPluginStats{
+		Calls:      21,
+		Errors:     1,
+		P95Latency: 20 * time.Millisecond,
+		Healthy:    true,
+	}, got)
+
+	for i := 1; i < jsPluginUnhealthyTimeouts; i++ {
+		assert.False(t, s.record(now, time.Second, ErrJSPluginTimeout))
+	}
+	assert.True(t, s.record(now, time.Second, ErrJSPluginInstructionBudget))
+	assert.False(t, s.snapshot().Healthy)
+
+	assert.False(t, s.allow(now.Add(time.Second)))
+	assert.True(t, s.allow(now.Add(jsPluginUnhealthyRetryInterval)))
+	assert.False(t, s.allow(now.Add(jsPluginUnhealthyRetryInterval+time.Second)))
+
+	s.record(now, time.Millisecond, nil)
+	assert.True(t, s.snapshot().Healthy)
+	assert.True(t, s.allow(now))
+}
+
+func Test_percentile(t *testing.T) {
+	cases := []struct {
+		name      string
+		latencies []time.Duration
+		expected  time.Duration
+	}{
+		{
+			name:     "empty",
+			expected: 0,
+		},
+		{
+			name:      "single",
+			latencies: []time.Duration{time.Second},
+			expected:  time.Second,
+		},
+		{
+			name:      "unsorted",
+			latencies: []time.Duration{3, 1, 2, 5, 4},
+			expected:  5,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, percentile(tc.latencies, 0.95))
+		})
+	}
+}
@@ -0,0 +1,308 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/octant/pkg/action"
+	"github.com/vmware-tanzu/octant/pkg/plugin/api"
+	apiFake "github.com/vmware-tanzu/octant/pkg/plugin/api/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
+)
+
+const testJSPlugin = `
+function TestPlugin() {
+  this.name = "test-plugin";
+  this.description = "test plugin";
+  this.isModule = false;
+  this.capabilities = {
+    actionNames: ["test/api", "test/timer", "test/fetch", "test/watch", "test/spin", "test/noop"]
+  };
+}
+
+TestPlugin.prototype.actionHandler = function(request) {
+  switch (request.actionName) {
+    case "test/api":
+      var namespaces = dashboardClient.ListNamespaces();
+      var pf = dashboardClient.PortForward({ namespace: "default", podName: "pod", port: 8080 });
+      dashboardClient.CancelPortForward(pf.id);
+      var result = dashboardClient.ExecCommand({ namespace: "default", podName: "pod", command: "ls", timeout: 5 });
+      dashboardClient.Update({ apiVersion: "v1", kind: "ConfigMap", metadata: { name: "config", namespace: "default" } });
+      dashboardClient.ForceFrontendUpdate();
+      request.sendAlert("info", namespaces.join(",") + " " + pf.port + " " + result.stdout + " " + result.exitCode);
+      break;
+    case "test/timer":
+      setTimeout(function() {
+        request.sendAlert("success", "timer fired");
+      }, 10);
+      break;
+    case "test/fetch":
+      httpClient.fetch(request.payload.url, {
+        method: "POST",
+        headers: { "X-Test": "yes" },
+        body: { hello: "world" }
+      }, function(err, resp) {
+        if (err) {
+          request.sendAlert("error", err);
+          return;
+        }
+        request.sendAlert("info", resp.status + " " + resp.body);
+      });
+      break;
+    case "test/watch":
+      var stop = dashboardClient.Watch({ namespace: "default", apiVersion: "v1", kind: "Pod" }, function(event) {
+        request.sendAlert("info", event.type + " " + event.object.metadata.name);
+        stop();
+      });
+      break;
+    case "test/spin":
+      while (true) {}
+      break;
+    case "test/noop":
+      break;
+  }
+};
+
+module.exports.default = TestPlugin;
+`
+
+type alertRecorder struct {
+	ch chan action.Alert
+}
+
+var _ action.Alerter = (*alertRecorder)(nil)
+
+func newAlertRecorder() *alertRecorder {
+	return &alertRecorder{ch: make(chan action.Alert, 1)}
+}
+
+func (a *alertRecorder) SendAlert(alert action.Alert) {
+	a.ch <- alert
+}
+
+func (a *alertRecorder) wait(t *testing.T) action.Alert {
+	select {
+	case alert := <-a.ch:
+		return alert
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for alert")
+		return action.Alert{}
+	}
+}
+
+func withTestJSPlugin(t *testing.T, dashboard api.Service, fn func(p *jsPlugin)) {
+	dir, err := ioutil.TempDir("", "js-plugin")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	pluginPath := filepath.Join(dir, "test-plugin.js")
+	require.NoError(t, ioutil.WriteFile(pluginPath, []byte(testJSPlugin), 0600))
+
+	ctx := context.Background()
+	p, err := NewJSPlugin(ctx, nil, dashboard, pluginPath, CreateRuntimeLoop, ExtractDefaultClass, ExtractMetadata)
+	require.NoError(t, err)
+	defer p.Close()
+
+	fn(p)
+}
+
+func Test_jsPlugin_dashboardClient(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboard := apiFake.NewMockService(controller)
+	dashboard.EXPECT().
+		ListNamespaces(gomock.Any()).
+		Return(api.NamespacesResponse{Namespaces: []string{"default", "kube-system"}}, nil)
+	dashboard.EXPECT().
+		PortForward(gomock.Any(), api.PortForwardRequest{Namespace: "default", PodName: "pod", Port: 8080}).
+		Return(api.PortForwardResponse{ID: "id", Port: 40000}, nil)
+	dashboard.EXPECT().
+		CancelPortForward(gomock.Any(), "id")
+	dashboard.EXPECT().
+		ExecCommand(gomock.Any(), api.ExecCommandRequest{
+			Namespace: "default",
+			PodName:   "pod",
+			Command:   []string{"/bin/sh", "-c", "ls"},
+			Timeout:   5 * time.Second,
+		}).
+		Return(api.ExecCommandResponse{Stdout: "out"}, nil)
+	dashboard.EXPECT().
+		Update(gomock.Any(), &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "config",
+				"namespace": "default",
+			},
+		}}).
+		Return(nil)
+	dashboard.EXPECT().
+		ForceFrontendUpdate(gomock.Any()).
+		Return(nil)
+
+	withTestJSPlugin(t, dashboard, func(p *jsPlugin) {
+		alerter := newAlertRecorder()
+
+		ctx := context.Background()
+		require.NoError(t, p.handleAction(ctx, alerter, "test/api", action.Payload{}))
+
+		alert := alerter.wait(t)
+		assert.Equal(t, action.AlertTypeInfo, alert.Type)
+		assert.Equal(t, "default,kube-system 40000 out 0", alert.Message)
+	})
+}
+
+func Test_jsPlugin_setTimeout(t *testing.T) {
+	withTestJSPlugin(t, nil, func(p *jsPlugin) {
+		alerter := newAlertRecorder()
+
+		ctx := context.Background()
+		require.NoError(t, p.handleAction(ctx, alerter, "test/timer", action.Payload{}))
+
+		alert := alerter.wait(t)
+		assert.Equal(t, action.AlertTypeSuccess, alert.Type)
+		assert.Equal(t, "timer fired", alert.Message)
+	})
+}
+
+func Test_jsPlugin_fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost ||
+			r.Header.Get("X-Test") != "yes" ||
+			r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	withTestJSPlugin(t, nil, func(p *jsPlugin) {
+		alerter := newAlertRecorder()
+
+		ctx := context.Background()
+		require.NoError(t, p.handleAction(ctx, alerter, "test/fetch", action.Payload{"url": server.URL}))
+
+		alert := alerter.wait(t)
+		assert.Equal(t, action.AlertTypeInfo, alert.Type)
+		assert.Equal(t, `201 {"hello":"world"}`, alert.Message)
+	})
+}
+
+func Test_jsPlugin_watch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name":      "pod",
+			"namespace": "default",
+		},
+	}}
+
+	events := make(chan api.WatchEvent, 1)
+	events <- api.WatchEvent{Type: api.WatchEventAdded, Object: pod}
+
+	dashboard := apiFake.NewMockService(controller)
+	dashboard.EXPECT().
+		Watch(gomock.Any(), store.Key{Namespace: "default", APIVersion: "v1", Kind: "Pod"}).
+		DoAndReturn(func(ctx context.Context, key store.Key) (<-chan api.WatchEvent, error) {
+			go func() {
+				<-ctx.Done()
+				close(events)
+			}()
+			return events, nil
+		})
+
+	withTestJSPlugin(t, dashboard, func(p *jsPlugin) {
+		alerter := newAlertRecorder()
+
+		ctx := context.Background()
+		require.NoError(t, p.handleAction(ctx, alerter, "test/watch", action.Payload{}))
+
+		alert := alerter.wait(t)
+		assert.Equal(t, action.AlertTypeInfo, alert.Type)
+		assert.Equal(t, "ADDED pod", alert.Message)
+	})
+}
+
+func Test_jsPlugin_timeout(t *testing.T) {
+	withTestJSPlugin(t, nil, func(p *jsPlugin) {
+		p.limits = jsLimits{timeout: 50 * time.Millisecond}
+
+		ctx := context.Background()
+		err := p.handleAction(ctx, nil, "test/spin", action.Payload{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrJSPluginTimeout))
+
+		require.NoError(t, p.handleAction(ctx, nil, "test/noop", action.Payload{}))
+
+		stats := p.Stats()
+		assert.Equal(t, 2, stats.Calls)
+		assert.Equal(t, 1, stats.Errors)
+		assert.Equal(t, 1, stats.Timeouts)
+		assert.True(t, stats.Healthy)
+	})
+}
+
+func Test_jsPlugin_instructionBudget(t *testing.T) {
+	withTestJSPlugin(t, nil, func(p *jsPlugin) {
+		p.limits = jsLimits{instructions: 100000}
+
+		ctx := context.Background()
+		err := p.handleAction(ctx, nil, "test/spin", action.Payload{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrJSPluginInstructionBudget))
+
+		require.NoError(t, p.handleAction(ctx, nil, "test/noop", action.Payload{}))
+		assert.Equal(t, 1, p.Stats().Timeouts)
+	})
+}
+
+func Test_jsPlugin_unhealthy(t *testing.T) {
+	withTestJSPlugin(t, nil, func(p *jsPlugin) {
+		p.limits = jsLimits{timeout: 10 * time.Millisecond}
+
+		ctx := context.Background()
+		for i := 0; i < jsPluginUnhealthyTimeouts; i++ {
+			err := p.handleAction(ctx, nil, "test/spin", action.Payload{})
+			require.True(t, errors.Is(err, ErrJSPluginTimeout))
+		}
+
+		assert.False(t, p.Stats().Healthy)
+
+		err := p.handleAction(ctx, nil, "test/noop", action.Payload{})
+		assert.True(t, errors.Is(err, ErrJSPluginUnhealthy))
+		assert.Equal(t, jsPluginUnhealthyTimeouts, p.Stats().Calls)
+	})
+}
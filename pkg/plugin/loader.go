@@ -57,11 +57,7 @@ func (c *defaultConfig) PluginDirs(home string) ([]string, error) {
 		return []string{}, nil
 	}
 
-	defaultDir := filepath.Join(home, ".config", configDir, "plugins")
-
-	if c.os == "windows" || viper.GetString("xdg-config-home") != "" {
-		defaultDir = filepath.Join(home, configDir, "plugins")
-	}
+	defaultDir := filepath.Join(c.ConfigDir(home), "plugins")
 
 	if path := viper.GetString("plugin-path"); path != "" {
 		path = strings.Trim(path, string(filepath.ListSeparator))
@@ -71,6 +67,15 @@ func (c *defaultConfig) PluginDirs(home string) ([]string, error) {
 	return []string{defaultDir}, nil
 }
 
+// ConfigDir returns the Octant configuration directory in home.
+func (c *defaultConfig) ConfigDir(home string) string {
+	if c.os == "windows" || viper.GetString("xdg-config-home") != "" {
+		return filepath.Join(home, configDir)
+	}
+
+	return filepath.Join(home, ".config", configDir)
+}
+
 func (c *defaultConfig) Home() string {
 	if c.homeFn == nil {
 		c.homeFn = func() string {
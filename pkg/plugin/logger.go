@@ -6,16 +6,64 @@ SPDX-License-Identifier: Apache-2.0
 package plugin
 
 import (
+	"fmt"
 	"io"
 	golog "log"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/vmware-tanzu/octant/pkg/log"
 )
 
+// DefaultLogTailSize is the number of lines a LogTail keeps.
+const DefaultLogTailSize = 50
+
+// LogTail keeps the most recent lines a plugin has logged. The plugin
+// client logs a plugin's stderr, so the tail shows why a plugin stopped.
+type LogTail struct {
+	size  int
+	lines []string
+	mu    sync.Mutex
+}
+
+// NewLogTail creates an instance of LogTail which keeps size lines.
+func NewLogTail(size int) *LogTail {
+	return &LogTail{
+		size: size,
+	}
+}
+
+// Lines returns the lines in the tail, oldest first.
+func (t *LogTail) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lines := make([]string, len(t.lines))
+	copy(lines, t.lines)
+	return lines
+}
+
+func (t *LogTail) add(level hclog.Level, msg string, args ...interface{}) {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[%s] %s", strings.ToUpper(level.String()), msg))
+	for i := 0; i+1 < len(args); i += 2 {
+		sb.WriteString(fmt.Sprintf(" %v=%v", args[i], args[i+1]))
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lines = append(t.lines, sb.String())
+	if len(t.lines) > t.size {
+		t.lines = append([]string(nil), t.lines[len(t.lines)-t.size:]...)
+	}
+}
+
 type zapAdapter struct {
 	dashLogger log.Logger
+	tail       *LogTail
 }
 
 var _ hclog.Logger = (*zapAdapter)(nil)
@@ -25,32 +73,46 @@ var _ hclog.Logger = (*zapAdapter)(nil)
 // vals can be any type, but display is implementation specific
 // Emit a message and key/value pairs at a provided log level
 func (za *zapAdapter) Log(level hclog.Level, msg string, args ...interface{}) {
-	// no-op: zap doesn't handle log
+	// zap doesn't handle log, so it is only recorded in the tail
+	za.record(level, msg, args...)
 }
 
 // Emit a message and key/value pairs at the TRACE level
 func (za *zapAdapter) Trace(msg string, args ...interface{}) {
-	// no-op: zap doesn't handle trace
+	// zap doesn't handle trace, so it is only recorded in the tail
+	za.record(hclog.Trace, msg, args...)
 }
 
 // Emit a message and key/value pairs at the DEBUG level
 func (za *zapAdapter) Debug(msg string, args ...interface{}) {
+	za.record(hclog.Debug, msg, args...)
 	za.dashLogger.With(args...).Debugf(msg)
 }
 
 // Emit a message and key/value pairs at the INFO level
 func (za *zapAdapter) Info(msg string, args ...interface{}) {
+	za.record(hclog.Info, msg, args...)
 	za.dashLogger.With(args...).Infof(msg)
 }
 
 // Emit a message and key/value pairs at the WARN level
 func (za *zapAdapter) Warn(msg string, args ...interface{}) {
+	za.record(hclog.Warn, msg, args...)
 	za.dashLogger.With(args...).Warnf(msg)
 }
 
 // Emit a message and key/value pairs at the ERROR level
 func (za *zapAdapter) Error(msg string, args ...interface{}) {
-	za.dashLogger.With(args).Errorf(msg)
+	za.record(hclog.Error, msg, args...)
+	za.dashLogger.With(args...).Errorf(msg)
+}
+
+// record adds a message to the tail if the adapter has one.
+func (za *zapAdapter) record(level hclog.Level, msg string, args ...interface{}) {
+	if za.tail == nil {
+		return
+	}
+	za.tail.add(level, msg, args...)
 }
 
 // Indicate if TRACE logs would be emitted. This and the other Is* guards
@@ -89,6 +151,7 @@ func (za *zapAdapter) ImpliedArgs() []interface{} {
 func (za *zapAdapter) With(args ...interface{}) hclog.Logger {
 	return &zapAdapter{
 		dashLogger: za.dashLogger.With(args...),
+		tail:       za.tail,
 	}
 }
 
@@ -105,6 +168,7 @@ func (za *zapAdapter) Name() string {
 func (za *zapAdapter) Named(name string) hclog.Logger {
 	return &zapAdapter{
 		dashLogger: za.dashLogger.Named(name),
+		tail:       za.tail,
 	}
 }
 
@@ -114,6 +178,7 @@ func (za *zapAdapter) Named(name string) hclog.Logger {
 func (za *zapAdapter) ResetNamed(name string) hclog.Logger {
 	return &zapAdapter{
 		dashLogger: za.dashLogger.Named(name),
+		tail:       za.tail,
 	}
 }
 
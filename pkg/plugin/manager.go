@@ -38,8 +38,8 @@ import (
 
 // ClientFactory is a factory for creating clients.
 type ClientFactory interface {
-	// Init initializes a client.
-	Init(ctx context.Context, cmd string) Client
+	// Init initializes a client. The plugin's log output is kept in tail.
+	Init(ctx context.Context, cmd string, tail *LogTail) Client
 }
 
 // DefaultClientFactory is the default client factory
@@ -53,9 +53,10 @@ func NewDefaultClientFactory() *DefaultClientFactory {
 }
 
 // Init creates a new client.
-func (f *DefaultClientFactory) Init(ctx context.Context, cmd string) Client {
+func (f *DefaultClientFactory) Init(ctx context.Context, cmd string, tail *LogTail) Client {
 	loggerAdapter := &zapAdapter{
 		dashLogger: log.From(ctx),
+		tail:       tail,
 	}
 
 	return plugin.NewClient(&plugin.ClientConfig{
@@ -78,6 +79,7 @@ type Client interface {
 // ManagerStore is the data store for Manager.
 type ManagerStore interface {
 	Store(name string, client Client, metadata *Metadata, cmd string) error
+	Remove(name string)
 	StoreJS(name string, jspc JSPlugin) error
 	GetJS(name string) (JSPlugin, bool)
 	RemoveJS(name string)
@@ -94,6 +96,7 @@ type DefaultStore struct {
 	clients  map[string]Client
 	metadata map[string]Metadata
 	commands map[string]string
+	mu       sync.RWMutex
 
 	jsPlugins sync.Map
 }
@@ -146,6 +149,9 @@ func (s *DefaultStore) Store(name string, client Client, metadata *Metadata, cmd
 		return errors.New("metadata is nil")
 	}
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	s.clients[name] = client
 	s.metadata[name] = *metadata
 	s.commands[name] = cmd
@@ -153,9 +159,21 @@ func (s *DefaultStore) Store(name string, client Client, metadata *Metadata, cmd
 	return nil
 }
 
+// Remove removes a plugin from the store.
+func (s *DefaultStore) Remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, name)
+	delete(s.metadata, name)
+	delete(s.commands, name)
+}
+
 // GetService gets the service for a plugin.
 func (s *DefaultStore) GetService(name string) (Service, error) {
+	s.mu.RLock()
 	client, ok := s.clients[name]
+	s.mu.RUnlock()
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have a client", name)
 	}
@@ -180,6 +198,9 @@ func (s *DefaultStore) GetService(name string) (Service, error) {
 
 // GetMetadata gets the metadata for a plugin.
 func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	metadata, ok := s.metadata[name]
 	if !ok {
 		return nil, errors.Errorf("plugin %q doesn't have metadata", name)
@@ -190,6 +211,9 @@ func (s *DefaultStore) GetMetadata(name string) (*Metadata, error) {
 
 // GetCommand gets the command for a plugin.
 func (s *DefaultStore) GetCommand(name string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	cmd, ok := s.commands[name]
 	if !ok {
 		return "", errors.Errorf("plugin %q doesn't have command", name)
@@ -200,7 +224,14 @@ func (s *DefaultStore) GetCommand(name string) (string, error) {
 
 // Clients returns all the clients in the store.
 func (s *DefaultStore) Clients() map[string]Client {
-	return s.clients
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := make(map[string]Client, len(s.clients))
+	for name, client := range s.clients {
+		clients[name] = client
+	}
+	return clients
 }
 
 // ClientNames returns the client names in the store.
@@ -239,8 +270,17 @@ type ManagerInterface interface {
 	// ObjectStatus returns the object status
 	ObjectStatus(ctx context.Context, object runtime.Object) (*ObjectStatusResponse, error)
 
+	// Relations returns the keys of objects plugins have related to an object.
+	Relations(ctx context.Context, object runtime.Object) ([]store.Key, error)
+
 	// UpdateClusterClient sets the current cluster client.
 	UpdateObjectStore(objectStore store.Store)
+
+	// Statuses returns the status of each plugin.
+	Statuses() []PluginStatus
+
+	// SetEnabled enables or disables a plugin.
+	SetEnabled(ctx context.Context, name string, enabled bool) error
 }
 
 // ModuleRegistrar is a module registrar.
@@ -276,6 +316,17 @@ type Manager struct {
 	configs     []config
 	store       ManagerStore
 
+	// ctx is the context the manager was started with. Plugins which are
+	// enabled at runtime run with it.
+	ctx context.Context
+	now func() time.Time
+
+	health     map[string]*pluginHealth
+	healthLock sync.Mutex
+
+	// lifecycleLock serializes restarting, enabling and disabling plugins.
+	lifecycleLock sync.Mutex
+
 	lock sync.Mutex
 }
 
@@ -290,6 +341,8 @@ func NewManager(apiService api.API, moduleRegistrar ModuleRegistrar, actionRegis
 		API:             apiService,
 		ModuleRegistrar: moduleRegistrar,
 		ActionRegistrar: actionRegistrar,
+		now:             time.Now,
+		health:          make(map[string]*pluginHealth),
 	}
 
 	for _, option := range options {
@@ -368,6 +421,14 @@ func (m *Manager) watchJS(ctx context.Context) {
 
 	writeEvents := make(map[string]bool)
 	updatePlugin := func(name string) {
+		m.lifecycleLock.Lock()
+		defer m.lifecycleLock.Unlock()
+
+		if m.isDisabled(name) {
+			logger.Infof("not reloading disabled JavaScript plugin: %s", name)
+			return
+		}
+
 		jsPlugin, ok := m.store.GetJS(name)
 		if ok {
 			if err := m.unregisterJSPlugin(ctx, jsPlugin); err != nil {
@@ -396,6 +457,7 @@ func (m *Manager) watchJS(ctx context.Context) {
 			}
 			if IsJavaScriptPlugin(event.Name) {
 				if event.Op&fsnotify.Remove == fsnotify.Remove {
+					m.lifecycleLock.Lock()
 					jsPlugin, ok := m.store.GetJS(event.Name)
 					if ok {
 						if err := m.unregisterJSPlugin(ctx, jsPlugin); err != nil {
@@ -404,7 +466,9 @@ func (m *Manager) watchJS(ctx context.Context) {
 						m.store.RemoveJS(event.Name)
 						logger.Infof("removing: JavaScript plugin: %s", event.Name)
 					}
-				} else if event.Op&fsnotify.Write == fsnotify.Write {
+					m.removeHealth(event.Name)
+					m.lifecycleLock.Unlock()
+				} else if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
 					writeEvents[event.Name] = true
 				}
 			}
@@ -444,8 +508,14 @@ func (m *Manager) unregisterJSPlugin(_ context.Context, p JSPlugin) error {
 }
 
 func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string, apiAddr string) error {
-	jsPlugin, err := NewJSPlugin(ctx, m.objectStore, pluginPath, CreateRuntimeLoop, ExtractDefaultClass, ExtractMetadata)
+	dashboardClient, err := api.NewClient(apiAddr)
 	if err != nil {
+		return fmt.Errorf("create dashboard client: %w", err)
+	}
+
+	jsPlugin, err := NewJSPlugin(ctx, m.objectStore, dashboardClient, pluginPath, CreateRuntimeLoop, ExtractDefaultClass, ExtractMetadata)
+	if err != nil {
+		_ = dashboardClient.Close()
 		return err
 	}
 	if err := m.store.StoreJS(pluginPath, jsPlugin); err != nil {
@@ -453,6 +523,7 @@ func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string, apiAd
 	}
 
 	metadata := jsPlugin.Metadata()
+	m.healthFor(pluginPath, "").running(m.now(), *metadata)
 
 	pluginLogger := log.From(ctx).With("plugin-name", pluginPath)
 	pluginLogger.With(
@@ -464,7 +535,7 @@ func (m *Manager) registerJSPlugin(ctx context.Context, pluginPath string, apiAd
 		actionPath := actionName
 		pluginLogger.With("action-path", actionPath).Infof("registering plugin action")
 		err := m.ActionRegistrar.Register(actionPath, pluginPath, func(ctx context.Context, alerter action.Alerter, payload action.Payload) error {
-			return jsPlugin.HandleAction(ctx, actionPath, payload)
+			return jsPlugin.handleAction(ctx, alerter, actionPath, payload)
 		})
 
 		if err != nil {
@@ -528,6 +599,8 @@ func (m *Manager) Start(ctx context.Context) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
+	m.ctx = ctx
+
 	if err := m.startJS(ctx, m.API.Addr()); err != nil {
 		return err
 	}
@@ -550,51 +623,233 @@ func (m *Manager) Start(ctx context.Context) error {
 func (m *Manager) watchPlugins(ctx context.Context) {
 	logger := log.From(ctx)
 
-	timer := time.NewTimer(5 * time.Second)
-	running := true
+	ticker := time.NewTicker(pluginWatchInterval)
+	defer ticker.Stop()
 
-	for running {
+	for {
 		select {
 		case <-ctx.Done():
 			logger.Infof("shutting down plugin watcher")
-			running = false
-			break
-		case <-timer.C:
-			for clientName, client := range m.store.Clients() {
-				rpcClient, err := client.Client()
-				if err != nil {
-					logger.WithErr(err).Errorf("retrieve plugin client for ping")
-				}
+			return
+		case <-ticker.C:
+			m.checkPlugins(ctx)
+		}
+	}
+}
 
-				if err := rpcClient.Ping(); err != nil {
-					logger.With("plugin-name", clientName).Infof("restarting plugin")
+// checkPlugins pings running plugins and restarts plugins which have stopped.
+// A plugin which keeps stopping is restarted with an exponential backoff.
+func (m *Manager) checkPlugins(ctx context.Context) {
+	m.lifecycleLock.Lock()
+	defer m.lifecycleLock.Unlock()
 
-					cmd, err := m.store.GetCommand(clientName)
-					if err != nil {
-						logger.WithErr(err).Errorf("unable to find command for plugin")
-						continue
-					}
+	for _, h := range m.healthList() {
+		if IsJavaScriptPlugin(h.name) {
+			continue
+		}
 
-					c := config{
-						name: clientName,
-						cmd:  cmd,
-					}
+		logger := log.From(ctx).With("plugin-name", h.name)
+		now := m.now()
 
-					if err := m.start(ctx, c); err != nil {
-						logger.WithErr(err).Errorf("unable to restart plugin")
-						continue
-					}
+		state, nextRestart := h.currentState()
+		switch state {
+		case PluginStateDisabled:
+			continue
+		case PluginStateRunning:
+			err := m.ping(h.name)
+			if err == nil {
+				h.healthy(now)
+				continue
+			}
+
+			logger.WithErr(err).Errorf("plugin stopped")
+			m.stopPlugin(h.name, h.currentMetadata())
+			h.failed(now, err)
+
+			_, nextRestart = h.currentState()
+		}
+
+		if now.Before(nextRestart) {
+			continue
+		}
+
+		logger.Infof("restarting plugin")
+		h.restarting()
+
+		if err := m.start(ctx, config{name: h.name, cmd: h.cmd}); err != nil {
+			logger.WithErr(err).Errorf("unable to restart plugin")
+			m.stopPlugin(h.name, h.currentMetadata())
+			h.failed(now, err)
+		}
+	}
+}
+
+// ping checks that a plugin's process is responding.
+func (m *Manager) ping(name string) error {
+	client, ok := m.store.Clients()[name]
+	if !ok {
+		return errors.Errorf("plugin %q doesn't have a client", name)
+	}
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		return errors.Wrap(err, "retrieve plugin client for ping")
+	}
+
+	return rpcClient.Ping()
+}
+
+// stopPlugin kills a plugin's process, unregisters its actions and module, and
+// removes it from the store.
+func (m *Manager) stopPlugin(name string, metadata Metadata) {
+	if client, ok := m.store.Clients()[name]; ok {
+		client.Kill()
+	}
+
+	for _, actionPath := range metadata.Capabilities.ActionNames {
+		m.ActionRegistrar.Unregister(actionPath, name)
+	}
+
+	if metadata.Capabilities.IsModule {
+		mp, err := NewModuleProxy(name, &metadata, nil)
+		if err == nil {
+			m.ModuleRegistrar.Unregister(mp)
+		}
+	}
+
+	m.store.Remove(name)
+}
+
+// SetEnabled enables or disables a plugin. A disabled plugin is stopped and
+// isn't restarted until it is enabled.
+func (m *Manager) SetEnabled(ctx context.Context, name string, enabled bool) error {
+	m.lifecycleLock.Lock()
+	defer m.lifecycleLock.Unlock()
+
+	m.healthLock.Lock()
+	h, ok := m.health[name]
+	m.healthLock.Unlock()
+	if !ok {
+		return errors.Errorf("plugin %q not found", name)
+	}
+
+	if m.ctx == nil {
+		return errors.New("plugin manager is not started")
+	}
+
+	logger := log.From(ctx).With("plugin-name", name)
+
+	state, _ := h.currentState()
+	if enabled == (state != PluginStateDisabled) {
+		return nil
+	}
+
+	if !enabled {
+		logger.Infof("disabling plugin")
+
+		if IsJavaScriptPlugin(name) {
+			if jsPlugin, ok := m.store.GetJS(name); ok {
+				if err := m.unregisterJSPlugin(ctx, jsPlugin); err != nil {
+					return errors.Wrapf(err, "disable plugin %q", name)
 				}
+				m.store.RemoveJS(name)
 			}
+		} else {
+			m.stopPlugin(name, h.currentMetadata())
+		}
+
+		h.disable()
+		return nil
+	}
 
-			timer.Reset(5 * time.Second)
+	logger.Infof("enabling plugin")
+
+	if IsJavaScriptPlugin(name) {
+		if err := m.registerJSPlugin(m.ctx, name, m.API.Addr()); err != nil {
+			return errors.Wrapf(err, "enable plugin %q", name)
 		}
+		return nil
 	}
 
+	if err := m.start(m.ctx, config{name: name, cmd: h.cmd}); err != nil {
+		m.stopPlugin(name, h.currentMetadata())
+		h.failed(m.now(), err)
+		return errors.Wrapf(err, "enable plugin %q", name)
+	}
+
+	return nil
 }
 
-func (m *Manager) start(ctx context.Context, c config) error {
-	client := m.ClientFactory.Init(ctx, c.cmd)
+// Statuses returns the status of each plugin, sorted by name.
+func (m *Manager) Statuses() []PluginStatus {
+	var statuses []PluginStatus
+	for _, h := range m.healthList() {
+		statuses = append(statuses, h.status())
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	return statuses
+}
+
+// healthFor returns a plugin's health, creating it if it doesn't exist.
+func (m *Manager) healthFor(name, cmd string) *pluginHealth {
+	m.healthLock.Lock()
+	defer m.healthLock.Unlock()
+
+	h, ok := m.health[name]
+	if !ok {
+		h = newPluginHealth(name, cmd)
+		m.health[name] = h
+	}
+
+	return h
+}
+
+func (m *Manager) healthList() []*pluginHealth {
+	m.healthLock.Lock()
+	defer m.healthLock.Unlock()
+
+	var list []*pluginHealth
+	for _, h := range m.health {
+		list = append(list, h)
+	}
+
+	return list
+}
+
+func (m *Manager) removeHealth(name string) {
+	m.healthLock.Lock()
+	defer m.healthLock.Unlock()
+
+	delete(m.health, name)
+}
+
+func (m *Manager) isDisabled(name string) bool {
+	m.healthLock.Lock()
+	h, ok := m.health[name]
+	m.healthLock.Unlock()
+	if !ok {
+		return false
+	}
+
+	state, _ := h.currentState()
+	return state == PluginStateDisabled
+}
+
+func (m *Manager) start(ctx context.Context, c config) (err error) {
+	h := m.healthFor(c.name, c.cmd)
+	client := m.ClientFactory.Init(ctx, c.cmd, h.tail)
+
+	// Once the client is stored, stopping the plugin kills it.
+	stored := false
+	defer func() {
+		if err != nil && !stored {
+			client.Kill()
+		}
+	}()
 
 	rpcClient, err := client.Client()
 	if err != nil {
@@ -621,6 +876,7 @@ func (m *Manager) start(ctx context.Context, c config) error {
 	if err := m.store.Store(c.name, client, &metadata, c.cmd); err != nil {
 		return errors.Wrapf(err, "storing plugin")
 	}
+	stored = true
 
 	for _, actionName := range metadata.Capabilities.ActionNames {
 		actionPath := actionName
@@ -657,6 +913,8 @@ func (m *Manager) start(ctx context.Context, c config) error {
 		}
 	}
 
+	h.running(m.now(), metadata)
+
 	return nil
 }
 
@@ -777,3 +1035,31 @@ func (m *Manager) ObjectStatus(ctx context.Context, object runtime.Object) (*Obj
 	<-done
 	return &osr, nil
 }
+
+// Relations returns the keys of objects which plugins have related to an object.
+func (m *Manager) Relations(ctx context.Context, object runtime.Object) ([]store.Key, error) {
+	if m.Runners == nil {
+		return nil, errors.New("runners is nil")
+	}
+
+	runner, ch := m.Runners.Relations(m.store)
+	done := make(chan bool)
+
+	var keys []store.Key
+
+	go func() {
+		for resp := range ch {
+			keys = append(keys, resp.Keys...)
+		}
+
+		done <- true
+	}()
+
+	if err := runner.Run(ctx, object, m.store.ClientNames()); err != nil {
+		return nil, err
+	}
+	close(ch)
+
+	<-done
+	return keys, nil
+}
@@ -20,6 +20,7 @@ import (
 	dashPlugin "github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/api"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	octantStore "github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -63,7 +64,7 @@ func TestManager(t *testing.T) {
 	name := "plugin1"
 
 	client := newFakePluginClient(name, controller)
-	clientFactory.EXPECT().Init(gomock.Any(), gomock.Eq(name)).Return(client)
+	clientFactory.EXPECT().Init(gomock.Any(), gomock.Eq(name), gomock.Any()).Return(client)
 
 	metadata := &dashPlugin.Metadata{
 		Name: name,
@@ -197,6 +198,54 @@ func TestManager_Tabs(t *testing.T) {
 	assert.Equal(t, expected, got)
 }
 
+func TestManager_Relations(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	pod := testutil.CreatePod("pod")
+
+	var options []dashPlugin.ManagerOption
+
+	store := fake.NewMockManagerStore(controller)
+	moduleRegistrar := fake.NewMockModuleRegistrar(controller)
+	actionRegistrar := fake.NewMockActionRegistrar(controller)
+
+	store.EXPECT().ClientNames().Return([]string{"plugin1", "plugin2"})
+
+	ch := make(chan dashPlugin.RelationsResponse)
+	relationsRunner := dashPlugin.DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			ch <- dashPlugin.RelationsResponse{
+				Keys: []octantStore.Key{{APIVersion: "v1", Kind: "ConfigMap", Name: name}},
+			}
+
+			return nil
+		},
+	}
+
+	runners := fake.NewMockRunners(controller)
+	runners.EXPECT().
+		Relations(gomock.Eq(store)).Return(relationsRunner, ch)
+
+	options = append(options, func(m *dashPlugin.Manager) {
+		m.Runners = runners
+	})
+
+	apiService := &stubAPIService{}
+	manager := dashPlugin.NewManager(apiService, moduleRegistrar, actionRegistrar, options...)
+	manager.SetStore(store)
+
+	ctx := context.Background()
+	got, err := manager.Relations(ctx, pod)
+	require.NoError(t, err)
+
+	expected := []octantStore.Key{
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "plugin1"},
+		{APIVersion: "v1", Kind: "ConfigMap", Name: "plugin2"},
+	}
+	assert.ElementsMatch(t, expected, got)
+}
+
 type fakePluginClient struct {
 	clientProtocol *fake.MockClientProtocol
 	service        *fake.MockService
@@ -7,6 +7,7 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"golang.org/x/sync/errgroup"
@@ -28,6 +29,9 @@ type Runners interface {
 	// ObjectStatus returns a runner for object status. The caller should
 	// close the channel when they are done with it.
 	ObjectStatus(ManagerStore) (DefaultRunner, chan ObjectStatusResponse)
+	// Relations returns a runner for object relations. The caller should
+	// close the channel when they are done with it.
+	Relations(ManagerStore) (DefaultRunner, chan RelationsResponse)
 }
 
 type defaultRunners struct{}
@@ -53,6 +57,11 @@ func (dr *defaultRunners) ObjectStatus(store ManagerStore) (DefaultRunner, chan
 	return ObjectStatusRunner(store, ch), ch
 }
 
+func (dr *defaultRunners) Relations(store ManagerStore) (DefaultRunner, chan RelationsResponse) {
+	ch := make(chan RelationsResponse)
+	return RelationsRunner(store, ch), ch
+}
+
 // DefaultRunner runs a function against all plugins
 type DefaultRunner struct {
 	RunFunc func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error
@@ -114,7 +123,10 @@ func PrintRunner(store ManagerStore, ch chan<- PrintResponse) DefaultRunner {
 				}
 
 				resp, err := jsPlugin.Print(ctx, object)
-
+				if errors.Is(err, ErrJSPluginUnhealthy) {
+					// An unhealthy plugin is skipped so it doesn't block content.
+					return nil
+				}
 				if err != nil {
 					return err
 				}
@@ -175,6 +187,9 @@ func TabRunner(store ManagerStore, ch chan<- component.Tab) DefaultRunner {
 				}
 
 				resp, err := jsPlugin.PrintTab(ctx, object)
+				if errors.Is(err, ErrJSPluginUnhealthy) {
+					return nil
+				}
 				if err != nil {
 					return fmt.Errorf("printing tabResponse for plugin: %q: %w", name, err)
 				}
@@ -230,6 +245,9 @@ func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) Defa
 				}
 
 				resp, err := jsPlugin.ObjectStatus(ctx, object)
+				if errors.Is(err, ErrJSPluginUnhealthy) {
+					return nil
+				}
 				if err != nil {
 					return fmt.Errorf("printing objectStatus for plugin: %q: %w", name, err)
 				}
@@ -262,3 +280,54 @@ func ObjectStatusRunner(store ManagerStore, ch chan<- ObjectStatusResponse) Defa
 		},
 	}
 }
+
+// RelationsRunner is a runner for object relations.
+func RelationsRunner(store ManagerStore, ch chan<- RelationsResponse) DefaultRunner {
+	return DefaultRunner{
+		RunFunc: func(ctx context.Context, name string, gvk schema.GroupVersionKind, object runtime.Object) error {
+			if IsJavaScriptPlugin(name) {
+				jsPlugin, ok := store.GetJS(name)
+				if !ok {
+					return fmt.Errorf("plugin %s not found", name)
+				}
+
+				if !jsPlugin.Metadata().Capabilities.HasObjectRelationsSupport(gvk) {
+					return nil
+				}
+
+				resp, err := jsPlugin.Relations(ctx, object)
+				if errors.Is(err, ErrJSPluginUnhealthy) {
+					return nil
+				}
+				if err != nil {
+					return fmt.Errorf("finding relations for plugin: %q: %w", name, err)
+				}
+
+				ch <- resp
+				return nil
+			}
+
+			metadata, err := store.GetMetadata(name)
+			if err != nil {
+				return err
+			}
+
+			if !metadata.Capabilities.HasObjectRelationsSupport(gvk) {
+				return nil
+			}
+
+			service, err := store.GetService(name)
+			if err != nil {
+				return err
+			}
+
+			resp, err := service.Relations(ctx, object)
+			if err != nil {
+				return fmt.Errorf("find object relations with plugin %q: %w", name, err)
+			}
+
+			ch <- resp
+			return nil
+		},
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/vmware-tanzu/octant/internal/testutil"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/fake"
+	octantStore "github.com/vmware-tanzu/octant/pkg/store"
 	"github.com/vmware-tanzu/octant/pkg/view/component"
 )
 
@@ -186,3 +187,57 @@ func Test_TabRunner(t *testing.T) {
 	ctx := context.Background()
 	require.NoError(t, runner.Run(ctx, object, clientNames))
 }
+
+func Test_RelationsRunner(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	store := fake.NewMockManagerStore(controller)
+	service := fake.NewMockService(controller)
+
+	object := testutil.CreateDeployment("deployment")
+	clientNames := []string{"plugin1", "plugin2"}
+
+	plugin1Metadata := &plugin.Metadata{
+		Capabilities: plugin.Capabilities{
+			SupportsObjectRelations: []schema.GroupVersionKind{gvk.Deployment},
+		},
+	}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin1")).Return(plugin1Metadata, nil)
+
+	plugin2Metadata := &plugin.Metadata{}
+	store.EXPECT().
+		GetMetadata(gomock.Eq("plugin2")).Return(plugin2Metadata, nil)
+
+	store.EXPECT().
+		GetService(gomock.Eq("plugin1")).Return(service, nil)
+
+	relationsResponse := plugin.RelationsResponse{
+		Keys: []octantStore.Key{
+			{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "config"},
+		},
+	}
+
+	service.EXPECT().
+		Relations(gomock.Any(), gomock.Eq(object)).Return(relationsResponse, nil)
+
+	ch := make(chan plugin.RelationsResponse)
+	defer close(ch)
+
+	runner := plugin.RelationsRunner(store, ch)
+
+	done := make(chan bool)
+	go func() {
+		resp := <-ch
+		assert.Equal(t, relationsResponse, resp)
+		done <- true
+	}()
+
+	defer func() {
+		<-done
+	}()
+
+	ctx := context.Background()
+	require.NoError(t, runner.Run(ctx, object, clientNames))
+}
@@ -17,10 +17,13 @@ type Dashboard interface {
 	List(ctx context.Context, key store.Key) (*unstructured.UnstructuredList, error)
 	Get(ctx context.Context, key store.Key) (*unstructured.Unstructured, error)
 	Update(ctx context.Context, object *unstructured.Unstructured) error
+	Delete(ctx context.Context, key store.Key) error
+	Watch(ctx context.Context, key store.Key) (<-chan api.WatchEvent, error)
 	PortForward(ctx context.Context, req api.PortForwardRequest) (api.PortForwardResponse, error)
 	CancelPortForward(ctx context.Context, id string)
 	ListNamespaces(ctx context.Context) (api.NamespacesResponse, error)
 	ForceFrontendUpdate(ctx context.Context) error
+	ExecCommand(ctx context.Context, req api.ExecCommandRequest) (api.ExecCommandResponse, error)
 }
 
 // NewDashboardClient creates a dashboard client.
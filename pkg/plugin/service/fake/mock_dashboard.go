@@ -64,6 +64,35 @@ func (mr *MockDashboardMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDashboard)(nil).Close))
 }
 
+// Delete mocks base method
+func (m *MockDashboard) Delete(arg0 context.Context, arg1 store.Key) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockDashboardMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDashboard)(nil).Delete), arg0, arg1)
+}
+
+// ExecCommand mocks base method
+func (m *MockDashboard) ExecCommand(arg0 context.Context, arg1 api.ExecCommandRequest) (api.ExecCommandResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecCommand", arg0, arg1)
+	ret0, _ := ret[0].(api.ExecCommandResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecCommand indicates an expected call of ExecCommand
+func (mr *MockDashboardMockRecorder) ExecCommand(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecCommand", reflect.TypeOf((*MockDashboard)(nil).ExecCommand), arg0, arg1)
+}
+
 // ForceFrontendUpdate mocks base method
 func (m *MockDashboard) ForceFrontendUpdate(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -151,3 +180,18 @@ func (mr *MockDashboardMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDashboard)(nil).Update), arg0, arg1)
 }
+
+// Watch mocks base method
+func (m *MockDashboard) Watch(arg0 context.Context, arg1 store.Key) (<-chan api.WatchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1)
+	ret0, _ := ret[0].(<-chan api.WatchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockDashboardMockRecorder) Watch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockDashboard)(nil).Watch), arg0, arg1)
+}
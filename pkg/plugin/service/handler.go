@@ -103,6 +103,21 @@ func (p *Handler) ObjectStatus(ctx context.Context, object runtime.Object) (plug
 	return p.HandlerFuncs.ObjectStatus(request)
 }
 
+// Relations finds the keys of objects related to an object.
+func (p *Handler) Relations(ctx context.Context, object runtime.Object) (plugin.RelationsResponse, error) {
+	if p.HandlerFuncs.Relations == nil {
+		return plugin.RelationsResponse{}, nil
+	}
+
+	request := &PrintRequest{
+		baseRequest:     newBaseRequest(ctx, p.name),
+		DashboardClient: p.dashboardClient,
+		Object:          object,
+	}
+
+	return p.HandlerFuncs.Relations(request)
+}
+
 // HandleAction handles actions given a payload.
 func (p *Handler) HandleAction(ctx context.Context, actionName string, payload action.Payload) error {
 	if p.HandlerFuncs.HandleAction == nil {
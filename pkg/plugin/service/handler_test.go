@@ -16,6 +16,7 @@ import (
 	"github.com/vmware-tanzu/octant/pkg/navigation"
 	"github.com/vmware-tanzu/octant/pkg/plugin"
 	"github.com/vmware-tanzu/octant/pkg/plugin/service/fake"
+	"github.com/vmware-tanzu/octant/pkg/store"
 )
 
 func TestHandler_Register(t *testing.T) {
@@ -230,6 +231,57 @@ func TestHandler_ObjectStatus_using_supplied_function(t *testing.T) {
 	assert.True(t, ran)
 }
 
+func TestHandler_Relations_default(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+	}
+
+	pod := testutil.CreatePod("pod")
+
+	ctx := context.Background()
+	got, err := h.Relations(ctx, pod)
+	require.NoError(t, err)
+
+	expected := plugin.RelationsResponse{}
+
+	require.Equal(t, expected, got)
+}
+
+func TestHandler_Relations_using_supplied_function(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dashboardClient := fake.NewMockDashboard(controller)
+	pod := testutil.CreatePod("pod")
+
+	keys := []store.Key{
+		{Namespace: "default", APIVersion: "v1", Kind: "ConfigMap", Name: "config"},
+	}
+
+	h := Handler{
+		dashboardClient: dashboardClient,
+		HandlerFuncs: HandlerFuncs{
+			Relations: func(r *PrintRequest) (plugin.RelationsResponse, error) {
+				assert.Equal(t, dashboardClient, r.DashboardClient)
+				assert.Equal(t, pod, r.Object)
+				return plugin.RelationsResponse{Keys: keys}, nil
+			},
+		},
+	}
+
+	ctx := context.Background()
+	got, err := h.Relations(ctx, pod)
+	require.NoError(t, err)
+
+	expected := plugin.RelationsResponse{Keys: keys}
+	assert.Equal(t, expected, got)
+}
+
 func TestHandler_HandleAction_default(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -46,6 +46,13 @@ func WithObjectStatus(fn HandlerObjectStatusFunc) PluginOption {
 	}
 }
 
+// WithObjectRelations configures the plugin to supply the keys of related objects.
+func WithObjectRelations(fn HandlerRelationsFunc) PluginOption {
+	return func(p *Plugin) {
+		p.pluginHandler.HandlerFuncs.Relations = fn
+	}
+}
+
 // WithActionHandler configures the plugin to handle actions.
 func WithActionHandler(fn HandlerActionFunc) PluginOption {
 	return func(p *Plugin) {
@@ -176,6 +183,7 @@ type NavigationRequest struct {
 type HandlerPrinterFunc func(request *PrintRequest) (plugin.PrintResponse, error)
 type HandlerTabPrintFunc func(request *PrintRequest) (plugin.TabResponse, error)
 type HandlerObjectStatusFunc func(request *PrintRequest) (plugin.ObjectStatusResponse, error)
+type HandlerRelationsFunc func(request *PrintRequest) (plugin.RelationsResponse, error)
 type HandlerActionFunc func(request *ActionRequest) error
 type HandlerNavigationFunc func(request *NavigationRequest) (navigation.Navigation, error)
 type HandlerInitRoutesFunc func(router *Router)
@@ -185,6 +193,7 @@ type HandlerFuncs struct {
 	Print        HandlerPrinterFunc
 	PrintTab     HandlerTabPrintFunc
 	ObjectStatus HandlerObjectStatusFunc
+	Relations    HandlerRelationsFunc
 	HandleAction HandlerActionFunc
 	Navigation   HandlerNavigationFunc
 	InitRoutes   HandlerInitRoutesFunc
@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"sync"
+	"time"
+)
+
+// PluginState is the state of a plugin.
+type PluginState string
+
+const (
+	// PluginStateRunning is a plugin which is running.
+	PluginStateRunning PluginState = "Running"
+	// PluginStateRestarting is a plugin which has stopped and will be restarted.
+	PluginStateRestarting PluginState = "Restarting"
+	// PluginStateCrashLooping is a plugin which keeps stopping. It is
+	// restarted with an exponential backoff.
+	PluginStateCrashLooping PluginState = "CrashLooping"
+	// PluginStateDisabled is a plugin which has been disabled.
+	PluginStateDisabled PluginState = "Disabled"
+)
+
+const (
+	// pluginWatchInterval is how often plugins are pinged.
+	pluginWatchInterval = 5 * time.Second
+
+	// pluginRestartBackoff is the delay before a plugin's second restart.
+	// A plugin's first restart is immediate, and the delay doubles for each
+	// restart after the second.
+	pluginRestartBackoff = 10 * time.Second
+
+	// pluginMaxRestartBackoff is the longest delay between restarts.
+	pluginMaxRestartBackoff = 5 * time.Minute
+
+	// pluginBackoffResetAfter is how long a plugin has to run before its
+	// backoff is reset.
+	pluginBackoffResetAfter = 5 * time.Minute
+
+	// pluginCrashLoopFailures is the number of consecutive failures after
+	// which a plugin is crash looping.
+	pluginCrashLoopFailures = 3
+)
+
+// PluginStatus is the status of a plugin.
+type PluginStatus struct {
+	// Name is the plugin's name in the manager's store.
+	Name string
+	// Metadata is the metadata the plugin registered with when it last started.
+	Metadata Metadata
+	// State is the plugin's state.
+	State PluginState
+	// Restarts is the number of times the plugin has been restarted.
+	Restarts int
+	// LastError is why the plugin last stopped or failed to start.
+	LastError string
+	// NextRestart is when a plugin which has stopped will be restarted.
+	NextRestart time.Time
+	// LogTail is the plugin's most recent log output, including its stderr.
+	LogTail []string
+}
+
+// pluginHealth tracks the state of a plugin.
+type pluginHealth struct {
+	name string
+	cmd  string
+	tail *LogTail
+
+	metadata    Metadata
+	state       PluginState
+	restarts    int
+	failures    int
+	lastError   string
+	startedAt   time.Time
+	nextRestart time.Time
+
+	mu sync.Mutex
+}
+
+func newPluginHealth(name, cmd string) *pluginHealth {
+	return &pluginHealth{
+		name:  name,
+		cmd:   cmd,
+		tail:  NewLogTail(DefaultLogTailSize),
+		state: PluginStateRestarting,
+	}
+}
+
+func (h *pluginHealth) status() PluginStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return PluginStatus{
+		Name:        h.name,
+		Metadata:    h.metadata,
+		State:       h.state,
+		Restarts:    h.restarts,
+		LastError:   h.lastError,
+		NextRestart: h.nextRestart,
+		LogTail:     h.tail.Lines(),
+	}
+}
+
+func (h *pluginHealth) currentState() (PluginState, time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.state, h.nextRestart
+}
+
+func (h *pluginHealth) currentMetadata() Metadata {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.metadata
+}
+
+// running records that the plugin has started.
+func (h *pluginHealth) running(now time.Time, metadata Metadata) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.metadata = metadata
+	h.state = PluginStateRunning
+	h.startedAt = now
+	h.nextRestart = time.Time{}
+}
+
+// restarting records that the plugin is being restarted.
+func (h *pluginHealth) restarting() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.restarts++
+}
+
+// healthy records that the plugin responded. A plugin which has been running
+// long enough has its backoff reset.
+func (h *pluginHealth) healthy(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if now.Sub(h.startedAt) >= pluginBackoffResetAfter {
+		h.failures = 0
+	}
+}
+
+// failed records that the plugin stopped or failed to start, and schedules
+// its next restart.
+func (h *pluginHealth) failed(now time.Time, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.failures++
+	h.lastError = err.Error()
+	h.nextRestart = now.Add(restartBackoff(h.failures))
+
+	h.state = PluginStateRestarting
+	if h.failures >= pluginCrashLoopFailures {
+		h.state = PluginStateCrashLooping
+	}
+}
+
+// disable records that the plugin has been disabled.
+func (h *pluginHealth) disable() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.state = PluginStateDisabled
+	h.failures = 0
+	h.nextRestart = time.Time{}
+}
+
+// restartBackoff returns the delay before restarting a plugin which has
+// failed a number of consecutive times.
+func restartBackoff(failures int) time.Duration {
+	if failures <= 1 {
+		return 0
+	}
+
+	backoff := pluginRestartBackoff
+	for i := 2; i < failures; i++ {
+		backoff *= 2
+		if backoff >= pluginMaxRestartBackoff {
+			return pluginMaxRestartBackoff
+		}
+	}
+
+	return backoff
+}
@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware-tanzu/octant/internal/log"
+)
+
+func Test_restartBackoff(t *testing.T) {
+	cases := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 1, expected: 0},
+		{failures: 2, expected: 10 * time.Second},
+		{failures: 3, expected: 20 * time.Second},
+		{failures: 4, expected: 40 * time.Second},
+		{failures: 6, expected: 160 * time.Second},
+		{failures: 7, expected: pluginMaxRestartBackoff},
+		{failures: 100, expected: pluginMaxRestartBackoff},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, restartBackoff(tc.failures), "failures: %d", tc.failures)
+	}
+}
+
+func TestLogTail(t *testing.T) {
+	tail := NewLogTail(2)
+
+	za := (&zapAdapter{dashLogger: log.NopLogger(), tail: tail}).Named("plugin")
+	za.Debug("starting")
+	za.Info("started", "pid", 10)
+	za.Error("plugin process exited", "error", "exit status 2")
+
+	assert.Equal(t, []string{
+		"[INFO] started pid=10",
+		"[ERROR] plugin process exited error=exit status 2",
+	}, tail.Lines())
+}
+
+func TestManager_checkPlugins(t *testing.T) {
+	factory := &stubClientFactory{}
+	m := NewManager(&stubAPI{}, nil, nil, func(m *Manager) {
+		m.ClientFactory = factory
+	})
+
+	clock := time.Unix(0, 0)
+	m.now = func() time.Time {
+		return clock
+	}
+
+	ctx := context.Background()
+	m.ctx = ctx
+
+	require.NoError(t, m.start(ctx, config{name: "plugin", cmd: "plugin"}))
+	assertPluginStatus(t, m, PluginStateRunning, 0, "")
+	require.Equal(t, 1, factory.inits)
+
+	// A plugin is restarted immediately the first time it stops.
+	factory.pingErr = errors.New("connection refused")
+	m.checkPlugins(ctx)
+	assertPluginStatus(t, m, PluginStateRunning, 1, "connection refused")
+	assert.Equal(t, 2, factory.inits)
+	assert.Equal(t, 1, factory.kills)
+
+	// It is restarted after a backoff when it keeps stopping.
+	m.checkPlugins(ctx)
+	assertPluginStatus(t, m, PluginStateRestarting, 1, "connection refused")
+	assert.Equal(t, 2, factory.inits)
+	assert.Empty(t, m.store.Clients())
+
+	clock = clock.Add(5 * time.Second)
+	m.checkPlugins(ctx)
+	assert.Equal(t, 2, factory.inits)
+
+	factory.dispenseErr = errors.New("dispense failed")
+	clock = clock.Add(5 * time.Second)
+	m.checkPlugins(ctx)
+	assert.Equal(t, 3, factory.inits)
+	status := assertPluginStatus(t, m, PluginStateCrashLooping, 2, `dispensing plugin for "plugin": dispense failed`)
+	assert.Equal(t, clock.Add(20*time.Second), status.NextRestart)
+
+	factory.pingErr = nil
+	factory.dispenseErr = nil
+	clock = clock.Add(20 * time.Second)
+	m.checkPlugins(ctx)
+	assertPluginStatus(t, m, PluginStateRunning, 3, `dispensing plugin for "plugin": dispense failed`)
+	assert.Equal(t, 4, factory.inits)
+
+	// A plugin which has run long enough has its backoff reset.
+	clock = clock.Add(pluginBackoffResetAfter)
+	m.checkPlugins(ctx)
+	factory.pingErr = errors.New("connection refused")
+	m.checkPlugins(ctx)
+	assertPluginStatus(t, m, PluginStateRunning, 4, "connection refused")
+}
+
+func TestManager_SetEnabled(t *testing.T) {
+	factory := &stubClientFactory{}
+	m := NewManager(&stubAPI{}, nil, nil, func(m *Manager) {
+		m.ClientFactory = factory
+	})
+
+	ctx := context.Background()
+	m.ctx = ctx
+
+	require.NoError(t, m.start(ctx, config{name: "plugin", cmd: "plugin"}))
+
+	require.NoError(t, m.SetEnabled(ctx, "plugin", false))
+	assertPluginStatus(t, m, PluginStateDisabled, 0, "")
+	assert.Equal(t, 1, factory.kills)
+	assert.Empty(t, m.store.ClientNames())
+
+	// Disabled plugins aren't pinged or restarted.
+	factory.pingErr = errors.New("connection refused")
+	m.checkPlugins(ctx)
+	assert.Equal(t, 1, factory.inits)
+
+	factory.pingErr = nil
+	require.NoError(t, m.SetEnabled(ctx, "plugin", true))
+	assertPluginStatus(t, m, PluginStateRunning, 0, "")
+	assert.Equal(t, 2, factory.inits)
+	assert.Equal(t, []string{"plugin"}, m.store.ClientNames())
+
+	require.Error(t, m.SetEnabled(ctx, "missing", false))
+}
+
+func assertPluginStatus(t *testing.T, m *Manager, state PluginState, restarts int, lastError string) PluginStatus {
+	statuses := m.Statuses()
+	require.Len(t, statuses, 1)
+
+	status := statuses[0]
+	assert.Equal(t, "plugin", status.Name)
+	assert.Equal(t, state, status.State)
+	assert.Equal(t, restarts, status.Restarts)
+	assert.Equal(t, lastError, status.LastError)
+	return status
+}
+
+type stubClientFactory struct {
+	inits       int
+	kills       int
+	pingErr     error
+	dispenseErr error
+}
+
+var _ ClientFactory = (*stubClientFactory)(nil)
+
+func (f *stubClientFactory) Init(ctx context.Context, cmd string, tail *LogTail) Client {
+	f.inits++
+	return &stubClient{factory: f}
+}
+
+type stubClient struct {
+	factory *stubClientFactory
+}
+
+var _ Client = (*stubClient)(nil)
+var _ plugin.ClientProtocol = (*stubClient)(nil)
+
+func (c *stubClient) Client() (plugin.ClientProtocol, error) {
+	return c, nil
+}
+
+func (c *stubClient) Kill() {
+	c.factory.kills++
+}
+
+func (c *stubClient) Close() error {
+	return nil
+}
+
+func (c *stubClient) Dispense(string) (interface{}, error) {
+	if c.factory.dispenseErr != nil {
+		return nil, c.factory.dispenseErr
+	}
+	return &stubService{}, nil
+}
+
+func (c *stubClient) Ping() error {
+	return c.factory.pingErr
+}
+
+type stubService struct {
+	Service
+}
+
+func (s *stubService) Register(ctx context.Context, dashboardAPIAddress string) (Metadata, error) {
+	return Metadata{Name: "plugin"}, nil
+}
+
+type stubAPI struct{}
+
+func (a *stubAPI) Addr() string {
+	return "localhost:54321"
+}
+
+func (a *stubAPI) Start(context.Context) error {
+	return nil
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/vmware-tanzu/octant/pkg/store (interfaces: Store)
+// Source: github.com/vmware-tanzu/octant/pkg/store (interfaces: Store,DryRunner,Applier,Patcher)
 
 // Package fake is a generated GoMock package.
 package fake
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
 	cache "k8s.io/client-go/tools/cache"
 
 	cluster "github.com/vmware-tanzu/octant/internal/cluster"
@@ -200,3 +201,115 @@ func (mr *MockStoreMockRecorder) Watch(arg0, arg1, arg2 interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockStore)(nil).Watch), arg0, arg1, arg2)
 }
+
+// MockDryRunner is a mock of DryRunner interface
+type MockDryRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockDryRunnerMockRecorder
+}
+
+// MockDryRunnerMockRecorder is the mock recorder for MockDryRunner
+type MockDryRunnerMockRecorder struct {
+	mock *MockDryRunner
+}
+
+// NewMockDryRunner creates a new mock instance
+func NewMockDryRunner(ctrl *gomock.Controller) *MockDryRunner {
+	mock := &MockDryRunner{ctrl: ctrl}
+	mock.recorder = &MockDryRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDryRunner) EXPECT() *MockDryRunnerMockRecorder {
+	return m.recorder
+}
+
+// DryRunFromYAML mocks base method
+func (m *MockDryRunner) DryRunFromYAML(arg0 context.Context, arg1, arg2 string) ([]store.DryRunResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunFromYAML", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]store.DryRunResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRunFromYAML indicates an expected call of DryRunFromYAML
+func (mr *MockDryRunnerMockRecorder) DryRunFromYAML(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunFromYAML", reflect.TypeOf((*MockDryRunner)(nil).DryRunFromYAML), arg0, arg1, arg2)
+}
+
+// MockApplier is a mock of Applier interface
+type MockApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplierMockRecorder
+}
+
+// MockApplierMockRecorder is the mock recorder for MockApplier
+type MockApplierMockRecorder struct {
+	mock *MockApplier
+}
+
+// NewMockApplier creates a new mock instance
+func NewMockApplier(ctrl *gomock.Controller) *MockApplier {
+	mock := &MockApplier{ctrl: ctrl}
+	mock.recorder = &MockApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApplier) EXPECT() *MockApplierMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method
+func (m *MockApplier) Apply(arg0 context.Context, arg1 *unstructured.Unstructured, arg2 store.ApplyOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply
+func (mr *MockApplierMockRecorder) Apply(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockApplier)(nil).Apply), arg0, arg1, arg2)
+}
+
+// MockPatcher is a mock of Patcher interface
+type MockPatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPatcherMockRecorder
+}
+
+// MockPatcherMockRecorder is the mock recorder for MockPatcher
+type MockPatcherMockRecorder struct {
+	mock *MockPatcher
+}
+
+// NewMockPatcher creates a new mock instance
+func NewMockPatcher(ctrl *gomock.Controller) *MockPatcher {
+	mock := &MockPatcher{ctrl: ctrl}
+	mock.recorder = &MockPatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPatcher) EXPECT() *MockPatcherMockRecorder {
+	return m.recorder
+}
+
+// Patch mocks base method
+func (m *MockPatcher) Patch(arg0 context.Context, arg1 store.Key, arg2 types.PatchType, arg3 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch
+func (mr *MockPatcherMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockPatcher)(nil).Patch), arg0, arg1, arg2, arg3)
+}
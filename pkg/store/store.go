@@ -7,6 +7,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -15,13 +16,14 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/vmware-tanzu/octant/internal/cluster"
 	"github.com/vmware-tanzu/octant/pkg/action"
 )
 
-//go:generate mockgen  -destination=./fake/mock_store.go -package=fake github.com/vmware-tanzu/octant/pkg/store Store
+//go:generate mockgen  -destination=./fake/mock_store.go -package=fake github.com/vmware-tanzu/octant/pkg/store Store,DryRunner,Applier,Patcher
 
 // UpdateFn is a function that is called when
 type UpdateFn func(store Store)
@@ -41,6 +43,103 @@ type Store interface {
 	CreateOrUpdateFromYAML(ctx context.Context, namespace, input string) ([]string, error)
 }
 
+// DryRunner is a Store which can preview YAML with a server-side dry-run. It
+// is optional so existing Store implementations keep working; callers check
+// for it with a type assertion.
+type DryRunner interface {
+	DryRunFromYAML(ctx context.Context, namespace, input string) ([]DryRunResult, error)
+}
+
+// Applier is a Store which can update objects with a server-side apply. It is
+// optional so existing Store implementations keep working; callers check for
+// it with a type assertion.
+type Applier interface {
+	Apply(ctx context.Context, object *unstructured.Unstructured, options ApplyOptions) error
+}
+
+// Patcher is a Store which can patch objects. It is optional so existing Store
+// implementations keep working; callers check for it with a type assertion.
+type Patcher interface {
+	Patch(ctx context.Context, key Key, patchType types.PatchType, data []byte) error
+}
+
+// DefaultFieldManager is the field manager Octant uses when it applies
+// complete objects, e.g. YAML entered by the user. A server-side apply removes
+// fields its manager applied before and leaves out this time, so applies of
+// some of an object's fields should use their own field manager.
+const DefaultFieldManager = "octant"
+
+// ApplyOptions are options for a server-side apply.
+type ApplyOptions struct {
+	// FieldManager is the manager which owns the applied fields. If it is
+	// blank, DefaultFieldManager is used.
+	FieldManager string
+	// Force takes ownership of fields owned by other managers instead of
+	// returning a conflict.
+	Force bool
+}
+
+// FieldConflict is a field which can't be applied because it is owned by
+// another field manager.
+type FieldConflict struct {
+	// Field is the path of the field, e.g. `.spec.replicas`.
+	Field string `json:"field"`
+	// Manager is the field manager which owns the field.
+	Manager string `json:"manager"`
+}
+
+// String describes the conflict.
+func (c FieldConflict) String() string {
+	return fmt.Sprintf("field %s is owned by %s", c.Field, c.Manager)
+}
+
+// ApplyConflictError is returned when a server-side apply conflicts with
+// fields owned by other managers.
+type ApplyConflictError struct {
+	Key       Key
+	Conflicts []FieldConflict
+}
+
+var _ error = (*ApplyConflictError)(nil)
+
+// Error describes the conflicts.
+func (e *ApplyConflictError) Error() string {
+	var conflicts []string
+	for _, conflict := range e.Conflicts {
+		conflicts = append(conflicts, conflict.String())
+	}
+
+	return fmt.Sprintf("apply %s %s: %s", e.Key.Kind, e.Key.Name, strings.Join(conflicts, "; "))
+}
+
+// IsApplyConflict returns the ApplyConflictError in err's chain.
+func IsApplyConflict(err error) (*ApplyConflictError, bool) {
+	var conflictErr *ApplyConflictError
+	if errors.As(err, &conflictErr) {
+		return conflictErr, true
+	}
+
+	return nil, false
+}
+
+// DryRunResult is the result of a server-side dry-run for a single document.
+type DryRunResult struct {
+	// Key is the key of the object in the document.
+	Key Key
+	// Live is the object currently in the cluster. It is nil if the
+	// object does not exist.
+	Live *unstructured.Unstructured
+	// Result is the object the cluster would store if the document
+	// was applied.
+	Result *unstructured.Unstructured
+	// Conflicts are fields owned by other field managers which applying
+	// the document would take over.
+	Conflicts []FieldConflict
+	// Err is the error returned by the cluster for the document, e.g.
+	// a validation or admission failure.
+	Err error
+}
+
 // Key is a key for the object store.
 type Key struct {
 	Namespace  string      `json:"namespace"`
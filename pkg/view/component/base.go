@@ -17,6 +17,7 @@ const (
 	typeError              = "error"
 	typeExtension          = "extension"
 	typeExpressionSelector = "expressionSelector"
+	typeFileBrowser        = "fileBrowser"
 	typeFlexLayout         = "flexlayout"
 	typeGraphviz           = "graphviz"
 	typeGridActions        = "gridActions"
@@ -30,6 +31,7 @@ const (
 	typePodStatus          = "podStatus"
 	typePort               = "port"
 	typePorts              = "ports"
+	typeProgressBar        = "progressBar"
 	typeQuadrant           = "quadrant"
 	typeResourceViewer     = "resourceViewer"
 	typeSelectors          = "selectors"
@@ -38,6 +40,7 @@ const (
 	typeSummary            = "summary"
 	typeTable              = "table"
 	typeTerminal           = "terminal"
+	typeTerminalRecording  = "terminalRecording"
 	typeText               = "text"
 	typeTimestamp          = "timestamp"
 	typeYAML               = "yaml"
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// FileBrowserConfig is the configuration for a file browser.
+type FileBrowserConfig struct {
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+	// Containers are the containers which can be browsed.
+	Containers []string `json:"containers"`
+	// Container is the container which is browsed first.
+	Container string `json:"container"`
+}
+
+// FileBrowser is a component which browses the files in a pod's containers.
+// Files can be downloaded from and uploaded to the containers.
+type FileBrowser struct {
+	base
+	Config FileBrowserConfig `json:"config"`
+}
+
+var _ Component = (*FileBrowser)(nil)
+
+// NewFileBrowser creates a file browser component.
+func NewFileBrowser(namespace, pod string, containers []string, container string) *FileBrowser {
+	return &FileBrowser{
+		base: newBase(typeFileBrowser, TitleFromString("Files")),
+		Config: FileBrowserConfig{
+			Namespace:  namespace,
+			Pod:        pod,
+			Containers: containers,
+			Container:  container,
+		},
+	}
+}
+
+// GetMetadata accesses the components metadata. Implements Component.
+func (t *FileBrowser) GetMetadata() Metadata {
+	return t.Metadata
+}
+
+type fileBrowserMarshal FileBrowser
+
+// MarshalJSON implements json.Marshaler.
+func (t *FileBrowser) MarshalJSON() ([]byte, error) {
+	m := fileBrowserMarshal(*t)
+	m.Metadata.Type = typeFileBrowser
+
+	return json.Marshal(&m)
+}
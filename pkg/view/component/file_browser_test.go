@@ -0,0 +1,26 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileBrowser_Marshal(t *testing.T) {
+	input := NewFileBrowser("default", "web", []string{"app", "sidecar"}, "app")
+	actual, err := json.Marshal(input)
+	assert.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "file_browser.json"))
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, string(expected), string(actual))
+}
@@ -10,8 +10,12 @@ import (
 )
 
 type LogsConfig struct {
-	Namespace  string   `json:"namespace,omitempty"`
-	Name       string   `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// APIVersion and Kind are set when logs are for all the pods of a
+	// workload rather than a single pod.
+	APIVersion string   `json:"apiVersion,omitempty"`
+	Kind       string   `json:"kind,omitempty"`
 	Containers []string `json:"containers,omitempty"`
 }
 
@@ -31,6 +35,14 @@ func NewLogs(namespace, name string, containers ...string) *Logs {
 	}
 }
 
+// NewWorkloadLogs creates a logs component for all the pods of a workload.
+func NewWorkloadLogs(namespace, apiVersion, kind, name string) *Logs {
+	l := NewLogs(namespace, name, "")
+	l.Config.APIVersion = apiVersion
+	l.Config.Kind = kind
+	return l
+}
+
 // GetMetadata accesses the components metadata. Implements Component.
 func (l *Logs) GetMetadata() Metadata {
 	return l.Metadata
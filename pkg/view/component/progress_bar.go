@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// ProgressBarConfig is the config for a progress bar.
+type ProgressBarConfig struct {
+	// Percent is how much of the bar is filled, from 0 to 100.
+	Percent int `json:"percent"`
+	// Text is shown next to the bar.
+	Text string `json:"text,omitempty"`
+	// Status sets the color of the bar.
+	Status TextStatus `json:"status,omitempty"`
+}
+
+// ProgressBar is a component which shows a horizontal bar filled to a
+// percentage.
+type ProgressBar struct {
+	base
+	Config ProgressBarConfig `json:"config"`
+}
+
+var _ Component = (*ProgressBar)(nil)
+
+// NewProgressBar creates a progress bar component. The percent is clamped
+// to between 0 and 100, so the text can describe values outside the bar.
+func NewProgressBar(text string, percent int) *ProgressBar {
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+
+	return &ProgressBar{
+		base: newBase(typeProgressBar, nil),
+		Config: ProgressBarConfig{
+			Percent: percent,
+			Text:    text,
+		},
+	}
+}
+
+// SetStatus sets the status of the progress bar.
+func (p *ProgressBar) SetStatus(status TextStatus) {
+	p.Config.Status = status
+}
+
+// GetMetadata accesses the components metadata. Implements Component.
+func (p *ProgressBar) GetMetadata() Metadata {
+	return p.Metadata
+}
+
+type progressBarMarshal ProgressBar
+
+// MarshalJSON implements json.Marshaler.
+func (p *ProgressBar) MarshalJSON() ([]byte, error) {
+	m := progressBarMarshal(*p)
+	m.Metadata.Type = typeProgressBar
+
+	return json.Marshal(&m)
+}
@@ -0,0 +1,28 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBar_Marshal(t *testing.T) {
+	input := NewProgressBar("150%", 150)
+	input.SetStatus(TextStatusError)
+
+	actual, err := json.Marshal(input)
+	assert.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "progress_bar.json"))
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, string(expected), string(actual))
+}
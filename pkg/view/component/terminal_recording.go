@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import "encoding/json"
+
+// TerminalRecordingConfig is the config for a terminal recording.
+type TerminalRecordingConfig struct {
+	// Name is the name of the recording. The recording is fetched from the
+	// API in the asciicast v2 format.
+	Name string `json:"name"`
+}
+
+// TerminalRecording is a component which replays a terminal recording.
+type TerminalRecording struct {
+	base
+	Config TerminalRecordingConfig `json:"config"`
+}
+
+var _ Component = (*TerminalRecording)(nil)
+
+// NewTerminalRecording creates a terminal recording component.
+func NewTerminalRecording(title, name string) *TerminalRecording {
+	return &TerminalRecording{
+		base: newBase(typeTerminalRecording, TitleFromString(title)),
+		Config: TerminalRecordingConfig{
+			Name: name,
+		},
+	}
+}
+
+// GetMetadata accesses the components metadata. Implements Component.
+func (t *TerminalRecording) GetMetadata() Metadata {
+	return t.Metadata
+}
+
+type terminalRecordingMarshal TerminalRecording
+
+// MarshalJSON implements json.Marshaler.
+func (t *TerminalRecording) MarshalJSON() ([]byte, error) {
+	m := terminalRecordingMarshal(*t)
+	m.Metadata.Type = typeTerminalRecording
+
+	return json.Marshal(&m)
+}
@@ -0,0 +1,26 @@
+/*
+Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package component
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerminalRecording_Marshal(t *testing.T) {
+	input := NewTerminalRecording("recording", "session")
+	actual, err := json.Marshal(input)
+	assert.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", "terminal_recording.json"))
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, string(expected), string(actual))
+}
@@ -241,6 +241,19 @@ func Test_unmarshal(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "progressBar",
+			configFile: "config_progress_bar.json",
+			objectType: "progressBar",
+			expected: &ProgressBar{
+				Config: ProgressBarConfig{
+					Percent: 75,
+					Text:    "75%",
+					Status:  TextStatusWarning,
+				},
+				base: newBase(typeProgressBar, nil),
+			},
+		},
 		{
 			name:       "quadrant",
 			configFile: "config_quadrant.json",
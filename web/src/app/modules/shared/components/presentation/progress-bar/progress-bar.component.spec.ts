@@ -0,0 +1,43 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+
+import { ProgressBarComponent } from './progress-bar.component';
+import { ProgressBarView } from '../../../models/content';
+
+describe('ProgressBarComponent', () => {
+  let component: ProgressBarComponent;
+  let fixture: ComponentFixture<ProgressBarComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [ProgressBarComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(ProgressBarComponent);
+    component = fixture.componentInstance;
+    const view: ProgressBarView = {
+      metadata: {
+        type: 'progressBar',
+      },
+      config: {
+        percent: 75,
+        text: '75%',
+        status: 2,
+      },
+    };
+    component.view = view;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('colors the bar by status', () => {
+    expect(component.statusClass()).toEqual('warning');
+  });
+});
@@ -0,0 +1,31 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input } from '@angular/core';
+import { ProgressBarView, View } from '../../../models/content';
+
+const statusClasses = {
+  1: 'success',
+  2: 'warning',
+  3: 'danger',
+};
+
+@Component({
+  selector: 'app-view-progress-bar',
+  templateUrl: './progress-bar.component.html',
+  styleUrls: ['./progress-bar.component.scss'],
+})
+export class ProgressBarComponent {
+  v: ProgressBarView;
+
+  @Input() set view(v: View) {
+    this.v = v as ProgressBarView;
+  }
+  get view() {
+    return this.v;
+  }
+
+  statusClass(): string {
+    return statusClasses[this.v.config.status] || '';
+  }
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { of } from 'rxjs';
+import { FileBrowserComponent, parentPath } from './file-browser.component';
+import { ContainerFilesService } from '../../../services/container-files/container-files.service';
+
+describe('FileBrowserComponent', () => {
+  let component: FileBrowserComponent;
+  let fixture: ComponentFixture<FileBrowserComponent>;
+  let service: jasmine.SpyObj<ContainerFilesService>;
+
+  beforeEach(async(() => {
+    service = jasmine.createSpyObj('ContainerFilesService', [
+      'list',
+      'downloadUrl',
+      'upload',
+    ]);
+    service.list.and.returnValue(
+      of([
+        {
+          name: 'etc',
+          path: '/etc',
+          mode: 'drwxr-xr-x',
+          owner: 'root',
+          group: 'root',
+          size: 4096,
+          modified: 'Jan 2 15:04',
+          isDir: true,
+        },
+      ])
+    );
+    service.downloadUrl.and.returnValue('');
+
+    TestBed.configureTestingModule({
+      declarations: [FileBrowserComponent],
+      providers: [{ provide: ContainerFilesService, useValue: service }],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(FileBrowserComponent);
+    component = fixture.componentInstance;
+    component.view = {
+      metadata: { type: 'fileBrowser' },
+      config: {
+        namespace: 'default',
+        pod: 'web',
+        containers: ['app', 'sidecar'],
+        container: 'app',
+      },
+    };
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('should list the root directory', () => {
+    expect(service.list).toHaveBeenCalledWith(
+      { namespace: 'default', pod: 'web', container: 'app' },
+      '/'
+    );
+    expect(component.files.length).toEqual(1);
+  });
+
+  it('should open directories', () => {
+    component.openFile(component.files[0]);
+    expect(component.path).toEqual('/etc');
+    expect(service.list).toHaveBeenCalledTimes(2);
+  });
+
+  it('should find parent directories', () => {
+    expect(parentPath('/var/log')).toEqual('/var');
+    expect(parentPath('/var')).toEqual('/');
+    expect(parentPath('/')).toEqual('/');
+  });
+});
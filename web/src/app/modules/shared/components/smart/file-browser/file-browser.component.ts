@@ -0,0 +1,130 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { Component, Input, OnInit } from '@angular/core';
+import { FileBrowserView } from 'src/app/modules/shared/models/content';
+import {
+  ContainerFile,
+  ContainerFilesService,
+  ContainerFilesTarget,
+} from '../../../services/container-files/container-files.service';
+
+/**
+ * Returns the parent directory of an absolute path.
+ */
+export function parentPath(path: string): string {
+  const i = path.lastIndexOf('/');
+  return i <= 0 ? '/' : path.substring(0, i);
+}
+
+@Component({
+  selector: 'app-file-browser',
+  templateUrl: './file-browser.component.html',
+  styleUrls: ['./file-browser.component.scss'],
+})
+export class FileBrowserComponent implements OnInit {
+  @Input() view: FileBrowserView;
+
+  container: string;
+  path = '/';
+  files: ContainerFile[] = [];
+  loading = false;
+  uploading = false;
+  error = '';
+
+  constructor(private containerFilesService: ContainerFilesService) {}
+
+  ngOnInit(): void {
+    if (!this.view) {
+      return;
+    }
+
+    this.container = this.view.config.container;
+    this.refresh();
+  }
+
+  get target(): ContainerFilesTarget {
+    return {
+      namespace: this.view.config.namespace,
+      pod: this.view.config.pod,
+      container: this.container,
+    };
+  }
+
+  get isRoot(): boolean {
+    return this.path === '/';
+  }
+
+  onContainerChange(container: string): void {
+    this.container = container;
+    this.open('/');
+  }
+
+  open(path: string): void {
+    this.path = path;
+    this.refresh();
+  }
+
+  openFile(file: ContainerFile): void {
+    if (file.isDir) {
+      this.open(file.path);
+    }
+  }
+
+  up(): void {
+    this.open(parentPath(this.path));
+  }
+
+  refresh(): void {
+    this.loading = true;
+    this.error = '';
+    this.containerFilesService.list(this.target, this.path).subscribe(
+      files => {
+        this.files = files;
+        this.loading = false;
+      },
+      err => {
+        this.files = [];
+        this.error = (err.error && err.error.error) || err.message;
+        this.loading = false;
+      }
+    );
+  }
+
+  downloadUrl(file: ContainerFile, archive = false): string {
+    return this.containerFilesService.downloadUrl(
+      this.target,
+      file.path,
+      archive
+    );
+  }
+
+  directoryDownloadUrl(): string {
+    return this.containerFilesService.downloadUrl(this.target, this.path);
+  }
+
+  upload(fileList: FileList): void {
+    if (!fileList || fileList.length === 0) {
+      return;
+    }
+
+    this.uploading = true;
+    this.error = '';
+    this.containerFilesService
+      .upload(this.target, this.path, Array.from(fileList))
+      .subscribe(
+        () => {
+          this.uploading = false;
+          this.refresh();
+        },
+        err => {
+          this.error = (err.error && err.error.error) || err.message;
+          this.uploading = false;
+        }
+      );
+  }
+
+  trackByPath(index: number, file: ContainerFile): string {
+    return file.path;
+  }
+}
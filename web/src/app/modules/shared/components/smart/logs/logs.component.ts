@@ -21,6 +21,8 @@ import {
   View,
 } from 'src/app/modules/shared/models/content';
 import {
+  LogsDownloadFormat,
+  PodLogsOptions,
   PodLogsService,
   PodLogsStreamer,
 } from 'src/app/modules/shared/pod-logs/pod-logs.service';
@@ -52,6 +54,15 @@ export class LogsComponent
   containerLogs: LogEntry[] = [];
 
   selectedContainer = '';
+  showPrevious = false;
+  sinceSeconds = 0;
+  fieldFilter = '';
+  sinceOptions = [
+    { label: 'All', value: 0 },
+    { label: '5 minutes', value: 300 },
+    { label: '1 hour', value: 3600 },
+    { label: '24 hours', value: 86400 },
+  ];
   shouldDisplayTimestamp = false;
   shouldDisplayName = true;
   showOnlyFiltered = false;
@@ -83,20 +94,56 @@ export class LogsComponent
 
   onContainerChange(containerSelection: string): void {
     this.selectedContainer = containerSelection;
+    if (this.selectedContainer === '') {
+      this.shouldDisplayName = true;
+    } else {
+      this.shouldDisplayName = false;
+    }
+
+    this.restartStream();
+  }
+
+  togglePrevious(): void {
+    this.showPrevious = !this.showPrevious;
+    this.restartStream();
+  }
+
+  onSinceChange(sinceSeconds: string): void {
+    this.sinceSeconds = Number(sinceSeconds);
+    this.restartStream();
+  }
+
+  onFieldFilterChange(): void {
+    this.restartStream();
+  }
+
+  restartStream(): void {
     if (this.logStream) {
       this.containerLogs = [];
       this.logStream.close();
       this.logStream = null;
     }
-    if (this.selectedContainer === '') {
-      this.shouldDisplayName = true;
-    } else {
-      this.shouldDisplayName = false;
+    if (this.logSubscription) {
+      this.logSubscription.unsubscribe();
     }
 
     this.startStream();
   }
 
+  streamOptions(): PodLogsOptions {
+    const options: PodLogsOptions = {};
+    if (this.showPrevious) {
+      options.previous = true;
+    }
+    if (this.sinceSeconds > 0) {
+      options.sinceSeconds = this.sinceSeconds;
+    }
+    if (this.fieldFilter.trim().length > 0) {
+      options.fieldFilter = this.fieldFilter.trim();
+    }
+    return options;
+  }
+
   toggleTimestampDisplay(): void {
     this.shouldDisplayTimestamp = !this.shouldDisplayTimestamp;
     this.updateSelectedCount();
@@ -108,6 +155,20 @@ export class LogsComponent
     this.scrollToHighlight(0, 0);
   }
 
+  downloadUrl(format: LogsDownloadFormat, wholeNamespace = false): string {
+    const config = this.v.config;
+    return this.podLogsService.downloadUrl(
+      format,
+      config.namespace,
+      wholeNamespace ? '' : config.name,
+      this.selectedContainer,
+      this.streamOptions(),
+      config.kind
+        ? { apiVersion: config.apiVersion, kind: config.kind }
+        : undefined
+    );
+  }
+
   startStream() {
     const namespace = this.v.config.namespace;
     const pod = this.v.config.name;
@@ -116,7 +177,11 @@ export class LogsComponent
       this.logStream = this.podLogsService.createStream(
         namespace,
         pod,
-        container
+        container,
+        this.streamOptions(),
+        this.v.config.kind
+          ? { apiVersion: this.v.config.apiVersion, kind: this.v.config.kind }
+          : undefined
       );
       this.logSubscription = this.logStream.logEntry.subscribe(
         (entry: LogEntry) => {
@@ -131,7 +196,21 @@ export class LogsComponent
   }
 
   identifyLog(index: number, item: LogEntry) {
-    return `${item.timestamp}-${item.message}`;
+    return `${item.timestamp}-${item.pod}-${item.message}`;
+  }
+
+  logFields(log: LogEntry): { key: string; value: string }[] {
+    if (!log.fields) {
+      return [];
+    }
+
+    return Object.keys(log.fields)
+      .sort()
+      .map(key => ({ key, value: log.fields[key] }));
+  }
+
+  logSource(log: LogEntry): string {
+    return log.pod ? `${log.pod}/${log.container}` : log.container;
   }
 
   onScroll(evt: { target: HTMLDivElement }) {
@@ -283,7 +362,7 @@ export class LogsComponent
     }
 
     if (this.shouldDisplayName) {
-      match = input.container.match(
+      match = this.logSource(input).match(
         new RegExp(this.filterText, this.regexFlags)
       );
       return match || [];
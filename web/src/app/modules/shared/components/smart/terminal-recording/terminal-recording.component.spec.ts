@@ -0,0 +1,45 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { HttpClientTestingModule } from '@angular/common/http/testing';
+import {
+  parseAsciicast,
+  TerminalRecordingComponent,
+} from './terminal-recording.component';
+
+describe('TerminalRecordingComponent', () => {
+  let component: TerminalRecordingComponent;
+  let fixture: ComponentFixture<TerminalRecordingComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      imports: [HttpClientTestingModule],
+      declarations: [TerminalRecordingComponent],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(TerminalRecordingComponent);
+    component = fixture.componentInstance;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('should parse an asciicast recording', () => {
+    const cast = parseAsciicast(
+      '{"version":2,"width":100,"height":30}\n' +
+        '[0.5,"r","120x40"]\n' +
+        'not an event\n' +
+        '[1.25,"o","$ "]\n'
+    );
+    expect(cast.header.width).toEqual(100);
+    expect(cast.events).toEqual([
+      [0.5, 'r', '120x40'],
+      [1.25, 'o', '$ '],
+    ]);
+  });
+});
@@ -0,0 +1,201 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+import {
+  AfterViewInit,
+  Component,
+  ElementRef,
+  Input,
+  OnDestroy,
+  ViewChild,
+  ViewEncapsulation,
+} from '@angular/core';
+import { HttpClient } from '@angular/common/http';
+import { Subscription } from 'rxjs';
+import { Terminal } from 'xterm';
+import { TerminalRecordingView } from 'src/app/modules/shared/models/content';
+import getAPIBase from '../../../services/common/getAPIBase';
+
+const API_BASE = getAPIBase();
+
+export interface AsciicastHeader {
+  version: number;
+  width: number;
+  height: number;
+  timestamp?: number;
+  command?: string;
+  title?: string;
+}
+
+export type AsciicastEvent = [number, string, string];
+
+export interface Asciicast {
+  header: AsciicastHeader;
+  events: AsciicastEvent[];
+}
+
+/**
+ * Parses an asciicast v2 recording. Lines which can't be parsed are skipped.
+ */
+export function parseAsciicast(cast: string): Asciicast {
+  const lines = (cast || '').split('\n').filter(line => line.trim() !== '');
+  if (lines.length === 0) {
+    return { header: { version: 2, width: 80, height: 24 }, events: [] };
+  }
+
+  const header = JSON.parse(lines[0]) as AsciicastHeader;
+  const events: AsciicastEvent[] = [];
+  lines.slice(1).forEach(line => {
+    try {
+      const event = JSON.parse(line);
+      if (Array.isArray(event) && event.length === 3) {
+        events.push(event as AsciicastEvent);
+      }
+    } catch (e) {
+      // skip lines which aren't events
+    }
+  });
+
+  return { header, events };
+}
+
+@Component({
+  encapsulation: ViewEncapsulation.None,
+  selector: 'app-terminal-recording',
+  styleUrls: ['./terminal-recording.component.scss'],
+  templateUrl: './terminal-recording.component.html',
+})
+export class TerminalRecordingComponent implements AfterViewInit, OnDestroy {
+  @Input() view: TerminalRecordingView;
+  @ViewChild('terminal', { static: true }) terminalDiv: ElementRef;
+
+  cast: Asciicast;
+  playing = false;
+  speed = 1;
+  speeds = [1, 2, 4, 8];
+  position = 0;
+  private next = 0;
+  private timer: any;
+  private term: Terminal;
+  private castSubscription: Subscription;
+
+  constructor(private http: HttpClient) {}
+
+  ngAfterViewInit() {
+    if (!this.view) {
+      return;
+    }
+
+    // The recording is fetched once, rather than being sent with the
+    // content, which is refreshed.
+    const params = new URLSearchParams({ name: this.view.config.name });
+    this.castSubscription = this.http
+      .get(`${API_BASE}/api/v1/terminal-recordings?${params.toString()}`, {
+        responseType: 'text',
+      })
+      .subscribe(cast => this.load(cast));
+  }
+
+  ngOnDestroy(): void {
+    this.pause();
+    if (this.castSubscription) {
+      this.castSubscription.unsubscribe();
+    }
+    if (this.term) {
+      this.term.dispose();
+    }
+  }
+
+  get duration(): number {
+    const events = this.cast ? this.cast.events : [];
+    return events.length > 0 ? events[events.length - 1][0] : 0;
+  }
+
+  play(): void {
+    if (!this.cast || this.playing) {
+      return;
+    }
+    if (this.next >= this.cast.events.length) {
+      this.restart();
+      return;
+    }
+
+    this.playing = true;
+    this.schedule();
+  }
+
+  pause(): void {
+    this.playing = false;
+    if (this.timer) {
+      clearTimeout(this.timer);
+      this.timer = null;
+    }
+  }
+
+  restart(): void {
+    if (!this.cast) {
+      return;
+    }
+
+    this.pause();
+    this.next = 0;
+    this.position = 0;
+    this.term.reset();
+    const { width, height } = this.cast.header;
+    this.term.resize(width, height);
+    this.play();
+  }
+
+  onSpeedChange(speed: string): void {
+    this.speed = Number(speed);
+    if (this.playing) {
+      this.pause();
+      this.play();
+    }
+  }
+
+  private load(data: string): void {
+    this.cast = parseAsciicast(data);
+    const { width, height } = this.cast.header;
+    this.term = new Terminal({
+      cols: width,
+      rows: height,
+      disableStdin: true,
+    });
+    this.term.open(this.terminalDiv.nativeElement);
+    setTimeout(() => this.play());
+  }
+
+  private schedule(): void {
+    if (!this.playing || this.next >= this.cast.events.length) {
+      this.playing = false;
+      return;
+    }
+
+    const [time] = this.cast.events[this.next];
+    const delay = Math.max(0, ((time - this.position) * 1000) / this.speed);
+    this.timer = setTimeout(() => {
+      this.apply(this.cast.events[this.next]);
+      this.next++;
+      this.schedule();
+    }, delay);
+  }
+
+  private apply(event: AsciicastEvent): void {
+    const [time, type, data] = event;
+    this.position = time;
+
+    switch (type) {
+      case 'o':
+        this.term.write(data);
+        break;
+      case 'r': {
+        const [cols, rows] = data.split('x').map(Number);
+        if (cols > 0 && rows > 0) {
+          this.term.resize(cols, rows);
+        }
+        break;
+      }
+    }
+  }
+}
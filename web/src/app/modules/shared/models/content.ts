@@ -175,6 +175,14 @@ export interface LabelSelectorView extends View {
   };
 }
 
+export interface ProgressBarView extends View {
+  config: {
+    percent: number;
+    text?: string;
+    status?: number;
+  };
+}
+
 export interface SingleStatView extends View {
   config: {
     title: string;
@@ -395,6 +403,8 @@ export interface LogsView extends View {
   config: {
     namespace: string;
     name: string;
+    apiVersion?: string;
+    kind?: string;
     containers: string[];
   };
 }
@@ -402,7 +412,12 @@ export interface LogsView extends View {
 export interface LogEntry {
   timestamp: string;
   message: string;
+  pod?: string;
   container: string;
+  format?: string;
+  level?: string;
+  caller?: string;
+  fields?: { [key: string]: string };
 }
 
 export interface LogResponse {
@@ -431,6 +446,21 @@ export interface TerminalView extends View {
   };
 }
 
+export interface TerminalRecordingView extends View {
+  config: {
+    name: string;
+  };
+}
+
+export interface FileBrowserView extends View {
+  config: {
+    namespace: string;
+    pod: string;
+    containers: string[];
+    container: string;
+  };
+}
+
 export interface EditorView extends View {
   config: {
     value: string;
@@ -13,4 +13,21 @@ describe('PodLogsService', () => {
     const service: PodLogsService = TestBed.inject(PodLogsService);
     expect(service).toBeTruthy();
   });
+
+  it('should create a download url', () => {
+    const service: PodLogsService = TestBed.inject(PodLogsService);
+    const url = service.downloadUrl('ndjson', 'default', 'web', 'app', {
+      previous: true,
+    });
+    expect(url).toContain('/api/v1/logs/download?');
+    expect(url).toContain(
+      'namespace=default&podName=web&containerName=app&previous=true&format=ndjson'
+    );
+  });
+
+  it('should create a namespace download url', () => {
+    const service: PodLogsService = TestBed.inject(PodLogsService);
+    const url = service.downloadUrl('archive', 'default', '', 'app');
+    expect(url).toContain('logs/download?namespace=default&format=archive');
+  });
 });
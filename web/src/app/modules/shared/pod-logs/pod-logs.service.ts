@@ -10,6 +10,39 @@ import { WebsocketService } from '../services/websocket/websocket.service';
 
 const API_BASE = getAPIBase();
 
+export interface PodLogsOptions {
+  previous?: boolean;
+  sinceTime?: string;
+  sinceSeconds?: number;
+  tailLines?: number;
+  limitBytes?: number;
+  include?: string;
+  exclude?: string;
+  fieldFilter?: string;
+}
+
+export interface LogsWorkload {
+  apiVersion: string;
+  kind: string;
+}
+
+export type LogsDownloadFormat = 'text' | 'ndjson' | 'archive';
+
+function logsTarget(namespace: string, pod: string, workload?: LogsWorkload) {
+  if (workload) {
+    return {
+      namespace,
+      apiVersion: workload.apiVersion,
+      kind: workload.kind,
+      name: pod,
+    };
+  }
+  return {
+    namespace,
+    podName: pod,
+  };
+}
+
 export class PodLogsStreamer {
   public logEntry: BehaviorSubject<LogEntry>;
   private intervalID: number;
@@ -18,7 +51,9 @@ export class PodLogsStreamer {
     private namespace: string,
     private pod: string,
     private container: string,
-    private wss: WebsocketService
+    private wss: WebsocketService,
+    private options: PodLogsOptions = {},
+    private workload?: LogsWorkload
   ) {}
 
   public start(): void {
@@ -31,9 +66,9 @@ export class PodLogsStreamer {
     this.logEntry = new BehaviorSubject(emptyEntry);
 
     this.wss.sendMessage('action.octant.dev/podLogs/subscribe', {
-      namespace: this.namespace,
-      podName: this.pod,
+      ...this.target(),
       containerName: this.container,
+      ...this.options,
     });
 
     this.wss.registerHandler(this.streamUrl(), data => {
@@ -43,19 +78,27 @@ export class PodLogsStreamer {
   }
 
   public close(): void {
-    this.wss.sendMessage('action.octant.dev/podLogs/unsubscribe', {
-      namespace: this.namespace,
-      podName: this.pod,
-    });
+    this.wss.sendMessage(
+      'action.octant.dev/podLogs/unsubscribe',
+      this.target()
+    );
     this.logEntry.unsubscribe();
   }
 
+  private target() {
+    return logsTarget(this.namespace, this.pod, this.workload);
+  }
+
   private streamUrl(): string {
+    const target = this.workload
+      ? `${this.workload.kind.toLowerCase()}/${this.pod}`
+      : `pod/${this.pod}`;
+
     return [
       'event.octant.dev',
       'logging',
       `namespace/${this.namespace}`,
-      `pod/${this.pod}`,
+      target,
     ].join('/');
   }
 }
@@ -66,9 +109,46 @@ export class PodLogsStreamer {
 export class PodLogsService {
   constructor(private wss: WebsocketService) {}
 
-  public createStream(namespace, pod, container: string): PodLogsStreamer {
-    const pls = new PodLogsStreamer(namespace, pod, container, this.wss);
+  public createStream(
+    namespace,
+    pod,
+    container: string,
+    options: PodLogsOptions = {},
+    workload?: LogsWorkload
+  ): PodLogsStreamer {
+    const pls = new PodLogsStreamer(
+      namespace,
+      pod,
+      container,
+      this.wss,
+      options,
+      workload
+    );
     pls.start();
     return pls;
   }
+
+  /**
+   * Returns a URL which downloads logs. If pod is empty, logs for all the
+   * pods in the namespace are downloaded.
+   */
+  public downloadUrl(
+    format: LogsDownloadFormat,
+    namespace: string,
+    pod: string,
+    container: string,
+    options: PodLogsOptions = {},
+    workload?: LogsWorkload
+  ): string {
+    const params = new URLSearchParams();
+    const query = {
+      ...(pod ? logsTarget(namespace, pod, workload) : { namespace }),
+      ...(pod && container ? { containerName: container } : {}),
+      ...options,
+      format,
+    };
+    Object.keys(query).forEach(key => params.set(key, String(query[key])));
+
+    return `${API_BASE}/api/v1/logs/download?${params.toString()}`;
+  }
 }
@@ -0,0 +1,62 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { TestBed } from '@angular/core/testing';
+import {
+  HttpClientTestingModule,
+  HttpTestingController,
+} from '@angular/common/http/testing';
+import { ContainerFilesService } from './container-files.service';
+
+describe('ContainerFilesService', () => {
+  let service: ContainerFilesService;
+  let httpMock: HttpTestingController;
+
+  const target = { namespace: 'default', pod: 'web', container: 'app' };
+
+  beforeEach(() => {
+    TestBed.configureTestingModule({
+      imports: [HttpClientTestingModule],
+    });
+    service = TestBed.inject(ContainerFilesService);
+    httpMock = TestBed.inject(HttpTestingController);
+  });
+
+  afterEach(() => {
+    httpMock.verify();
+  });
+
+  it('should list files', () => {
+    service.list(target, '/etc').subscribe(files => {
+      expect(files.length).toEqual(1);
+    });
+
+    const req = httpMock.expectOne(
+      r =>
+        r.url.includes('/api/v1/containers/files?') &&
+        r.url.includes('path=%2Fetc')
+    );
+    expect(req.request.method).toEqual('GET');
+    req.flush([{ name: 'app.conf', path: '/etc/app.conf', isDir: false }]);
+  });
+
+  it('should create download urls', () => {
+    const url = service.downloadUrl(target, '/var/log', true);
+    expect(url).toContain('/api/v1/containers/files/download?');
+    expect(url).toContain('container=app');
+    expect(url).toContain('archive=true');
+  });
+
+  it('should upload files', () => {
+    const file = new File(['heap'], 'heap.hprof');
+    service.upload(target, '/tmp', [file]).subscribe();
+
+    const req = httpMock.expectOne(r =>
+      r.url.includes('/api/v1/containers/files/upload?')
+    );
+    expect(req.request.method).toEqual('POST');
+    expect((req.request.body as FormData).get('files')).toBeTruthy();
+    req.flush(null);
+  });
+});
@@ -0,0 +1,85 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Injectable } from '@angular/core';
+import { HttpClient } from '@angular/common/http';
+import { Observable } from 'rxjs';
+import getAPIBase from '../common/getAPIBase';
+
+const API_BASE = getAPIBase();
+
+export interface ContainerFile {
+  name: string;
+  path: string;
+  mode: string;
+  owner: string;
+  group: string;
+  size: number;
+  modified: string;
+  isDir: boolean;
+  linkTarget?: string;
+}
+
+export interface ContainerFilesTarget {
+  namespace: string;
+  pod: string;
+  container: string;
+}
+
+@Injectable({
+  providedIn: 'root',
+})
+export class ContainerFilesService {
+  constructor(private http: HttpClient) {}
+
+  /**
+   * Lists the files in a directory in a container.
+   */
+  list(
+    target: ContainerFilesTarget,
+    path: string
+  ): Observable<ContainerFile[]> {
+    return this.http.get<ContainerFile[]>(this.url('', target, path));
+  }
+
+  /**
+   * Returns a URL which downloads a file, or a directory as a tar.gz archive.
+   */
+  downloadUrl(
+    target: ContainerFilesTarget,
+    path: string,
+    archive = false
+  ): string {
+    const extra = archive ? { archive: 'true' } : {};
+    return this.url('/download', target, path, extra);
+  }
+
+  /**
+   * Uploads files to a directory in a container.
+   */
+  upload(
+    target: ContainerFilesTarget,
+    path: string,
+    files: File[]
+  ): Observable<void> {
+    const form = new FormData();
+    files.forEach(file => form.append('files', file, file.name));
+
+    return this.http.post<void>(this.url('/upload', target, path), form);
+  }
+
+  private url(
+    endpoint: string,
+    target: ContainerFilesTarget,
+    path: string,
+    extra: { [key: string]: string } = {}
+  ): string {
+    const params = new URLSearchParams();
+    const values = { ...target, path, ...extra };
+    Object.keys(values).forEach(key => params.set(key, values[key]));
+
+    const query = params.toString();
+    return `${API_BASE}/api/v1/containers/files${endpoint}?${query}`;
+  }
+}
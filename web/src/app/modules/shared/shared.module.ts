@@ -19,6 +19,7 @@ import { DatagridComponent } from './components/presentation/datagrid/datagrid.c
 import { DonutChartComponent } from './components/presentation/donut-chart/donut-chart.component';
 import { FlexlayoutComponent } from './components/presentation/flexlayout/flexlayout.component';
 import { SingleStatComponent } from './components/presentation/single-stat/single-stat.component';
+import { ProgressBarComponent } from './components/presentation/progress-bar/progress-bar.component';
 import { QuadrantComponent } from './components/presentation/quadrant/quadrant.component';
 import { IFrameComponent } from './components/presentation/iframe/iframe.component';
 import { EditorComponent } from './components/smart/editor/editor.component';
@@ -48,6 +49,8 @@ import { ObjectStatusComponent } from './components/presentation/object-status/o
 import { PodStatusComponent } from './components/presentation/pod-status/pod-status.component';
 import { FormsModule, ReactiveFormsModule } from '@angular/forms';
 import { TerminalComponent } from './components/smart/terminal/terminal.component';
+import { TerminalRecordingComponent } from './components/smart/terminal-recording/terminal-recording.component';
+import { FileBrowserComponent } from './components/smart/file-browser/file-browser.component';
 import { LogsComponent } from './components/smart/logs/logs.component';
 import { PortsComponent } from './components/presentation/ports/ports.component';
 import { FiltersComponent } from './components/smart/filters/filters.component';
@@ -115,6 +118,7 @@ import { StepperComponent } from './components/presentation/stepper/stepper.comp
     PodStatusComponent,
     PortForwardComponent,
     PortsComponent,
+    ProgressBarComponent,
     QuadrantComponent,
     ResourceViewerComponent,
     SafePipe,
@@ -129,6 +133,8 @@ import { StepperComponent } from './components/presentation/stepper/stepper.comp
     TableComponent,
     TabsComponent,
     TerminalComponent,
+    TerminalRecordingComponent,
+    FileBrowserComponent,
     TextComponent,
     TimestampComponent,
     TitleComponent,
@@ -195,6 +201,7 @@ import { StepperComponent } from './components/presentation/stepper/stepper.comp
     PodStatusComponent,
     PortForwardComponent,
     PortsComponent,
+    ProgressBarComponent,
     QuadrantComponent,
     ResourceViewerComponent,
     SelectorsComponent,
@@ -204,6 +211,8 @@ import { StepperComponent } from './components/presentation/stepper/stepper.comp
     TableComponent,
     TabsComponent,
     TerminalComponent,
+    TerminalRecordingComponent,
+    FileBrowserComponent,
     TextComponent,
     TimestampComponent,
     TitleComponent,
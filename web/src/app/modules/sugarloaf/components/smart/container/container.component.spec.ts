@@ -29,6 +29,8 @@ import { ThemeSwitchButtonComponent } from '../theme-switch/theme-switch-button.
 import { QuickSwitcherComponent } from '../quick-switcher/quick-switcher.component';
 import { MonacoEditorConfig, MonacoProviderService } from 'ng-monaco-editor';
 import { UploaderComponent } from '../uploader/uploader.component';
+import { ExecCommandResultComponent } from '../exec-command-result/exec-command-result.component';
+import { CodeComponent } from '../../../../shared/components/presentation/code/code.component';
 
 describe('AppComponent', () => {
   beforeEach(async(() => {
@@ -64,6 +66,8 @@ describe('AppComponent', () => {
         ThemeSwitchButtonComponent,
         QuickSwitcherComponent,
         UploaderComponent,
+        ExecCommandResultComponent,
+        CodeComponent,
       ],
     }).compileComponents();
   }));
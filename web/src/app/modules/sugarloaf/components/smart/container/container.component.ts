@@ -5,11 +5,17 @@
  */
 
 import { Component, HostListener, OnDestroy, OnInit } from '@angular/core';
+import { Router } from '@angular/router';
 import { Navigation } from '../../../models/navigation';
 import { WebsocketService } from '../../../../shared/services/websocket/websocket.service';
 import { IconService } from '../../../../shared/services/icon/icon.service';
 import { Preferences } from '../../../../shared/models/preference';
 
+interface ContentPathEvent {
+  contentPath: string;
+  fragment?: string;
+}
+
 // tslint:disable-next-line
 declare namespace astilectron {
   export function onMessage(fn: (message: Message) => void);
@@ -38,7 +44,8 @@ export class ContainerComponent implements OnInit, OnDestroy {
 
   constructor(
     private websocketService: WebsocketService,
-    private iconService: IconService
+    private iconService: IconService,
+    private router: Router
   ) {
     iconService.load({
       iconName: 'octant-logo',
@@ -53,6 +60,17 @@ export class ContainerComponent implements OnInit, OnDestroy {
   }
 
   ngOnInit(): void {
+    this.websocketService.registerHandler(
+      'event.octant.dev/contentPath',
+      data => {
+        const event = data as ContentPathEvent;
+        this.router
+          .navigate([event.contentPath], { fragment: event.fragment })
+          .catch(reason =>
+            console.error(`unable to navigate`, { event, reason })
+          );
+      }
+    );
     this.websocketService.open();
   }
 
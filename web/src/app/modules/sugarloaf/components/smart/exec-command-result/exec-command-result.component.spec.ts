@@ -0,0 +1,65 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { async, ComponentFixture, TestBed } from '@angular/core/testing';
+import { NO_ERRORS_SCHEMA } from '@angular/core';
+import { ExecCommandResultComponent } from './exec-command-result.component';
+import { WebsocketService } from '../../../../shared/services/websocket/websocket.service';
+import { WebsocketServiceMock } from '../../../../shared/services/websocket/mock';
+
+describe('ExecCommandResultComponent', () => {
+  let component: ExecCommandResultComponent;
+  let fixture: ComponentFixture<ExecCommandResultComponent>;
+
+  beforeEach(async(() => {
+    TestBed.configureTestingModule({
+      declarations: [ExecCommandResultComponent],
+      providers: [
+        { provide: WebsocketService, useClass: WebsocketServiceMock },
+      ],
+      schemas: [NO_ERRORS_SCHEMA],
+    }).compileComponents();
+  }));
+
+  beforeEach(() => {
+    fixture = TestBed.createComponent(ExecCommandResultComponent);
+    component = fixture.componentInstance;
+    fixture.detectChanges();
+  });
+
+  it('should create', () => {
+    expect(component).toBeTruthy();
+  });
+
+  it('opens when a result is received', () => {
+    const websocketService = TestBed.inject(
+      WebsocketService
+    ) as WebsocketServiceMock;
+
+    websocketService.triggerHandler('event.octant.dev/execCommandResult', {
+      namespace: 'default',
+      podName: 'pod',
+      containerName: 'app',
+      command: ['false'],
+      exitCode: 1,
+      timedOut: false,
+      view: {
+        metadata: {
+          type: 'codeBlock',
+          title: [
+            {
+              metadata: { type: 'text' },
+              config: { value: 'false exited with status 1' },
+            },
+          ],
+        },
+        config: { value: '' },
+      },
+    });
+
+    expect(component.isModalOpen).toBeTruthy();
+    expect(component.title).toEqual('false exited with status 1');
+    expect(component.succeeded()).toBeFalsy();
+  });
+});
@@ -0,0 +1,49 @@
+// Copyright (c) 2020 the Octant contributors. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+//
+
+import { Component, OnInit } from '@angular/core';
+import { WebsocketService } from '../../../../shared/services/websocket/websocket.service';
+import { ViewService } from '../../../../shared/services/view/view.service';
+import { CodeView } from '../../../../shared/models/content';
+
+export interface ExecCommandResult {
+  namespace: string;
+  podName: string;
+  containerName: string;
+  command: string[];
+  exitCode: number;
+  timedOut: boolean;
+  view: CodeView;
+}
+
+@Component({
+  selector: 'app-exec-command-result',
+  templateUrl: './exec-command-result.component.html',
+  styleUrls: ['./exec-command-result.component.scss'],
+})
+export class ExecCommandResultComponent implements OnInit {
+  result: ExecCommandResult;
+  title = '';
+  isModalOpen = false;
+
+  constructor(
+    private websocketService: WebsocketService,
+    private viewService: ViewService
+  ) {}
+
+  ngOnInit(): void {
+    this.websocketService.registerHandler(
+      'event.octant.dev/execCommandResult',
+      data => {
+        this.result = data as ExecCommandResult;
+        this.title = this.viewService.viewTitleAsText(this.result.view);
+        this.isModalOpen = true;
+      }
+    );
+  }
+
+  succeeded(): boolean {
+    return this.result && !this.result.timedOut && this.result.exitCode === 0;
+  }
+}
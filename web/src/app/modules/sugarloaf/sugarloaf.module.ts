@@ -9,6 +9,7 @@ import { NavigationComponent } from './components/smart/navigation/navigation.co
 import { QuickSwitcherComponent } from './components/smart/quick-switcher/quick-switcher.component';
 import { ThemeSwitchButtonComponent } from './components/smart/theme-switch/theme-switch-button.component';
 import { UploaderComponent } from './components/smart/uploader/uploader.component';
+import { ExecCommandResultComponent } from './components/smart/exec-command-result/exec-command-result.component';
 import { ClarityModule } from '@clr/angular';
 import { HttpClientModule } from '@angular/common/http';
 import { RouterModule, Routes } from '@angular/router';
@@ -46,6 +47,7 @@ export class UnstripTrailingSlashLocation extends Location {
     QuickSwitcherComponent,
     ThemeSwitchButtonComponent,
     UploaderComponent,
+    ExecCommandResultComponent,
     FilterTextPipe,
   ],
   imports: [